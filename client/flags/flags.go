@@ -9,6 +9,8 @@ import (
 	"github.com/spf13/viper"
 
 	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/pocblockchain/pocc/crypto/keys"
 )
 
 // nolint
@@ -54,8 +56,16 @@ const (
 	FlagRPCWriteTimeout    = "write-timeout"
 	FlagOutputDocument     = "output-document" // inspired by wget -O
 	FlagSkipConfirmation   = "yes"
+	FlagKeyringBackend     = "keyring-backend"
 )
 
+// DefaultKeyringBackend is the keyring backend used when none is selected.
+const DefaultKeyringBackend = keys.BackendLevelDB
+
+// KeyringBackendUsage is the help text of the --keyring-backend flag.
+var KeyringBackendUsage = fmt.Sprintf("Select the keyring backend storing the keys (%s|%s|%s)",
+	keys.BackendLevelDB, keys.BackendFile, keys.BackendMemory)
+
 // LineBreak can be included in a command list to provide a blank line
 // to help with readability
 var (
@@ -99,6 +109,7 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().Bool(FlagDryRun, false, "ignore the --gas flag and perform a simulation of a transaction, but don't broadcast it")
 		c.Flags().Bool(FlagGenerateOnly, false, "Build an unsigned transaction and write it to STDOUT (when enabled, the local Keybase is not accessible and the node operates offline)")
 		c.Flags().BoolP(FlagSkipConfirmation, "y", false, "Skip tx broadcasting prompt confirmation")
+		c.Flags().String(FlagKeyringBackend, DefaultKeyringBackend, KeyringBackendUsage)
 
 		// --gas can accept integers and "simulate"
 		c.Flags().Var(&GasFlagVar, "gas", fmt.Sprintf(
@@ -31,6 +31,7 @@ const (
 	flagIndex       = "index"
 	flagMultisig    = "multisig"
 	flagNoSort      = "nosort"
+	flagAlgo        = "algo"
 
 	// DefaultKeyPass contains the default key password for genesis transactions
 	DefaultKeyPass = "12345678"
@@ -56,6 +57,10 @@ You can add a multisig key by passing the list of key names you want the public
 key to be composed of to the --multisig flag and the minimum number of signatures
 required through --multisig-threshold. The keys are sorted by address, unless
 the flag --nosort is set.
+
+Use the --algo flag to derive an ed25519 key instead of a secp256k1 one, ed25519
+keys are derived from the mnemonic as specified by SLIP-0010 and every index of
+their path is hardened.
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: runAddCmd,
@@ -71,6 +76,7 @@ the flag --nosort is set.
 	cmd.Flags().Bool(flagDryRun, false, "Perform action, but don't add key to local keystore")
 	cmd.Flags().Uint32(flagAccount, 0, "Account number for HD derivation")
 	cmd.Flags().Uint32(flagIndex, 0, "Address index number for HD derivation")
+	cmd.Flags().String(flagAlgo, string(keys.Secp256k1), "Key signing algorithm to derive the key with, secp256k1 or ed25519")
 	cmd.Flags().Bool(flags.FlagIndentResponse, false, "Add indent to JSON response")
 	return cmd
 }
@@ -101,7 +107,7 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 		kb = keys.NewInMemory()
 		encryptPassword = DefaultKeyPass
 	} else {
-		kb, err = NewKeyBaseFromHomeFlag()
+		kb, err = newKeyBaseFromHomeFlagWithInput(inBuf)
 		if err != nil {
 			return err
 		}
@@ -176,11 +182,12 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 
 	account := uint32(viper.GetInt(flagAccount))
 	index := uint32(viper.GetInt(flagIndex))
+	algo := keys.SigningAlgo(viper.GetString(flagAlgo))
 
 	// If we're using ledger, only thing we need is the path and the bech32 prefix.
 	if viper.GetBool(flags.FlagUseLedger) {
 		bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
-		info, err := kb.CreateLedger(name, keys.Secp256k1, bech32PrefixAccAddr, account, index)
+		info, err := kb.CreateLedger(name, algo, bech32PrefixAccAddr, account, index)
 		if err != nil {
 			return err
 		}
@@ -243,7 +250,7 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 		}
 	}
 
-	info, err := kb.CreateAccount(name, mnemonic, bip39Passphrase, encryptPassword, account, index)
+	info, err := kb.CreateAccount(name, mnemonic, bip39Passphrase, encryptPassword, account, index, algo)
 	if err != nil {
 		return err
 	}
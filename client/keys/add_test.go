@@ -22,6 +22,7 @@ func Test_runAddCmdBasic(t *testing.T) {
 	assert.NotNil(t, kbHome)
 	defer kbCleanUp()
 	viper.Set(flags.FlagHome, kbHome)
+	viper.Set(flagAlgo, string(keys.Secp256k1))
 
 	viper.Set(cli.OutputFlag, OutputFormatText)
 
@@ -46,6 +47,19 @@ func Test_runAddCmdBasic(t *testing.T) {
 	mockIn.Reset("test1234\ntest1234\n")
 	err = runAddCmd(cmd, []string{"keyname2"})
 	assert.NoError(t, err)
+
+	viper.Set(flagAlgo, string(keys.Ed25519))
+
+	mockIn.Reset("test1234\ntest1234\n")
+	err = runAddCmd(cmd, []string{"keyname3"})
+	assert.NoError(t, err)
+
+	viper.Set(flagAlgo, string(keys.Sr25519))
+
+	mockIn.Reset("test1234\ntest1234\n")
+	err = runAddCmd(cmd, []string{"keyname4"})
+	assert.Error(t, err)
+	viper.Set(flagAlgo, string(keys.Secp256k1))
 }
 
 func TestExportPrivKey(t *testing.T) {
@@ -53,8 +67,34 @@ func TestExportPrivKey(t *testing.T) {
 
 	mnemonic := "lounge degree orphan snap fox prefer rail jealous rebuild flock mistake spell put know peace skate game laptop mixture amount unique fan sound gallery"
 
-	info, err := kb.CreateAccount("alice", mnemonic, "", "12345678", 0, 0)
+	info, err := kb.CreateAccount("alice", mnemonic, "", "12345678", 0, 0, keys.Secp256k1)
 	assert.Nil(t, err)
 	//t.Logf("info:%v", info)
 	t.Logf("addr:%s", info.GetAddress())
 }
+
+func Test_runAddCmdFileKeyring(t *testing.T) {
+	cmd := addKeyCommand()
+	mockIn, _, _ := tests.ApplyMockIO(cmd)
+	keys.KeyringScryptN = 1 << 4
+
+	kbHome, kbCleanUp := tests.NewTestCaseDir(t)
+	defer kbCleanUp()
+	viper.Set(flags.FlagHome, kbHome)
+	viper.Set(flags.FlagKeyringBackend, keys.BackendFile)
+	defer viper.Set(flags.FlagKeyringBackend, "")
+	viper.Set(flagAlgo, string(keys.Secp256k1))
+	viper.Set(cli.OutputFlag, OutputFormatText)
+	defer func() { keyringPassphrase = "" }()
+
+	// the key passphrase and then the keyring passphrase, asked for when the key
+	// is first written, are read from the same input
+	mockIn.Reset("test1234\nkeyringpass\n")
+	assert.NoError(t, runAddCmd(cmd, []string{"keyname1"}))
+	assert.Equal(t, "keyringpass", keyringPassphrase)
+
+	kb, err := NewKeyBaseFromHomeFlag()
+	assert.NoError(t, err)
+	_, _, err = kb.Sign("keyname1", "test1234", []byte("msg"))
+	assert.NoError(t, err)
+}
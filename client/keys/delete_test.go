@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/pocblockchain/pocc/client/flags"
+	"github.com/pocblockchain/pocc/crypto/keys"
 	"github.com/pocblockchain/pocc/tests"
 )
 
@@ -32,9 +33,9 @@ func Test_runDeleteCmd(t *testing.T) {
 	// Now
 	kb, err := NewKeyBaseFromHomeFlag()
 	assert.NoError(t, err)
-	_, err = kb.CreateAccount(fakeKeyName1, tests.TestMnemonic, "", "", 0, 0)
+	_, err = kb.CreateAccount(fakeKeyName1, tests.TestMnemonic, "", "", 0, 0, keys.Secp256k1)
 	assert.NoError(t, err)
-	_, err = kb.CreateAccount(fakeKeyName2, tests.TestMnemonic, "", "", 0, 1)
+	_, err = kb.CreateAccount(fakeKeyName2, tests.TestMnemonic, "", "", 0, 1, keys.Secp256k1)
 	assert.NoError(t, err)
 
 	err = runDeleteCmd(deleteKeyCommand, []string{"blah"})
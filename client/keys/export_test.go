@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/pocblockchain/pocc/client/flags"
+	"github.com/pocblockchain/pocc/crypto/keys"
 	"github.com/pocblockchain/pocc/tests"
 )
 
@@ -21,7 +22,7 @@ func Test_runExportCmd(t *testing.T) {
 	// create a key
 	kb, err := NewKeyBaseFromHomeFlag()
 	assert.NoError(t, err)
-	_, err = kb.CreateAccount("keyname1", tests.TestMnemonic, "", "123456789", 0, 0)
+	_, err = kb.CreateAccount("keyname1", tests.TestMnemonic, "", "123456789", 0, 0, keys.Secp256k1)
 	assert.NoError(t, err)
 
 	mockIn, _, _ := tests.ApplyMockIO(exportKeyCommand)
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/pocblockchain/pocc/client/flags"
+	"github.com/pocblockchain/pocc/crypto/keys"
 	"github.com/pocblockchain/pocc/tests"
 )
 
@@ -30,7 +31,7 @@ func Test_runListCmd(t *testing.T) {
 
 	kb, err := NewKeyBaseFromHomeFlag()
 	assert.NoError(t, err)
-	_, err = kb.CreateAccount("something", tests.TestMnemonic, "", "", 0, 0)
+	_, err = kb.CreateAccount("something", tests.TestMnemonic, "", "", 0, 0, keys.Secp256k1)
 	assert.NoError(t, err)
 
 	testData := []struct {
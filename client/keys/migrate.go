@@ -0,0 +1,76 @@
+package keys
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/pocblockchain/pocc/client/flags"
+	"github.com/pocblockchain/pocc/crypto/keys"
+)
+
+func migrateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Copy the keys of the LevelDB keybase into another keyring backend",
+		Long: `Copy every key of the LevelDB keybase into the keyring backend selected
+with --keyring-backend. Keys are copied as stored, private keys stay encrypted
+with their own passphrase. Keys whose name already exists in the destination
+keyring are skipped and the LevelDB keybase is left untouched.
+`,
+		Args: cobra.NoArgs,
+		RunE: runMigrateCmd,
+	}
+	cmd.Flags().Bool(flagDryRun, false, "List the keys that would be migrated without copying them")
+	return cmd
+}
+
+func runMigrateCmd(cmd *cobra.Command, _ []string) error {
+	rootDir := viper.GetString(flags.FlagHome)
+	backend := viper.GetString(flags.FlagKeyringBackend)
+	if backend == "" || backend == keys.BackendLevelDB {
+		return errors.New("select the keyring backend to migrate the keys to with --" + flags.FlagKeyringBackend)
+	}
+
+	legacy, err := NewKeyBaseFromDirWithBackend(rootDir, keys.BackendLevelDB)
+	if err != nil {
+		return err
+	}
+	infos, err := legacy.List()
+	if err != nil {
+		return err
+	}
+	if len(infos) == 0 {
+		cmd.PrintErrln("No keys to migrate")
+		return nil
+	}
+
+	kb, err := NewKeyBaseFromDirWithBackend(rootDir, backend)
+	if err != nil {
+		return err
+	}
+
+	dryRun := viper.GetBool(flagDryRun)
+	for _, info := range infos {
+		name := info.GetName()
+		if _, err := kb.Get(name); err == nil {
+			cmd.PrintErrf("Key %s already exists in the %s keyring, skipping\n", name, backend)
+			continue
+		}
+		if dryRun {
+			cmd.PrintErrf("Key %s would be migrated\n", name)
+			continue
+		}
+
+		armor, err := legacy.Export(name)
+		if err != nil {
+			return err
+		}
+		if err := kb.Import(name, armor); err != nil {
+			return err
+		}
+		cmd.PrintErrf("Key %s migrated\n", name)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package keys
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pocblockchain/pocc/client/flags"
+	"github.com/pocblockchain/pocc/crypto/keys"
+	"github.com/pocblockchain/pocc/tests"
+)
+
+func Test_runMigrateCmd(t *testing.T) {
+	cmd := migrateCommand()
+	keys.KeyringScryptN = 1 << 4
+
+	kbHome, cleanUp := tests.NewTestCaseDir(t)
+	defer cleanUp()
+	viper.Set(flags.FlagHome, kbHome)
+	defer viper.Set(flags.FlagKeyringBackend, "")
+	defer viper.Set(flagDryRun, false)
+
+	// the LevelDB keybase cannot be migrated into itself
+	viper.Set(flags.FlagKeyringBackend, keys.BackendLevelDB)
+	require.Error(t, runMigrateCmd(cmd, nil))
+
+	legacy, err := NewKeyBaseFromDirWithBackend(kbHome, keys.BackendLevelDB)
+	require.NoError(t, err)
+	local, err := legacy.CreateAccount("local", tests.TestMnemonic, "", "12345678", 0, 0, keys.Secp256k1)
+	require.NoError(t, err)
+	offline, err := legacy.CreateAccount("offline", tests.TestMnemonic, "", "", 0, 1, keys.Ed25519)
+	require.NoError(t, err)
+
+	keyringPassphrase = "keyringpass"
+	defer func() { keyringPassphrase = "" }()
+	viper.Set(flags.FlagKeyringBackend, keys.BackendFile)
+
+	// a dry run copies nothing
+	viper.Set(flagDryRun, true)
+	require.NoError(t, runMigrateCmd(cmd, nil))
+	kb, err := NewKeyBaseFromHomeFlag()
+	require.NoError(t, err)
+	infos, err := kb.List()
+	require.NoError(t, err)
+	require.Empty(t, infos)
+
+	viper.Set(flagDryRun, false)
+	require.NoError(t, runMigrateCmd(cmd, nil))
+	infos, err = kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+
+	info, err := kb.GetByAddress(local.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, keys.TypeLocal, info.GetType())
+	_, _, err = kb.Sign("local", "12345678", []byte("msg"))
+	require.NoError(t, err)
+
+	info, err = kb.GetByAddress(offline.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, keys.TypeOffline, info.GetType())
+	require.Equal(t, keys.Ed25519, info.GetAlgo())
+
+	// keys already migrated are skipped and the LevelDB keybase is untouched
+	require.NoError(t, runMigrateCmd(cmd, nil))
+	infos, err = legacy.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+}
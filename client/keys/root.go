@@ -16,7 +16,11 @@ func Commands() *cobra.Command {
 
     These keys may be in any format supported by go-crypto and can be
     used by light-clients, full nodes, or any other application that
-    needs to sign with a private key.`,
+    needs to sign with a private key.
+
+    Keys are stored in a LevelDB by default, --keyring-backend file stores
+    every key in its own encrypted file instead. Use the migrate command to
+    copy existing keys into another backend.`,
 	}
 	cmd.AddCommand(
 		mnemonicKeyCommand(),
@@ -29,6 +33,8 @@ func Commands() *cobra.Command {
 		deleteKeyCommand(),
 		updateKeyCommand(),
 		parseKeyStringCommand(),
+		migrateCommand(),
 	)
+	cmd.PersistentFlags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, flags.KeyringBackendUsage)
 	return cmd
 }
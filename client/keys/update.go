@@ -22,7 +22,7 @@ func runUpdateCmd(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
 	buf := bufio.NewReader(cmd.InOrStdin())
-	kb, err := NewKeyBaseFromHomeFlag()
+	kb, err := newKeyBaseFromHomeFlagWithInput(buf)
 	if err != nil {
 		return err
 	}
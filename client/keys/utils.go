@@ -22,8 +22,25 @@ const (
 
 	// defaultKeyDBName is the client's subdirectory where keys are stored.
 	defaultKeyDBName = "keys"
+
+	// defaultKeyringDirName is the client's subdirectory where the file
+	// keyring backend stores keys.
+	defaultKeyringDirName = "keyring-file"
 )
 
+// memoryKeyBase is the keybase of the memory keyring backend, it is shared by
+// the whole process and lost when it exits.
+var memoryKeyBase = keys.NewKeyring(keys.NewInMemoryBackend())
+
+// keyringPassphrase caches the passphrase of the file keyring, so that it is
+// asked for once per process.
+var keyringPassphrase string
+
+// stdinBuf buffers STDIN for the prompts of the commands which do not read
+// their own input, they all share it so that none of them loses the input
+// buffered by another one.
+var stdinBuf = bufio.NewReader(os.Stdin)
+
 type bechKeyOutFn func(keyInfo keys.Info) (keys.KeyOutput, error)
 
 // GetKeyInfo returns key info for a given name. An error is returned if the
@@ -64,7 +81,7 @@ func GetPassphrase(name string) (string, error) {
 // ReadPassphraseFromStdin attempts to read a passphrase from STDIN return an
 // error upon failure.
 func ReadPassphraseFromStdin(name string) (string, error) {
-	buf := bufio.NewReader(os.Stdin)
+	buf := StdinBuffer()
 	prompt := fmt.Sprintf("Password to sign with '%s':", name)
 
 	passphrase, err := input.GetPassword(prompt, buf)
@@ -75,15 +92,57 @@ func ReadPassphraseFromStdin(name string) (string, error) {
 	return passphrase, nil
 }
 
+// StdinBuffer returns the input buffer shared by the prompts reading from
+// STDIN.
+func StdinBuffer() *bufio.Reader {
+	return stdinBuf
+}
+
 // NewKeyBaseFromHomeFlag initializes a Keybase based on the configuration.
 func NewKeyBaseFromHomeFlag() (keys.Keybase, error) {
 	rootDir := viper.GetString(flags.FlagHome)
 	return NewKeyBaseFromDir(rootDir)
 }
 
-// NewKeyBaseFromDir initializes a keybase at a particular dir.
+// newKeyBaseFromHomeFlagWithInput initializes a Keybase based on the
+// configuration, which reads the keyring passphrase from buf, the input buffer
+// of the command.
+func newKeyBaseFromHomeFlagWithInput(buf *bufio.Reader) (keys.Keybase, error) {
+	return newKeyBase(viper.GetString(flags.FlagHome), viper.GetString(flags.FlagKeyringBackend), buf)
+}
+
+// NewKeyBaseFromDir initializes a keybase at a particular dir, using the
+// keyring backend selected with the --keyring-backend flag.
 func NewKeyBaseFromDir(rootDir string) (keys.Keybase, error) {
-	return getLazyKeyBaseFromDir(rootDir)
+	return NewKeyBaseFromDirWithBackend(rootDir, viper.GetString(flags.FlagKeyringBackend))
+}
+
+// NewKeyBaseFromDirWithBackend initializes a keybase of the given keyring
+// backend at a particular dir. The memory backend ignores the dir.
+func NewKeyBaseFromDirWithBackend(rootDir, backend string) (keys.Keybase, error) {
+	return newKeyBase(rootDir, backend, StdinBuffer())
+}
+
+// newKeyBase initializes a keybase of the given keyring backend at a
+// particular dir, the file backend reads its passphrase from buf.
+func newKeyBase(rootDir, backend string, buf *bufio.Reader) (keys.Keybase, error) {
+	switch backend {
+	case "", keys.BackendLevelDB:
+		return getLazyKeyBaseFromDir(rootDir)
+
+	case keys.BackendFile:
+		fb, err := keys.NewFileBackend(filepath.Join(rootDir, defaultKeyringDirName), keyringPassphraseReader(buf))
+		if err != nil {
+			return nil, err
+		}
+		return keys.NewKeyring(fb), nil
+
+	case keys.BackendMemory:
+		return memoryKeyBase, nil
+
+	default:
+		return nil, fmt.Errorf("unknown keyring backend %s", backend)
+	}
 }
 
 // NewInMemoryKeyBase returns a storage-less keybase.
@@ -93,6 +152,33 @@ func getLazyKeyBaseFromDir(rootDir string) (keys.Keybase, error) {
 	return keys.New(defaultKeyDBName, filepath.Join(rootDir, "keys")), nil
 }
 
+// keyringPassphraseReader returns the function reading the passphrase of the
+// file keyring from buf, a new keyring passphrase is asked for twice.
+func keyringPassphraseReader(buf *bufio.Reader) func(create bool) (string, error) {
+	return func(create bool) (string, error) {
+		if keyringPassphrase != "" {
+			return keyringPassphrase, nil
+		}
+
+		var (
+			passphrase string
+			err        error
+		)
+		if create {
+			passphrase, err = input.GetCheckPassword(
+				"Enter a passphrase for the new keyring:", "Repeat the keyring passphrase:", buf)
+		} else {
+			passphrase, err = input.GetPassword("Enter keyring passphrase:", buf)
+		}
+		if err != nil {
+			return "", fmt.Errorf("Error reading keyring passphrase: %v", err)
+		}
+
+		keyringPassphrase = passphrase
+		return passphrase, nil
+	}
+}
+
 func printKeyInfo(keyInfo keys.Info, bechKeyOut bechKeyOutFn) {
 	ko, err := bechKeyOut(keyInfo)
 	if err != nil {
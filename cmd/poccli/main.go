@@ -60,7 +60,7 @@ func main() {
 		client.LineBreak,
 		lcd.ServeCommand(cdc, registerRoutes),
 		client.LineBreak,
-		keys.Commands(),
+		keysCmd(cdc),
 		client.LineBreak,
 		version.Cmd,
 		client.NewCompletionCmd(rootCmd, true),
@@ -76,6 +76,13 @@ func main() {
 	}
 }
 
+// keysCmd returns the keys commands along with the ones that need the codec
+func keysCmd(cdc *amino.Codec) *cobra.Command {
+	keysCmd := keys.Commands()
+	keysCmd.AddCommand(cucmd.GetDeriveKeysCommand(cdc))
+	return keysCmd
+}
+
 func queryCmd(cdc *amino.Codec) *cobra.Command {
 	queryCmd := &cobra.Command{
 		Use:     "query",
@@ -88,6 +95,7 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 		rpc.BlockCommand(),
 		cucmd.QueryTxsByEventsCmd(cdc),
 		cucmd.QueryTxCmd(cdc),
+		cucmd.QueryReceiptsCmd(cdc),
 		client.LineBreak,
 	)
 
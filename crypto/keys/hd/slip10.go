@@ -0,0 +1,52 @@
+package hd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ed25519 keys are derived as specified by SLIP-0010:
+//  https://github.com/satoshilabs/slips/blob/master/slip-0010.md
+// The curve only allows hardened derivation, so every index of a path is hardened
+// whether or not it is marked with an apostrophe.
+
+// ComputeEd25519MastersFromSeed returns the SLIP-0010 ed25519 master private key and chain code.
+func ComputeEd25519MastersFromSeed(seed []byte) (secret [32]byte, chainCode [32]byte) {
+	masterSecret := []byte("ed25519 seed")
+	secret, chainCode = i64(masterSecret, seed)
+
+	return
+}
+
+// DeriveEd25519PrivateKeyForPath derives the ed25519 private key by following the path from
+// the master key, all the indexes are hardened.
+func DeriveEd25519PrivateKeyForPath(privKeyBytes [32]byte, chainCode [32]byte, path string) ([32]byte, error) {
+	data := privKeyBytes
+	parts := strings.Split(path, "/")
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		part = strings.TrimSuffix(part, "'")
+		idx, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return [32]byte{}, fmt.Errorf("invalid BIP 32 path: %s", err)
+		}
+		if idx >= 1<<31 {
+			return [32]byte{}, fmt.Errorf("index %d of path %s is out of range", idx, path)
+		}
+
+		data, chainCode = deriveEd25519PrivateKey(data, chainCode, uint32(idx))
+	}
+
+	return data, nil
+}
+
+// deriveEd25519PrivateKey derives the hardened child key at index of the ed25519 private key.
+// It returns the new private key and new chain code.
+func deriveEd25519PrivateKey(privKeyBytes [32]byte, chainCode [32]byte, index uint32) ([32]byte, [32]byte) {
+	data := append([]byte{byte(0)}, privKeyBytes[:]...)
+	data = append(data, uint32ToBytes(index|0x80000000)...)
+	return i64(chainCode[:], data)
+}
@@ -2,6 +2,7 @@ package keys
 
 import (
 	"bufio"
+	stded25519 "crypto/ed25519"
 	"fmt"
 	"os"
 	"reflect"
@@ -18,6 +19,7 @@ import (
 	bip39 "github.com/cosmos/go-bip39"
 
 	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 	cryptoAmino "github.com/tendermint/tendermint/crypto/encoding/amino"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 	dbm "github.com/tendermint/tm-db"
@@ -63,8 +65,12 @@ const (
 
 var (
 	// ErrUnsupportedSigningAlgo is raised when the caller tries to use a
-	// different signing scheme than secp256k1.
-	ErrUnsupportedSigningAlgo = errors.New("unsupported signing algo: only secp256k1 is supported")
+	// different signing scheme than secp256k1 and ed25519.
+	ErrUnsupportedSigningAlgo = errors.New("unsupported signing algo: only secp256k1 and ed25519 are supported")
+
+	// ErrUnsupportedLedgerSigningAlgo is raised when the caller tries to use a
+	// different signing scheme than secp256k1 with a Ledger device.
+	ErrUnsupportedLedgerSigningAlgo = errors.New("unsupported signing algo: only secp256k1 is supported")
 
 	// ErrUnsupportedLanguage is raised when the caller tries to use a
 	// different language than english for creating a mnemonic sentence.
@@ -74,19 +80,19 @@ var (
 // dbKeybase combines encryption and storage implementation to provide
 // a full-featured key manager
 type dbKeybase struct {
-	db dbm.DB
+	store KeyringBackend
 }
 
 // newDbKeybase creates a new keybase instance using the passed DB for reading and writing keys.
 func newDbKeybase(db dbm.DB) Keybase {
 	return dbKeybase{
-		db: db,
+		store: NewDBBackend(db),
 	}
 }
 
 // NewInMemory creates a transient keybase on top of in-memory storage
 // instance useful for testing purposes and on-the-fly key generation.
-func NewInMemory() Keybase { return dbKeybase{dbm.NewMemDB()} }
+func NewInMemory() Keybase { return dbKeybase{NewDBBackend(dbm.NewMemDB())} }
 
 // CreateMnemonic generates a new key and persists it to storage, encrypted
 // using the provided password.
@@ -98,7 +104,7 @@ func (kb dbKeybase) CreateMnemonic(name string, language Language, passwd string
 	if language != English {
 		return nil, "", ErrUnsupportedLanguage
 	}
-	if algo != Secp256k1 {
+	if !isSupportedAlgo(algo) {
 		err = ErrUnsupportedSigningAlgo
 		return
 	}
@@ -116,24 +122,29 @@ func (kb dbKeybase) CreateMnemonic(name string, language Language, passwd string
 
 	seed := bip39.NewSeed(mnemonic, DefaultBIP39Passphrase)
 	fullFundraiserPath := types.GetConfig().GetFullFundraiserPath()
-	info, err = kb.persistDerivedKey(seed, passwd, name, fullFundraiserPath)
+	info, err = kb.persistDerivedKey(seed, passwd, name, fullFundraiserPath, algo)
 	return
 }
 
-// CreateAccount converts a mnemonic to a private key and persists it, encrypted with the given password.
-func (kb dbKeybase) CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd string, account uint32, index uint32) (Info, error) {
+// CreateAccount converts a mnemonic to a private key of the algo and persists it, encrypted with the given password.
+func (kb dbKeybase) CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd string, account uint32, index uint32, algo SigningAlgo) (Info, error) {
 	coinType := types.GetConfig().GetCoinType()
 	hdPath := hd.NewFundraiserParams(account, coinType, index)
-	return kb.Derive(name, mnemonic, bip39Passwd, encryptPasswd, *hdPath)
+	return kb.Derive(name, mnemonic, bip39Passwd, encryptPasswd, *hdPath, algo)
 }
 
-func (kb dbKeybase) Derive(name, mnemonic, bip39Passphrase, encryptPasswd string, params hd.BIP44Params) (info Info, err error) {
+func (kb dbKeybase) Derive(name, mnemonic, bip39Passphrase, encryptPasswd string, params hd.BIP44Params, algo SigningAlgo) (info Info, err error) {
+	if !isSupportedAlgo(algo) {
+		err = ErrUnsupportedSigningAlgo
+		return
+	}
+
 	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
 	if err != nil {
 		return
 	}
 
-	info, err = kb.persistDerivedKey(seed, encryptPasswd, name, params.String())
+	info, err = kb.persistDerivedKey(seed, encryptPasswd, name, params.String(), algo)
 	return
 }
 
@@ -141,7 +152,7 @@ func (kb dbKeybase) Derive(name, mnemonic, bip39Passphrase, encryptPasswd string
 // It returns the created key info and an error if the Ledger could not be queried
 func (kb dbKeybase) CreateLedger(name string, algo SigningAlgo, hrp string, account, index uint32) (Info, error) {
 	if algo != Secp256k1 {
-		return nil, ErrUnsupportedSigningAlgo
+		return nil, ErrUnsupportedLedgerSigningAlgo
 	}
 
 	coinType := types.GetConfig().GetCoinType()
@@ -153,25 +164,23 @@ func (kb dbKeybase) CreateLedger(name string, algo SigningAlgo, hrp string, acco
 	pub := priv.PubKey()
 
 	// Note: Once Cosmos App v1.3.1 is compulsory, it could be possible to check that pubkey and addr match
-	return kb.writeLedgerKey(name, pub, *hdPath), nil
+	return kb.writeLedgerKey(name, pub, *hdPath)
 }
 
 // CreateOffline creates a new reference to an offline keypair. It returns the
 // created key info.
 func (kb dbKeybase) CreateOffline(name string, pub tmcrypto.PubKey) (Info, error) {
-	return kb.writeOfflineKey(name, pub), nil
+	return kb.writeOfflineKey(name, pub)
 }
 
 // CreateMulti creates a new reference to a multisig (offline) keypair. It
 // returns the created key info.
 func (kb dbKeybase) CreateMulti(name string, pub tmcrypto.PubKey) (Info, error) {
-	return kb.writeMultisigKey(name, pub), nil
+	return kb.writeMultisigKey(name, pub)
 }
 
-func (kb *dbKeybase) persistDerivedKey(seed []byte, passwd, name, fullHdPath string) (info Info, err error) {
-	// create master key and derive first key:
-	masterPriv, ch := hd.ComputeMastersFromSeed(seed)
-	derivedPriv, err := hd.DerivePrivateKeyForPath(masterPriv, ch, fullHdPath)
+func (kb *dbKeybase) persistDerivedKey(seed []byte, passwd, name, fullHdPath string, algo SigningAlgo) (info Info, err error) {
+	priv, err := derivePrivKey(seed, fullHdPath, algo)
 	if err != nil {
 		return
 	}
@@ -179,37 +188,78 @@ func (kb *dbKeybase) persistDerivedKey(seed []byte, passwd, name, fullHdPath str
 	// if we have a password, use it to encrypt the private key and store it
 	// else store the public key only
 	if passwd != "" {
-		info = kb.writeLocalKey(name, secp256k1.PrivKeySecp256k1(derivedPriv), passwd)
-	} else {
-		pubk := secp256k1.PrivKeySecp256k1(derivedPriv).PubKey()
-		info = kb.writeOfflineKey(name, pubk)
+		return kb.writeLocalKey(name, priv, passwd)
 	}
-	return
+	return kb.writeOfflineKey(name, priv.PubKey())
+}
+
+// derivePrivKey derives the private key of the algo for the path from the seed, secp256k1 keys
+// are derived as specified by BIP 32 and ed25519 ones as specified by SLIP-0010.
+func derivePrivKey(seed []byte, fullHdPath string, algo SigningAlgo) (tmcrypto.PrivKey, error) {
+	switch algo {
+	case Secp256k1:
+		// create master key and derive first key:
+		masterPriv, ch := hd.ComputeMastersFromSeed(seed)
+		derivedPriv, err := hd.DerivePrivateKeyForPath(masterPriv, ch, fullHdPath)
+		if err != nil {
+			return nil, err
+		}
+		return secp256k1.PrivKeySecp256k1(derivedPriv), nil
+
+	case Ed25519:
+		masterPriv, ch := hd.ComputeEd25519MastersFromSeed(seed)
+		derivedPriv, err := hd.DeriveEd25519PrivateKeyForPath(masterPriv, ch, fullHdPath)
+		if err != nil {
+			return nil, err
+		}
+
+		// the derived key is the seed of the ed25519 key pair
+		var priv ed25519.PrivKeyEd25519
+		copy(priv[:], stded25519.NewKeyFromSeed(derivedPriv[:]))
+		return priv, nil
+
+	default:
+		return nil, ErrUnsupportedSigningAlgo
+	}
+}
+
+// isSupportedAlgo returns true if keys of the algo can be derived from a mnemonic.
+func isSupportedAlgo(algo SigningAlgo) bool {
+	return algo == Secp256k1 || algo == Ed25519
 }
 
 // List returns the keys from storage in alphabetical order.
 func (kb dbKeybase) List() ([]Info, error) {
 	var res []Info
-	iter := kb.db.Iterator(nil, nil)
-	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
-		key := string(iter.Key())
-
+	keys, err := kb.store.Keys()
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
 		// need to include only keys in storage that have an info suffix
-		if strings.HasSuffix(key, infoSuffix) {
-			info, err := readInfo(iter.Value())
-			if err != nil {
-				return nil, err
-			}
-			res = append(res, info)
+		if !strings.HasSuffix(string(key), infoSuffix) {
+			continue
+		}
+
+		bz, err := kb.store.Get(key)
+		if err != nil {
+			return nil, err
 		}
+		info, err := readInfo(bz)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, info)
 	}
 	return res, nil
 }
 
 // Get returns the public information about one key.
 func (kb dbKeybase) Get(name string) (Info, error) {
-	bs := kb.db.Get(infoKey(name))
+	bs, err := kb.store.Get(infoKey(name))
+	if err != nil {
+		return nil, err
+	}
 	if len(bs) == 0 {
 		return nil, keyerror.NewErrKeyNotFound(name)
 	}
@@ -217,11 +267,17 @@ func (kb dbKeybase) Get(name string) (Info, error) {
 }
 
 func (kb dbKeybase) GetByAddress(address types.AccAddress) (Info, error) {
-	ik := kb.db.Get(addrKey(address))
+	ik, err := kb.store.Get(addrKey(address))
+	if err != nil {
+		return nil, err
+	}
 	if len(ik) == 0 {
 		return nil, fmt.Errorf("key with address %s not found", address)
 	}
-	bs := kb.db.Get(ik)
+	bs, err := kb.store.Get(ik)
+	if err != nil {
+		return nil, err
+	}
 	return readInfo(bs)
 }
 
@@ -317,7 +373,10 @@ func (kb dbKeybase) ExportPrivateKeyObject(name string, passphrase string) (tmcr
 }
 
 func (kb dbKeybase) Export(name string) (armor string, err error) {
-	bz := kb.db.Get(infoKey(name))
+	bz, err := kb.store.Get(infoKey(name))
+	if err != nil {
+		return "", err
+	}
 	if bz == nil {
 		return "", fmt.Errorf("no key to export with name %s", name)
 	}
@@ -328,7 +387,10 @@ func (kb dbKeybase) Export(name string) (armor string, err error) {
 // Retrieve a Info object by its name and return the public key in
 // a portable format.
 func (kb dbKeybase) ExportPubKey(name string) (armor string, err error) {
-	bz := kb.db.Get(infoKey(name))
+	bz, err := kb.store.Get(infoKey(name))
+	if err != nil {
+		return "", err
+	}
 	if bz == nil {
 		return "", fmt.Errorf("no key to export with name %s", name)
 	}
@@ -364,12 +426,17 @@ func (kb dbKeybase) ImportPrivKey(name string, armor string, passphrase string)
 		return errors.Wrap(err, "couldn't import private key")
 	}
 
-	kb.writeLocalKey(name, privKey, passphrase)
-	return nil
+	_, err = kb.writeLocalKey(name, privKey, passphrase)
+	return err
 }
 
+// Import imports an ASCII armored key info, as exported by Export, along with
+// its address index.
 func (kb dbKeybase) Import(name string, armor string) (err error) {
-	bz := kb.db.Get(infoKey(name))
+	bz, err := kb.store.Get(infoKey(name))
+	if err != nil {
+		return
+	}
 	if len(bz) > 0 {
 		return errors.New("Cannot overwrite data for name " + name)
 	}
@@ -377,15 +444,21 @@ func (kb dbKeybase) Import(name string, armor string) (err error) {
 	if err != nil {
 		return
 	}
-	kb.db.Set(infoKey(name), infoBytes)
-	return nil
+	info, err := readInfo(infoBytes)
+	if err != nil {
+		return
+	}
+	return kb.writeInfo(name, info)
 }
 
 // ImportPubKey imports ASCII-armored public keys.
 // Store a new Info object holding a public key only, i.e. it will
 // not be possible to sign with it as it lacks the secret key.
 func (kb dbKeybase) ImportPubKey(name string, armor string) (err error) {
-	bz := kb.db.Get(infoKey(name))
+	bz, err := kb.store.Get(infoKey(name))
+	if err != nil {
+		return
+	}
 	if len(bz) > 0 {
 		return errors.New("Cannot overwrite data for name " + name)
 	}
@@ -397,7 +470,7 @@ func (kb dbKeybase) ImportPubKey(name string, armor string) (err error) {
 	if err != nil {
 		return
 	}
-	kb.writeOfflineKey(name, pubKey)
+	_, err = kb.writeOfflineKey(name, pubKey)
 	return
 }
 
@@ -418,9 +491,10 @@ func (kb dbKeybase) Delete(name, passphrase string, skipPass bool) error {
 			return err
 		}
 	}
-	kb.db.DeleteSync(addrKey(info.GetAddress()))
-	kb.db.DeleteSync(infoKey(name))
-	return nil
+	if err := kb.store.Delete(addrKey(info.GetAddress())); err != nil {
+		return err
+	}
+	return kb.store.Delete(infoKey(name))
 }
 
 // Update changes the passphrase with which an already stored key is
@@ -445,8 +519,8 @@ func (kb dbKeybase) Update(name, oldpass string, getNewpass func() (string, erro
 		if err != nil {
 			return err
 		}
-		kb.writeLocalKey(name, key, newpass)
-		return nil
+		_, err = kb.writeLocalKey(name, key, newpass)
+		return err
 	default:
 		return fmt.Errorf("locally stored key required. Received: %v", reflect.TypeOf(info).String())
 	}
@@ -454,44 +528,42 @@ func (kb dbKeybase) Update(name, oldpass string, getNewpass func() (string, erro
 
 // CloseDB releases the lock and closes the storage backend.
 func (kb dbKeybase) CloseDB() {
-	kb.db.Close()
+	kb.store.Close()
 }
 
-func (kb dbKeybase) writeLocalKey(name string, priv tmcrypto.PrivKey, passphrase string) Info {
+func (kb dbKeybase) writeLocalKey(name string, priv tmcrypto.PrivKey, passphrase string) (Info, error) {
 	// encrypt private key using passphrase
 	privArmor := mintkey.EncryptArmorPrivKey(priv, passphrase)
 	// make Info
 	pub := priv.PubKey()
 	info := newLocalInfo(name, pub, privArmor)
-	kb.writeInfo(name, info)
-	return info
+	return info, kb.writeInfo(name, info)
 }
 
-func (kb dbKeybase) writeLedgerKey(name string, pub tmcrypto.PubKey, path hd.BIP44Params) Info {
+func (kb dbKeybase) writeLedgerKey(name string, pub tmcrypto.PubKey, path hd.BIP44Params) (Info, error) {
 	info := newLedgerInfo(name, pub, path)
-	kb.writeInfo(name, info)
-	return info
+	return info, kb.writeInfo(name, info)
 }
 
-func (kb dbKeybase) writeOfflineKey(name string, pub tmcrypto.PubKey) Info {
+func (kb dbKeybase) writeOfflineKey(name string, pub tmcrypto.PubKey) (Info, error) {
 	info := newOfflineInfo(name, pub)
-	kb.writeInfo(name, info)
-	return info
+	return info, kb.writeInfo(name, info)
 }
 
-func (kb dbKeybase) writeMultisigKey(name string, pub tmcrypto.PubKey) Info {
+func (kb dbKeybase) writeMultisigKey(name string, pub tmcrypto.PubKey) (Info, error) {
 	info := NewMultiInfo(name, pub)
-	kb.writeInfo(name, info)
-	return info
+	return info, kb.writeInfo(name, info)
 }
 
-func (kb dbKeybase) writeInfo(name string, info Info) {
+func (kb dbKeybase) writeInfo(name string, info Info) error {
 	// write the info by key
 	key := infoKey(name)
 	serializedInfo := writeInfo(info)
-	kb.db.SetSync(key, serializedInfo)
+	if err := kb.store.Set(key, serializedInfo); err != nil {
+		return err
+	}
 	// store a pointer to the infokey by address for fast lookup
-	kb.db.SetSync(addrKey(info.GetAddress()), key)
+	return kb.store.Set(addrKey(info.GetAddress()), key)
 }
 
 func addrKey(address types.AccAddress) []byte {
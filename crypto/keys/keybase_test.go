@@ -33,7 +33,7 @@ func TestCreateAccountInvalidMnemonic(t *testing.T) {
 	_, err := kb.CreateAccount(
 		"some_account",
 		"malarkey pair crucial catch public canyon evil outer stage ten gym tornado",
-		"", "", 0, 1)
+		"", "", 0, 1, Secp256k1)
 	assert.Error(t, err)
 	assert.Equal(t, "Invalid mnemonic", err.Error())
 }
@@ -96,8 +96,8 @@ func TestKeyManagement(t *testing.T) {
 	require.Nil(t, err)
 	assert.Empty(t, l)
 
-	_, _, err = cstore.CreateMnemonic(n1, English, p1, Ed25519)
-	require.Error(t, err, "ed25519 keys are currently not supported by keybase")
+	_, _, err = cstore.CreateMnemonic(n1, English, p1, Sr25519)
+	require.Error(t, err, "sr25519 keys are currently not supported by keybase")
 
 	// create some keys
 	_, err = cstore.Get(n1)
@@ -386,7 +386,7 @@ func TestSeedPhrase(t *testing.T) {
 
 	// let us re-create it from the mnemonic-phrase
 	params := *hd.NewFundraiserParams(0, sdk.CoinType, 0)
-	newInfo, err := cstore.Derive(n2, mnemonic, DefaultBIP39Passphrase, p2, params)
+	newInfo, err := cstore.Derive(n2, mnemonic, DefaultBIP39Passphrase, p2, params, Secp256k1)
 	require.NoError(t, err)
 	require.Equal(t, n2, newInfo.GetName())
 	require.Equal(t, info.GetPubKey().Address(), newInfo.GetPubKey().Address())
@@ -465,3 +465,58 @@ func TestDerivedKey(t *testing.T) {
 	t.Logf("address:%s", info.GetAddress())
 	t.Logf("pubkey:%v", pubk)
 }
+
+func TestEd25519DerivedKey(t *testing.T) {
+	kb := NewInMemory()
+	mnemonic := "lounge degree orphan snap fox prefer rail jealous rebuild flock mistake spell put know peace skate game laptop mixture amount unique fan sound gallery"
+	pw := "12345678"
+
+	secp, err := kb.CreateAccount("secp", mnemonic, "", pw, 0, 0, Secp256k1)
+	require.NoError(t, err)
+	require.Equal(t, Secp256k1, secp.GetAlgo())
+
+	info, err := kb.CreateAccount("ed", mnemonic, "", pw, 0, 0, Ed25519)
+	require.NoError(t, err)
+	require.Equal(t, Ed25519, info.GetAlgo())
+	require.IsType(t, ed25519.PubKeyEd25519{}, info.GetPubKey())
+	require.NotEqual(t, secp.GetAddress(), info.GetAddress())
+
+	// derivation is deterministic and follows the path
+	same, err := kb.Derive("ed-same", mnemonic, "", pw, *hd.NewFundraiserParams(0, sdk.CoinType, 0), Ed25519)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), same.GetPubKey())
+	other, err := kb.Derive("ed-other", mnemonic, "", pw, *hd.NewFundraiserParams(0, sdk.CoinType, 1), Ed25519)
+	require.NoError(t, err)
+	require.NotEqual(t, info.GetPubKey(), other.GetPubKey())
+
+	_, err = kb.Derive("sr", mnemonic, "", pw, *hd.NewFundraiserParams(0, sdk.CoinType, 0), Sr25519)
+	require.Equal(t, ErrUnsupportedSigningAlgo, err)
+
+	// the derived key signs
+	msg := []byte("hello ed25519")
+	sig, pub, err := kb.Sign("ed", pw, msg)
+	require.NoError(t, err)
+	require.Equal(t, info.GetPubKey(), pub)
+	require.True(t, pub.VerifyBytes(msg, sig))
+
+	// the algo survives storage, armored export and import
+	stored, err := kb.Get("ed")
+	require.NoError(t, err)
+	require.Equal(t, Ed25519, stored.GetAlgo())
+
+	armor, err := kb.ExportPrivKey("ed", pw, "exportpw")
+	require.NoError(t, err)
+	require.NoError(t, kb.ImportPrivKey("ed-imported", armor, "exportpw"))
+	imported, err := kb.Get("ed-imported")
+	require.NoError(t, err)
+	require.Equal(t, Ed25519, imported.GetAlgo())
+	require.Equal(t, info.GetPubKey(), imported.GetPubKey())
+
+	pubArmor, err := kb.ExportPubKey("ed")
+	require.NoError(t, err)
+	require.NoError(t, kb.ImportPubKey("ed-offline", pubArmor))
+	offline, err := kb.Get("ed-offline")
+	require.NoError(t, err)
+	require.Equal(t, Ed25519, offline.GetAlgo())
+	require.Equal(t, info.GetAddress(), offline.GetAddress())
+}
@@ -0,0 +1,151 @@
+package keys
+
+import (
+	"sort"
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Keyring backends selectable with the --keyring-backend flag.
+const (
+	// BackendLevelDB stores all keys in a single LevelDB, the historical default.
+	BackendLevelDB = "leveldb"
+	// BackendFile stores every entry in its own scrypt/xsalsa20 encrypted JSON file.
+	BackendFile = "file"
+	// BackendMemory keeps the keys in memory only, it is meant for testing.
+	BackendMemory = "memory"
+)
+
+// KeyringBackend is the storage a keybase persists its serialized key infos
+// and address index into. Values are opaque to the backend.
+type KeyringBackend interface {
+	// Get returns the value stored under key, or nil if there is none.
+	Get(key []byte) ([]byte, error)
+	// Set stores the value under key, overwriting any previous value.
+	Set(key []byte, value []byte) error
+	// Delete removes the key, it is not an error if the key does not exist.
+	Delete(key []byte) error
+	// Keys returns all the stored keys in ascending order.
+	Keys() ([][]byte, error)
+	// Close releases the resources held by the backend.
+	Close() error
+}
+
+// NewKeyring creates a keybase persisting its keys into the given backend.
+func NewKeyring(backend KeyringBackend) Keybase {
+	return dbKeybase{store: backend}
+}
+
+// IsKeyringBackend returns true if the name is a known keyring backend.
+func IsKeyringBackend(name string) bool {
+	switch name {
+	case BackendLevelDB, BackendFile, BackendMemory:
+		return true
+	default:
+		return false
+	}
+}
+
+var _ KeyringBackend = dbBackend{}
+
+// dbBackend stores the keys in a tendermint DB.
+type dbBackend struct {
+	db dbm.DB
+}
+
+// NewDBBackend returns a keyring backend on top of a tendermint DB.
+func NewDBBackend(db dbm.DB) KeyringBackend {
+	return dbBackend{db: db}
+}
+
+func (b dbBackend) Get(key []byte) ([]byte, error) {
+	return b.db.Get(key), nil
+}
+
+func (b dbBackend) Set(key []byte, value []byte) error {
+	b.db.SetSync(key, value)
+	return nil
+}
+
+func (b dbBackend) Delete(key []byte) error {
+	b.db.DeleteSync(key)
+	return nil
+}
+
+func (b dbBackend) Keys() ([][]byte, error) {
+	var keys [][]byte
+	iter := b.db.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	return keys, nil
+}
+
+func (b dbBackend) Close() error {
+	b.db.Close()
+	return nil
+}
+
+var _ KeyringBackend = (*memBackend)(nil)
+
+// memBackend keeps the keys in a map, it is safe for concurrent use.
+type memBackend struct {
+	mtx     sync.RWMutex
+	entries map[string][]byte
+}
+
+// NewInMemoryBackend returns a keyring backend that keeps the keys in memory
+// only, useful for testing purposes.
+func NewInMemoryBackend() KeyringBackend {
+	return &memBackend{entries: make(map[string][]byte)}
+}
+
+func (b *memBackend) Get(key []byte) ([]byte, error) {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+
+	value, ok := b.entries[string(key)]
+	if !ok {
+		return nil, nil
+	}
+	return append([]byte{}, value...), nil
+}
+
+func (b *memBackend) Set(key []byte, value []byte) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	b.entries[string(key)] = append([]byte{}, value...)
+	return nil
+}
+
+func (b *memBackend) Delete(key []byte) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	delete(b.entries, string(key))
+	return nil
+}
+
+func (b *memBackend) Keys() ([][]byte, error) {
+	b.mtx.RLock()
+	defer b.mtx.RUnlock()
+
+	names := make([]string, 0, len(b.entries))
+	for name := range b.entries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	keys := make([][]byte, len(names))
+	for i, name := range names {
+		keys[i] = []byte(name)
+	}
+	return keys, nil
+}
+
+func (b *memBackend) Close() error {
+	return nil
+}
@@ -0,0 +1,311 @@
+package keys
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/crypto/scrypt"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/xsalsa20symmetric"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+const (
+	keyringFileExt     = ".json"
+	keyringFileVersion = 1
+	keyringKDF         = "scrypt"
+	keyringSaltLen     = 32
+	keyringSecretLen   = 32
+)
+
+// KeyringScryptN is the scrypt CPU/memory cost of the file keyring encryption
+// key. It is stored in every file, so changing it only affects new files.
+var KeyringScryptN = 1 << 15
+
+// ErrInvalidKeyringPassphrase is raised when the passphrase does not decrypt
+// the files of the keyring.
+var ErrInvalidKeyringPassphrase = errors.New("invalid keyring passphrase")
+
+// KeyringPassphraseFunc returns the passphrase of a file keyring, create is
+// true when the keyring holds no entries yet and the passphrase is a new one.
+type KeyringPassphraseFunc func(create bool) (string, error)
+
+// keyringFile is the JSON content of a file keyring entry. Only the data is
+// encrypted, the key is kept in clear so the directory can be audited.
+type keyringFile struct {
+	Version int    `json:"version"`
+	Key     string `json:"key"`
+	KDF     string `json:"kdf"`
+	N       int    `json:"n"`
+	R       int    `json:"r"`
+	P       int    `json:"p"`
+	Salt    []byte `json:"salt"`
+	Data    []byte `json:"data"`
+}
+
+// keyringSecret is an encryption key derived from the keyring passphrase.
+type keyringSecret struct {
+	n, r, p int
+	salt    []byte
+	key     []byte
+}
+
+var _ KeyringBackend = (*fileBackend)(nil)
+
+// fileBackend stores every entry in its own file in a directory, the data
+// being encrypted with xsalsa20 under a key derived by scrypt from a single
+// keyring passphrase. The passphrase is asked for on first access only.
+type fileBackend struct {
+	dir        string
+	passphrase KeyringPassphraseFunc
+
+	mtx     sync.Mutex
+	pass    string
+	secret  *keyringSecret
+	secrets map[string]*keyringSecret
+}
+
+// NewFileBackend returns a keyring backend that stores its entries as
+// encrypted JSON files in dir.
+func NewFileBackend(dir string, passphrase KeyringPassphraseFunc) (KeyringBackend, error) {
+	if err := cmn.EnsureDir(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create keyring directory: %s", err)
+	}
+
+	return &fileBackend{
+		dir:        dir,
+		passphrase: passphrase,
+		secrets:    make(map[string]*keyringSecret),
+	}, nil
+}
+
+func (b *fileBackend) Get(key []byte) ([]byte, error) {
+	bz, err := ioutil.ReadFile(b.path(key))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if err := b.unlockLocked(); err != nil {
+		return nil, err
+	}
+	return b.decrypt(bz)
+}
+
+func (b *fileBackend) Set(key []byte, value []byte) error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if err := b.unlockLocked(); err != nil {
+		return err
+	}
+
+	file := keyringFile{
+		Version: keyringFileVersion,
+		Key:     string(key),
+		KDF:     keyringKDF,
+		N:       b.secret.n,
+		R:       b.secret.r,
+		P:       b.secret.p,
+		Salt:    b.secret.salt,
+		Data:    xsalsa20symmetric.EncryptSymmetric(value, b.secret.key),
+	}
+	bz, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so an entry is never left half written
+	tmp, err := ioutil.TempFile(b.dir, ".tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(bz); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), b.path(key))
+}
+
+func (b *fileBackend) Delete(key []byte) error {
+	err := os.Remove(b.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (b *fileBackend) Keys() ([][]byte, error) {
+	names, err := b.fileNames()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(names))
+	for _, name := range names {
+		key, err := url.PathUnescape(strings.TrimSuffix(name, keyringFileExt))
+		if err != nil {
+			return nil, fmt.Errorf("invalid keyring file name %s: %s", name, err)
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	res := make([][]byte, len(keys))
+	for i, key := range keys {
+		res[i] = []byte(key)
+	}
+	return res, nil
+}
+
+func (b *fileBackend) Close() error {
+	return nil
+}
+
+// path returns the file of the key, escaped so any key maps to a file name.
+func (b *fileBackend) path(key []byte) string {
+	return filepath.Join(b.dir, url.PathEscape(string(key))+keyringFileExt)
+}
+
+// fileNames returns the names of the entry files of the keyring directory.
+func (b *fileBackend) fileNames() ([]string, error) {
+	entries, err := ioutil.ReadDir(b.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") || !strings.HasSuffix(name, keyringFileExt) {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// unlockLocked asks for the keyring passphrase once. If the keyring already
+// holds entries, the passphrase is checked against one of them and new
+// entries reuse its salt, so that the key is derived only once.
+func (b *fileBackend) unlockLocked() error {
+	if b.secret != nil {
+		return nil
+	}
+
+	names, err := b.fileNames()
+	if err != nil {
+		return err
+	}
+
+	passphrase, err := b.passphrase(len(names) == 0)
+	if err != nil {
+		return err
+	}
+	b.pass = passphrase
+
+	if len(names) == 0 {
+		b.secret, err = newKeyringSecret(passphrase, crypto.CRandBytes(keyringSaltLen), KeyringScryptN, 8, 1)
+		if err != nil {
+			b.pass = ""
+		}
+		return err
+	}
+
+	file, err := readKeyringFile(filepath.Join(b.dir, names[0]))
+	if err != nil {
+		b.pass = ""
+		return err
+	}
+	secret, err := b.secretFor(file)
+	if err != nil {
+		b.pass = ""
+		return err
+	}
+	if _, err := xsalsa20symmetric.DecryptSymmetric(file.Data, secret.key); err != nil {
+		b.pass = ""
+		delete(b.secrets, secretID(file))
+		return ErrInvalidKeyringPassphrase
+	}
+	b.secret = secret
+	return nil
+}
+
+// decrypt returns the data of an entry file, the keyring must be unlocked.
+func (b *fileBackend) decrypt(bz []byte) ([]byte, error) {
+	var file keyringFile
+	if err := json.Unmarshal(bz, &file); err != nil {
+		return nil, errors.Wrap(err, "invalid keyring file")
+	}
+	secret, err := b.secretFor(file)
+	if err != nil {
+		return nil, err
+	}
+	value, err := xsalsa20symmetric.DecryptSymmetric(file.Data, secret.key)
+	if err != nil {
+		return nil, ErrInvalidKeyringPassphrase
+	}
+	return value, nil
+}
+
+// secretFor derives the key of a file from the passphrase, caching it by
+// its scrypt parameters and salt.
+func (b *fileBackend) secretFor(file keyringFile) (*keyringSecret, error) {
+	if file.KDF != keyringKDF {
+		return nil, fmt.Errorf("unsupported keyring kdf %q", file.KDF)
+	}
+
+	id := secretID(file)
+	if secret, ok := b.secrets[id]; ok {
+		return secret, nil
+	}
+	secret, err := newKeyringSecret(b.pass, file.Salt, file.N, file.R, file.P)
+	if err != nil {
+		return nil, err
+	}
+	b.secrets[id] = secret
+	return secret, nil
+}
+
+func newKeyringSecret(passphrase string, salt []byte, n, r, p int) (*keyringSecret, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, n, r, p, keyringSecretLen)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to derive the keyring key")
+	}
+	return &keyringSecret{n: n, r: r, p: p, salt: salt, key: key}, nil
+}
+
+func readKeyringFile(path string) (file keyringFile, err error) {
+	bz, err := ioutil.ReadFile(path)
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(bz, &file); err != nil {
+		err = errors.Wrap(err, "invalid keyring file")
+	}
+	return
+}
+
+func secretID(file keyringFile) string {
+	return fmt.Sprintf("%d/%d/%d/%X", file.N, file.R, file.P, file.Salt)
+}
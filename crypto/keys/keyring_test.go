@@ -0,0 +1,148 @@
+package keys
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pocblockchain/pocc/tests"
+)
+
+func init() {
+	KeyringScryptN = 1 << 4
+}
+
+func staticPassphrase(passphrase string, calls *int, creates *int) KeyringPassphraseFunc {
+	return func(create bool) (string, error) {
+		*calls++
+		if create {
+			*creates++
+		}
+		return passphrase, nil
+	}
+}
+
+func TestFileBackend(t *testing.T) {
+	dir, cleanup := tests.NewTestCaseDir(t)
+	defer cleanup()
+
+	var calls, creates int
+	fb, err := NewFileBackend(dir, staticPassphrase("keyringpass", &calls, &creates))
+	require.NoError(t, err)
+
+	// missing keys do not unlock the keyring
+	bz, err := fb.Get([]byte("missing"))
+	require.NoError(t, err)
+	require.Nil(t, bz)
+	require.Equal(t, 0, calls)
+
+	require.NoError(t, fb.Set([]byte("a/b.info"), []byte("secret value")))
+	require.NoError(t, fb.Set([]byte("c.address"), []byte("a/b.info")))
+	require.Equal(t, 1, calls)
+	require.Equal(t, 1, creates)
+
+	bz, err = fb.Get([]byte("a/b.info"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret value"), bz)
+	require.Equal(t, 1, calls)
+
+	keys, err := fb.Keys()
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("a/b.info"), []byte("c.address")}, keys)
+
+	// every entry is a separate JSON file with the key in clear and the data encrypted
+	raw, err := ioutil.ReadFile(filepath.Join(dir, "a%2Fb.info.json"))
+	require.NoError(t, err)
+	require.False(t, strings.Contains(string(raw), "secret value"))
+	var file keyringFile
+	require.NoError(t, json.Unmarshal(raw, &file))
+	require.Equal(t, "a/b.info", file.Key)
+	require.Equal(t, "scrypt", file.KDF)
+	require.Equal(t, KeyringScryptN, file.N)
+
+	require.NoError(t, fb.Delete([]byte("c.address")))
+	require.NoError(t, fb.Delete([]byte("c.address")))
+	keys, err = fb.Keys()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	// a new backend on the same dir checks the passphrase
+	var calls2, creates2 int
+	wrong, err := NewFileBackend(dir, staticPassphrase("wrongpass", &calls2, &creates2))
+	require.NoError(t, err)
+	_, err = wrong.Get([]byte("a/b.info"))
+	require.Equal(t, ErrInvalidKeyringPassphrase, err)
+	require.Equal(t, ErrInvalidKeyringPassphrase, wrong.Set([]byte("d.info"), []byte("value")))
+	require.Equal(t, 0, creates2)
+
+	right, err := NewFileBackend(dir, staticPassphrase("keyringpass", &calls2, &creates2))
+	require.NoError(t, err)
+	bz, err = right.Get([]byte("a/b.info"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("secret value"), bz)
+}
+
+func TestKeyringBackends(t *testing.T) {
+	dir, cleanup := tests.NewTestCaseDir(t)
+	defer cleanup()
+
+	var calls, creates int
+	fb, err := NewFileBackend(dir, staticPassphrase("keyringpass", &calls, &creates))
+	require.NoError(t, err)
+
+	backends := map[string]KeyringBackend{
+		BackendFile:   fb,
+		BackendMemory: NewInMemoryBackend(),
+	}
+	mnemonic := "lounge degree orphan snap fox prefer rail jealous rebuild flock mistake spell put know peace skate game laptop mixture amount unique fan sound gallery"
+	for name, backend := range backends {
+		t.Run(name, func(t *testing.T) {
+			kb := NewKeyring(backend)
+
+			alice, err := kb.CreateAccount("alice", mnemonic, "", "12345678", 0, 0, Secp256k1)
+			require.NoError(t, err)
+			bob, _, err := kb.CreateMnemonic("bob", English, "12345678", Ed25519)
+			require.NoError(t, err)
+
+			infos, err := kb.List()
+			require.NoError(t, err)
+			require.Len(t, infos, 2)
+			require.Equal(t, "alice", infos[0].GetName())
+			require.Equal(t, "bob", infos[1].GetName())
+
+			info, err := kb.GetByAddress(bob.GetAddress())
+			require.NoError(t, err)
+			require.Equal(t, "bob", info.GetName())
+
+			msg := []byte("hello keyring")
+			sig, pub, err := kb.Sign("alice", "12345678", msg)
+			require.NoError(t, err)
+			require.Equal(t, alice.GetPubKey(), pub)
+			require.True(t, pub.VerifyBytes(msg, sig))
+
+			// keys move between keyrings as armored infos
+			armor, err := kb.Export("alice")
+			require.NoError(t, err)
+			other := NewInMemory()
+			require.NoError(t, other.Import("alice", armor))
+			info, err = other.GetByAddress(alice.GetAddress())
+			require.NoError(t, err)
+			require.Equal(t, alice.GetPubKey(), info.GetPubKey())
+
+			require.NoError(t, kb.Update("alice", "12345678", func() (string, error) { return "87654321", nil }))
+			_, _, err = kb.Sign("alice", "87654321", msg)
+			require.NoError(t, err)
+
+			require.NoError(t, kb.Delete("alice", "87654321", false))
+			_, err = kb.Get("alice")
+			require.Error(t, err)
+			_, err = kb.GetByAddress(alice.GetAddress())
+			require.Error(t, err)
+		})
+	}
+	require.Equal(t, 1, calls)
+}
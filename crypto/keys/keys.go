@@ -1,5 +1,11 @@
 package keys
 
+import (
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+)
+
 // SigningAlgo defines an algorithm to derive key-pairs which can be used for cryptographic signing.
 type SigningAlgo string
 
@@ -7,6 +13,23 @@ const (
 	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
 	Secp256k1 = SigningAlgo("secp256k1")
 	// Ed25519 represents the Ed25519 signature system.
-	// It is currently not supported for end-user keys (wallets/ledgers).
+	// Its keys are derived from the mnemonic as specified by SLIP-0010, Ledger devices do not support it.
 	Ed25519 = SigningAlgo("ed25519")
+	// Sr25519 represents the Schnorrkel signature system on Ristretto.
+	// It is currently not supported as the tendermint crypto library does not implement it.
+	Sr25519 = SigningAlgo("sr25519")
+	// MultiAlgo is the algo of multisig keys, which cannot be derived.
+	MultiAlgo = SigningAlgo("multi")
 )
+
+// pubKeyAlgo returns the signing algo of a public key.
+func pubKeyAlgo(pub crypto.PubKey) SigningAlgo {
+	switch pub.(type) {
+	case ed25519.PubKeyEd25519:
+		return Ed25519
+	case multisig.PubKeyMultisigThreshold:
+		return MultiAlgo
+	default:
+		return Secp256k1
+	}
+}
@@ -25,14 +25,14 @@ type Keybase interface {
 	// key from that.
 	CreateMnemonic(name string, language Language, passwd string, algo SigningAlgo) (info Info, seed string, err error)
 
-	// CreateAccount creates an account based using the BIP44 path (44'/11111'/{account}'/0/{index}
-	CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd string, account uint32, index uint32) (Info, error)
+	// CreateAccount creates an account of the algo based using the BIP44 path (44'/11111'/{account}'/0/{index}
+	CreateAccount(name, mnemonic, bip39Passwd, encryptPasswd string, account uint32, index uint32, algo SigningAlgo) (Info, error)
 
 	// Derive computes a BIP39 seed from th mnemonic and bip39Passwd.
-	// Derive private key from the seed using the BIP44 params.
+	// Derive private key of the algo from the seed using the BIP44 params.
 	// Encrypt the key to disk using encryptPasswd.
 	// See https://github.com/pocblockchain/pocc/issues/2095
-	Derive(name, mnemonic, bip39Passwd, encryptPasswd string, params hd.BIP44Params) (Info, error)
+	Derive(name, mnemonic, bip39Passwd, encryptPasswd string, params hd.BIP44Params, algo SigningAlgo) (Info, error)
 
 	// CreateLedger creates, stores, and returns a new Ledger key reference
 	CreateLedger(name string, algo SigningAlgo, hrp string, account, index uint32) (info Info, err error)
@@ -94,6 +94,8 @@ type Info interface {
 	GetAddress() types.AccAddress
 	// Bip44 Path
 	GetPath() (*hd.BIP44Params, error)
+	// Signing algo of the key
+	GetAlgo() SigningAlgo
 }
 
 var (
@@ -108,6 +110,7 @@ type localInfo struct {
 	Name         string        `json:"name"`
 	PubKey       crypto.PubKey `json:"pubkey"`
 	PrivKeyArmor string        `json:"privkey.armor"`
+	Algo         SigningAlgo   `json:"algo"`
 }
 
 func newLocalInfo(name string, pub crypto.PubKey, privArmor string) Info {
@@ -115,6 +118,7 @@ func newLocalInfo(name string, pub crypto.PubKey, privArmor string) Info {
 		Name:         name,
 		PubKey:       pub,
 		PrivKeyArmor: privArmor,
+		Algo:         pubKeyAlgo(pub),
 	}
 }
 
@@ -143,11 +147,17 @@ func (i localInfo) GetPath() (*hd.BIP44Params, error) {
 	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
 }
 
+// GetAlgo implements Info interface
+func (i localInfo) GetAlgo() SigningAlgo {
+	return infoAlgo(i.Algo, i.PubKey)
+}
+
 // ledgerInfo is the public information about a Ledger key
 type ledgerInfo struct {
 	Name   string         `json:"name"`
 	PubKey crypto.PubKey  `json:"pubkey"`
 	Path   hd.BIP44Params `json:"path"`
+	Algo   SigningAlgo    `json:"algo"`
 }
 
 func newLedgerInfo(name string, pub crypto.PubKey, path hd.BIP44Params) Info {
@@ -155,6 +165,7 @@ func newLedgerInfo(name string, pub crypto.PubKey, path hd.BIP44Params) Info {
 		Name:   name,
 		PubKey: pub,
 		Path:   path,
+		Algo:   pubKeyAlgo(pub),
 	}
 }
 
@@ -184,16 +195,23 @@ func (i ledgerInfo) GetPath() (*hd.BIP44Params, error) {
 	return &tmp, nil
 }
 
+// GetAlgo implements Info interface
+func (i ledgerInfo) GetAlgo() SigningAlgo {
+	return infoAlgo(i.Algo, i.PubKey)
+}
+
 // offlineInfo is the public information about an offline key
 type offlineInfo struct {
 	Name   string        `json:"name"`
 	PubKey crypto.PubKey `json:"pubkey"`
+	Algo   SigningAlgo   `json:"algo"`
 }
 
 func newOfflineInfo(name string, pub crypto.PubKey) Info {
 	return &offlineInfo{
 		Name:   name,
 		PubKey: pub,
+		Algo:   pubKeyAlgo(pub),
 	}
 }
 
@@ -222,6 +240,11 @@ func (i offlineInfo) GetPath() (*hd.BIP44Params, error) {
 	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
 }
 
+// GetAlgo implements Info interface
+func (i offlineInfo) GetAlgo() SigningAlgo {
+	return infoAlgo(i.Algo, i.PubKey)
+}
+
 type multisigPubKeyInfo struct {
 	PubKey crypto.PubKey `json:"pubkey"`
 	Weight uint          `json:"weight"`
@@ -278,6 +301,20 @@ func (i multiInfo) GetPath() (*hd.BIP44Params, error) {
 	return nil, fmt.Errorf("BIP44 Paths are not available for this type")
 }
 
+// GetAlgo implements Info interface
+func (i multiInfo) GetAlgo() SigningAlgo {
+	return MultiAlgo
+}
+
+// infoAlgo returns the stored algo of a key, the keys stored before the algo
+// was recorded get it from their public key
+func infoAlgo(algo SigningAlgo, pub crypto.PubKey) SigningAlgo {
+	if algo == "" {
+		return pubKeyAlgo(pub)
+	}
+	return algo
+}
+
 // encoding info
 func writeInfo(i Info) []byte {
 	return cdc.MustMarshalBinaryLengthPrefixed(i)
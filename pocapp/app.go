@@ -28,6 +28,8 @@ import (
 	"github.com/pocblockchain/pocc/x/staking"
 	"github.com/pocblockchain/pocc/x/supply"
 	"github.com/pocblockchain/pocc/x/token"
+	"github.com/pocblockchain/pocc/x/upgrade"
+	upgradeclient "github.com/pocblockchain/pocc/x/upgrade/client"
 )
 
 const appName = "PocApp"
@@ -50,12 +52,15 @@ var (
 		staking.AppModuleBasic{},
 		mint.AppModuleBasic{},
 		distr.AppModuleBasic{},
-		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler, token.TokenParamsChangeProposalHandler, token.DisableTokenProposalHandler),
+		gov.NewAppModuleBasic(paramsclient.ProposalHandler, distr.ProposalHandler, token.TokenParamsChangeProposalHandler, token.DisableTokenProposalHandler, token.FeeTokenProposalHandler,
+			token.EnableTokenProposalHandler, token.DelistTokenProposalHandler, token.ReserveSymbolProposalHandler, token.UnreserveSymbolProposalHandler,
+			upgradeclient.ProposalHandler, upgradeclient.CancelProposalHandler),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
 		slashing.AppModuleBasic{},
 		supply.AppModuleBasic{},
 		token.AppModuleBasic{},
+		upgrade.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -67,6 +72,10 @@ var (
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
 		token.ModuleName:          {supply.Minter, supply.Burner},
+		bank.EscrowAccountName:    nil,
+		bank.HTLCAccountName:      nil,
+		bank.ScheduleAccountName:  nil,
+		token.AirdropAccountName:  nil,
 	}
 )
 
@@ -102,6 +111,7 @@ type PocApp struct {
 	crisisKeeper   crisis.Keeper
 	paramsKeeper   params.Keeper
 	tokenKeeper    token.Keeper
+	upgradeKeeper  upgrade.Keeper
 
 	// the module manager
 	mm *module.Manager
@@ -121,7 +131,7 @@ func NewPocApp(
 
 	keys := sdk.NewKVStoreKeys(bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
-		gov.StoreKey, params.StoreKey, token.StoreKey)
+		gov.StoreKey, params.StoreKey, token.StoreKey, bank.StoreKey, upgrade.StoreKey)
 	tkeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
 	app := &PocApp{
@@ -146,7 +156,13 @@ func NewPocApp(
 
 	// add keepers
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
-	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper, nil, bankSubspace, bank.DefaultCodespace, app.ModuleAccountAddrs())
+	// the bank keeper is passed by value to the supply keeper, so the token and
+	// supply keepers it checks freezes and escrows against must be set before,
+	// by reference since they are only built below
+	bk := bank.NewBaseKeeper(app.accountKeeper, nil, keys[bank.StoreKey], bankSubspace, bank.DefaultCodespace, app.ModuleAccountAddrs())
+	bk.SetTokenKeeper(&app.tokenKeeper)
+	bk.SetSupplyKeeper(&app.supplyKeeper)
+	app.bankKeeper = bk
 	app.supplyKeeper = supply.NewKeeper(app.cdc, keys[supply.StoreKey], app.accountKeeper, app.bankKeeper, maccPerms)
 	stakingKeeper := staking.NewKeeper(app.cdc, keys[staking.StoreKey], tkeys[staking.TStoreKey],
 		app.supplyKeeper, stakingSubspace, staking.DefaultCodespace)
@@ -156,19 +172,18 @@ func NewPocApp(
 	app.slashingKeeper = slashing.NewKeeper(app.cdc, keys[slashing.StoreKey], &stakingKeeper,
 		slashingSubspace, slashing.DefaultCodespace)
 	app.crisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.supplyKeeper, auth.FeeCollectorName)
-	app.tokenKeeper = token.NewKeeper(app.cdc, keys[token.StoreKey], app.distrKeeper, app.supplyKeeper, tokenSubspace)
-
-	//set tokenKeeper in bank keeper
-	bk := app.bankKeeper.(bank.BaseKeeper)
-	bk.SetTokenKeeper(&app.tokenKeeper)
-	app.bankKeeper = bk
+	app.tokenKeeper = token.NewKeeper(app.cdc, keys[token.StoreKey], app.accountKeeper, app.distrKeeper, app.supplyKeeper, tokenSubspace,
+		app.ModuleAccountAddrs())
+	app.upgradeKeeper = upgrade.NewKeeper(app.cdc, keys[upgrade.StoreKey], upgrade.DefaultCodespace)
+	app.registerUpgradeHandlers()
 
 	// register the proposal types
 	govRouter := gov.NewRouter()
 	govRouter.AddRoute(gov.RouterKey, gov.ProposalHandler).
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
-		AddRoute(token.RouterKey, token.NewTokenProposalHandler(app.tokenKeeper))
+		AddRoute(token.RouterKey, token.NewTokenProposalHandler(app.tokenKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
 	app.govKeeper = gov.NewKeeper(app.cdc, keys[gov.StoreKey], app.paramsKeeper, govSubspace,
 		app.supplyKeeper, &stakingKeeper, gov.DefaultCodespace, govRouter)
 
@@ -193,21 +208,26 @@ func NewPocApp(
 		slashing.NewAppModule(app.slashingKeeper, app.stakingKeeper),
 		staking.NewAppModule(app.stakingKeeper, app.distrKeeper, app.accountKeeper, app.supplyKeeper),
 		token.NewAppModule(app.tokenKeeper),
+		upgrade.NewAppModule(app.upgradeKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
+	// The upgrade module must come first so that no module runs the old code
+	// on the block an upgrade is applied at.
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName, token.ModuleName)
 
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, bank.ModuleName, token.ModuleName)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
+	// The token module must occur before crisis so that the token invariants
+	// asserted at genesis find the genesis token infos.
 	app.mm.SetOrderInitGenesis(
 		genaccounts.ModuleName, distr.ModuleName, staking.ModuleName,
-		auth.ModuleName, bank.ModuleName, slashing.ModuleName, gov.ModuleName,
-		mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName, token.ModuleName,
+		auth.ModuleName, slashing.ModuleName, gov.ModuleName, bank.ModuleName,
+		mint.ModuleName, supply.ModuleName, token.ModuleName, crisis.ModuleName, genutil.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -220,7 +240,7 @@ func NewPocApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, &app.tokenKeeper, auth.DefaultSigVerificationGasConsumer))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -4,12 +4,18 @@ import (
 	"github.com/pocblockchain/pocc/x/auth"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/pocblockchain/pocc/codec"
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/bank"
+	"github.com/pocblockchain/pocc/x/token"
+	tokentypes "github.com/pocblockchain/pocc/x/token/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -108,3 +114,134 @@ func TestStdSignedMarshal1(t *testing.T) {
 		require.Nil(t, err)
 	}
 }
+
+// ensure that frozen balances cannot leave the account through the supply keeper
+func TestFrozenAccountThroughSupply(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewPocApp(log.NewNopLogger(), db, nil, true, 0)
+
+	stateBytes, err := codec.MarshalJSONIndent(app.cdc, NewDefaultGenesisState())
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		Validators:    []abci.ValidatorUpdate{},
+		AppStateBytes: stateBytes,
+	})
+	app.Commit()
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(1000)))
+	tokenInfo := sdk.NewTokenInfo("abc", addr.String(), true, 18, sdk.NewInt(1000))
+	tokenInfo.Burnable = true
+	tokenInfo.Freezable = true
+	app.tokenKeeper.SetTokenInfo(ctx, tokenInfo)
+	app.accountKeeper.SetAccount(ctx, app.accountKeeper.NewAccountWithAddress(ctx, addr))
+	require.NoError(t, app.bankKeeper.SetCoins(ctx, addr, coins))
+	app.tokenKeeper.FreezeAccount(ctx, "abc", addr)
+
+	sdkErr := app.supplyKeeper.SendCoinsFromAccountToModule(ctx, addr, token.ModuleName, coins)
+	require.Error(t, sdkErr)
+	require.Equal(t, bank.CodeAccountFrozen, sdkErr.Code())
+
+	_, sdkErr = app.bankKeeper.CreateEscrow(ctx, addr, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		coins, ctx.BlockHeight()+10, time.Time{})
+	require.Error(t, sdkErr)
+	require.Equal(t, bank.CodeAccountFrozen, sdkErr.Code())
+
+	handler := token.NewHandler(app.tokenKeeper)
+	res := handler(ctx, tokentypes.NewMsgBurnToken(addr, coins))
+	require.Equal(t, bank.CodeAccountFrozen, res.Code)
+	require.Equal(t, coins, app.bankKeeper.GetCoins(ctx, addr))
+
+	app.tokenKeeper.UnfreezeAccount(ctx, "abc", addr)
+	res = handler(ctx, tokentypes.NewMsgBurnToken(addr, coins))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, app.bankKeeper.GetCoins(ctx, addr).IsZero())
+}
+
+// ensure that a frozen module account can not halt the chain by failing the refunds of the EndBlock
+func TestFrozenEscrowAccountRefund(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewPocApp(log.NewNopLogger(), db, nil, true, 0)
+
+	stateBytes, err := codec.MarshalJSONIndent(app.cdc, NewDefaultGenesisState())
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		Validators:    []abci.ValidatorUpdate{},
+		AppStateBytes: stateBytes,
+	})
+	app.Commit()
+
+	now := time.Unix(1000, 0).UTC()
+	header := abci.Header{Height: app.LastBlockHeight() + 1, Time: now}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(1000)))
+	tokenInfo := sdk.NewTokenInfo("abc", addr.String(), true, 18, sdk.NewInt(1000))
+	tokenInfo.Freezable = true
+	app.tokenKeeper.SetTokenInfo(ctx, tokenInfo)
+	app.accountKeeper.SetAccount(ctx, app.accountKeeper.NewAccountWithAddress(ctx, addr))
+	require.NoError(t, app.bankKeeper.SetCoins(ctx, addr, coins))
+
+	_, sdkErr := app.bankKeeper.CreateEscrow(ctx, addr, sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+		coins, 0, now.Add(time.Hour))
+	require.NoError(t, sdkErr)
+
+	// the issuer can not freeze the escrow module account, which is not frozen even if forced
+	escrowAddr := app.supplyKeeper.GetModuleAddress(bank.EscrowAccountName)
+	res := token.NewHandler(app.tokenKeeper)(ctx, tokentypes.NewMsgFreezeAccount(addr, escrowAddr, "abc"))
+	require.Equal(t, sdk.CodeUnauthorized, res.Code)
+	app.tokenKeeper.FreezeAccount(ctx, "abc", escrowAddr)
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	header = abci.Header{Height: app.LastBlockHeight() + 1, Time: now.Add(time.Hour + bank.EscrowReclaimPeriod)}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	ctx = app.BaseApp.NewContext(true, header)
+	require.Equal(t, coins, app.bankKeeper.GetCoins(ctx, addr))
+	require.True(t, app.bankKeeper.GetCoins(ctx, escrowAddr).IsZero())
+}
+
+// ensure that the airdrops of the genesis keep the supply and the holder index consistent
+func TestAirdropGenesisInvariants(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewPocApp(log.NewNopLogger(), db, nil, true, 0)
+
+	genesisState := NewDefaultGenesisState()
+	var tokenGenesis token.GenesisState
+	app.cdc.MustUnmarshalJSON(genesisState[token.ModuleName], &tokenGenesis)
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	amount := sdk.NewCoin(sdk.NativeToken, sdk.NewInt(1000))
+	tokenGenesis.NextAirdropID = 2
+	tokenGenesis.Airdrops = token.Airdrops{token.NewAirdrop(1, issuer, amount, make([]byte, 32), 2, time.Unix(1000, 0).UTC())}
+	genesisState[token.ModuleName] = app.cdc.MustMarshalJSON(tokenGenesis)
+
+	stateBytes, err := codec.MarshalJSONIndent(app.cdc, genesisState)
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		Validators:    []abci.ValidatorUpdate{},
+		AppStateBytes: stateBytes,
+	})
+	app.Commit()
+
+	header := abci.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	airdropAddr := app.supplyKeeper.GetModuleAddress(token.AirdropAccountName)
+	require.Equal(t, sdk.NewCoins(amount), app.supplyKeeper.GetModuleAccount(ctx, token.AirdropAccountName).GetCoins())
+	require.Equal(t, amount.Amount, app.bankKeeper.GetHolderBalance(ctx, sdk.NativeToken, airdropAddr))
+
+	for _, invar := range app.crisisKeeper.Invariants() {
+		msg, broken := invar(ctx)
+		require.False(t, broken, msg)
+	}
+}
@@ -20,4 +20,17 @@ const (
 	OpWeightMsgUndelegate                              = "op_weight_msg_undelegate"
 	OpWeightMsgBeginRedelegate                         = "op_weight_msg_begin_redelegate"
 	OpWeightMsgUnjail                                  = "op_weight_msg_unjail"
+	OpWeightMsgEscrow                                  = "op_weight_msg_escrow"
+	OpWeightMsgReclaim                                 = "op_weight_msg_reclaim"
+	OpWeightMsgBonusSend                               = "op_weight_msg_bonus_send"
+	OpWeightMsgReclaimSend                             = "op_weight_msg_reclaim_send"
+	OpWeightMsgNewToken                                = "op_weight_msg_new_token"
+	OpWeightMsgInflateToken                            = "op_weight_msg_inflate_token"
+	OpWeightMsgBurnToken                               = "op_weight_msg_burn_token"
+	OpWeightSubmitVotingSlashingTokenParamsProposal    = "op_weight_submit_voting_slashing_token_params_change_proposal"
+	OpWeightSubmitVotingSlashingDisableTokenProposal   = "op_weight_submit_voting_slashing_disable_token_proposal"
+	OpWeightSubmitVotingSlashingEnableTokenProposal    = "op_weight_submit_voting_slashing_enable_token_proposal"
+	OpWeightSubmitVotingSlashingDelistTokenProposal    = "op_weight_submit_voting_slashing_delist_token_proposal"
+	OpWeightSubmitVotingSlashingReserveProposal        = "op_weight_submit_voting_slashing_reserve_symbol_proposal"
+	OpWeightSubmitVotingSlashingUnreserveProposal      = "op_weight_submit_voting_slashing_unreserve_symbol_proposal"
 )
@@ -0,0 +1,67 @@
+package pocapp
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pocblockchain/pocc/codec"
+	"github.com/pocblockchain/pocc/store/rootmulti"
+)
+
+func commitBlocks(app *PocApp, from, to int64) {
+	for height := from; height <= to; height++ {
+		header := abci.Header{Height: height}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+}
+
+func TestPocAppSnapshotRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pocapp-snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db := dbm.NewMemDB()
+	app := NewPocApp(log.NewNopLogger(), db, nil, true, 0)
+
+	stateBytes, err := codec.MarshalJSONIndent(app.cdc, NewDefaultGenesisState())
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		Validators:    []abci.ValidatorUpdate{},
+		AppStateBytes: stateBytes,
+	})
+	commitBlocks(app, 1, 5)
+
+	snapshotDir := filepath.Join(dir, "5")
+	manifest, err := rootmulti.WriteSnapshot(db, 0, snapshotDir, 4096)
+	require.NoError(t, err)
+	require.Equal(t, app.LastCommitID(), manifest.CommitID())
+
+	restoredDB := dbm.NewMemDB()
+	_, err = rootmulti.RestoreSnapshot(restoredDB, snapshotDir)
+	require.NoError(t, err)
+
+	restored := NewPocApp(log.NewNopLogger(), restoredDB, nil, true, 0)
+	require.Equal(t, app.LastCommitID(), restored.LastCommitID())
+
+	info := restored.Info(abci.RequestInfo{})
+	require.Equal(t, int64(5), info.LastBlockHeight)
+	require.Equal(t, manifest.Hash.Bytes(), info.LastBlockAppHash)
+
+	// both apps keep committing the same state
+	commitBlocks(app, 6, 7)
+	commitBlocks(restored, 6, 7)
+	require.Equal(t, app.LastCommitID(), restored.LastCommitID())
+
+	// a snapshot cannot be restored over committed state
+	_, err = rootmulti.RestoreSnapshot(restoredDB, snapshotDir)
+	require.Error(t, err)
+}
@@ -0,0 +1,14 @@
+package pocapp
+
+// registerUpgradeHandlers registers the handlers of the upgrade plans this
+// binary performs, see the upgrade module documentation. A handler must be
+// registered under the exact name of the plan approved by governance, and
+// only in the binary meant to run from the upgrade height, for instance:
+//
+//	app.upgradeKeeper.SetUpgradeHandler("v2", func(ctx sdk.Context, plan upgrade.Plan) {
+//		// migrate the stores of the modules changed by v2
+//	})
+//
+// A store added by an upgrade only needs its key to be mounted, it is created
+// empty at the upgrade height.
+func (app *PocApp) registerUpgradeHandlers() {}
@@ -0,0 +1,135 @@
+package server
+
+// DONTCOVER
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/pocblockchain/pocc/client/flags"
+	"github.com/pocblockchain/pocc/store/rootmulti"
+)
+
+const (
+	flagSnapshotDir = "dir"
+	flagChunkSize   = "chunk-size"
+)
+
+// SnapshotCmd returns the commands that write and restore snapshots of the
+// application state.
+func SnapshotCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Create and restore snapshots of the application state",
+	}
+
+	cmd.AddCommand(
+		SnapshotCreateCmd(ctx),
+		SnapshotRestoreCmd(ctx, appCreator),
+	)
+	return cmd
+}
+
+// SnapshotCreateCmd writes a snapshot of the application state at a committed
+// height.
+func SnapshotCreateCmd(ctx *Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Write a snapshot of the application state at a committed height",
+		Long: `Write a chunked, hashed snapshot of all the IAVL stores of the application
+at a committed height, which must not have been pruned. The node must be
+stopped. By default the snapshot is written to <home>/data/snapshots/<height>.
+
+Only the application state is included, the tendermint state and block store
+of the node are not.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			height := viper.GetInt64(flagHeight)
+			if height < 0 {
+				return fmt.Errorf("invalid height %d", height)
+			}
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			if height == 0 {
+				height = rootmulti.LatestVersion(db)
+			}
+			dir := viper.GetString(flagSnapshotDir)
+			if dir == "" {
+				dir = filepath.Join(config.RootDir, "data", "snapshots", strconv.FormatInt(height, 10))
+			}
+
+			manifest, err := rootmulti.WriteSnapshot(db, height, dir, viper.GetInt64(flagChunkSize))
+			if err != nil {
+				return fmt.Errorf("error writing snapshot: %v", err)
+			}
+
+			fmt.Printf("Wrote snapshot of height %d with app hash %X to %s (%d stores, %d chunks)\n",
+				manifest.Height, manifest.Hash, dir, len(manifest.Stores), len(manifest.Chunks))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Snapshot the state of a particular height (0 means latest height)")
+	cmd.Flags().String(flagSnapshotDir, "", "Directory to write the snapshot to (default <home>/data/snapshots/<height>)")
+	cmd.Flags().Int64(flagChunkSize, rootmulti.DefaultSnapshotChunkSize, "Maximum size in bytes of a snapshot chunk")
+	return cmd
+}
+
+// SnapshotRestoreCmd rebuilds the application state from a snapshot.
+func SnapshotRestoreCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore [snapshot-dir]",
+		Short: "Rebuild the application state from a snapshot",
+		Long: `Rebuild the application database from a snapshot written by "snapshot create".
+The node must be stopped and its application database must be empty. Every
+chunk and every tree node of the snapshot is verified, and the app hash of the
+restored application must match the commit the snapshot was taken at. A failed
+restore removes the state it wrote, so it can be retried once fixed.
+
+Only the application state is restored, the tendermint state and block store
+of the node must be provided separately.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			manifest, err := rootmulti.RestoreSnapshot(db, args[0])
+			if err != nil {
+				return fmt.Errorf("error restoring snapshot: %v", err)
+			}
+
+			app := appCreator(ctx.Logger, db, nil)
+			info := app.Info(abci.RequestInfo{})
+			commitID := manifest.CommitID()
+			if info.LastBlockHeight != commitID.Version || !bytes.Equal(info.LastBlockAppHash, commitID.Hash) {
+				return fmt.Errorf("restored app is at height %d with app hash %X, expected height %d with app hash %X",
+					info.LastBlockHeight, info.LastBlockAppHash, commitID.Version, commitID.Hash)
+			}
+
+			fmt.Printf("Restored snapshot of height %d with app hash %X\n", commitID.Version, commitID.Hash)
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,109 @@
+package server
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	bam "github.com/pocblockchain/pocc/baseapp"
+	"github.com/pocblockchain/pocc/client/flags"
+	"github.com/pocblockchain/pocc/server/mock"
+	"github.com/pocblockchain/pocc/store/rootmulti"
+	sdk "github.com/pocblockchain/pocc/types"
+)
+
+// newSnapshotTestApp creates an app with two stores initialized with the
+// genesis values of the mock app.
+func newSnapshotTestApp(logger log.Logger, db dbm.DB, _ io.Writer) abci.Application {
+	key, otherKey := sdk.NewKVStoreKey(bam.MainStoreKey), sdk.NewKVStoreKey("other")
+	app := bam.NewBaseApp("snapshot", logger, db, nil)
+	app.MountStores(key, otherKey)
+	app.SetInitChainer(func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		mock.InitChainer(otherKey)(ctx, req)
+		return mock.InitChainer(key)(ctx, req)
+	})
+	if err := app.LoadLatestVersion(key); err != nil {
+		panic(err)
+	}
+	return app
+}
+
+// writeSnapshotTestState commits a few blocks of the test app in home.
+func writeSnapshotTestState(t *testing.T, home string) abci.ResponseInfo {
+	db, err := openDB(home)
+	require.NoError(t, err)
+	defer db.Close()
+
+	app := newSnapshotTestApp(log.NewNopLogger(), db, nil)
+	appState, err := mock.AppGenState(nil, types.GenesisDoc{}, nil)
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	for height := int64(1); height <= 3; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+	return app.Info(abci.RequestInfo{})
+}
+
+func TestSnapshotRestoreCmdCorruptedChunk(t *testing.T) {
+	defer viper.Reset()
+
+	home, err := ioutil.TempDir("", "snapshot-home")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	info := writeSnapshotTestState(t, home)
+
+	ctx := NewContext(cfg.DefaultConfig(), log.NewNopLogger())
+	dir := filepath.Join(home, "snapshot")
+	viper.Set(flags.FlagHome, home)
+	viper.Set(flagSnapshotDir, dir)
+	// small chunks so the stream spans many of them
+	viper.Set(flagChunkSize, 64)
+	require.NoError(t, SnapshotCreateCmd(ctx).RunE(nil, nil))
+
+	manifest, err := rootmulti.ReadSnapshotManifest(dir)
+	require.NoError(t, err)
+	require.True(t, len(manifest.Chunks) > 2)
+
+	// the last chunk belongs to the last store, the first one is imported
+	// before the corruption is detected
+	path := filepath.Join(dir, manifest.Chunks[len(manifest.Chunks)-1].File)
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	bz[0] ^= 0xff
+	require.NoError(t, ioutil.WriteFile(path, bz, 0644))
+
+	restoreHome, err := ioutil.TempDir("", "snapshot-restore")
+	require.NoError(t, err)
+	defer os.RemoveAll(restoreHome)
+	viper.Set(flags.FlagHome, restoreHome)
+
+	restoreCmd := SnapshotRestoreCmd(ctx, newSnapshotTestApp)
+	err = restoreCmd.RunE(restoreCmd, []string{dir})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "corrupted")
+
+	// the failed restore leaves the application database empty, the restore
+	// succeeds once the chunk is repaired
+	bz[0] ^= 0xff
+	require.NoError(t, ioutil.WriteFile(path, bz, 0644))
+	require.NoError(t, restoreCmd.RunE(restoreCmd, []string{dir}))
+
+	db, err := openDB(restoreHome)
+	require.NoError(t, err)
+	defer db.Close()
+	restored := newSnapshotTestApp(log.NewNopLogger(), db, nil).Info(abci.RequestInfo{})
+	require.Equal(t, info.LastBlockHeight, restored.LastBlockHeight)
+	require.Equal(t, info.LastBlockAppHash, restored.LastBlockAppHash)
+}
@@ -142,6 +142,7 @@ func AddCommands(
 		flags.LineBreak,
 		tendermintCmd,
 		ExportCmd(ctx, cdc, appExport),
+		SnapshotCmd(ctx, appCreator),
 		flags.LineBreak,
 		version.Cmd,
 	)
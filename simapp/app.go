@@ -67,6 +67,10 @@ var (
 		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
 		gov.ModuleName:            {supply.Burner},
 		token.ModuleName:          {supply.Minter, supply.Burner},
+		bank.EscrowAccountName:    nil,
+		bank.HTLCAccountName:      nil,
+		bank.ScheduleAccountName:  nil,
+		token.AirdropAccountName:  nil,
 	}
 )
 
@@ -121,7 +125,7 @@ func NewSimApp(
 
 	keys := sdk.NewKVStoreKeys(bam.MainStoreKey, auth.StoreKey, staking.StoreKey,
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
-		gov.StoreKey, params.StoreKey, token.StoreKey)
+		gov.StoreKey, params.StoreKey, token.StoreKey, bank.StoreKey)
 	tkeys := sdk.NewTransientStoreKeys(staking.TStoreKey, params.TStoreKey)
 
 	app := &PocApp{
@@ -146,7 +150,7 @@ func NewSimApp(
 
 	// add keepers
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], authSubspace, auth.ProtoBaseAccount)
-	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper, nil, bankSubspace, bank.DefaultCodespace, app.ModuleAccountAddrs())
+	app.bankKeeper = bank.NewBaseKeeper(app.accountKeeper, nil, keys[bank.StoreKey], bankSubspace, bank.DefaultCodespace, app.ModuleAccountAddrs())
 	app.supplyKeeper = supply.NewKeeper(app.cdc, keys[supply.StoreKey], app.accountKeeper, app.bankKeeper, maccPerms)
 	stakingKeeper := staking.NewKeeper(app.cdc, keys[staking.StoreKey], tkeys[staking.TStoreKey],
 		app.supplyKeeper, stakingSubspace, staking.DefaultCodespace)
@@ -156,11 +160,13 @@ func NewSimApp(
 	app.slashingKeeper = slashing.NewKeeper(app.cdc, keys[slashing.StoreKey], &stakingKeeper,
 		slashingSubspace, slashing.DefaultCodespace)
 	app.crisisKeeper = crisis.NewKeeper(crisisSubspace, invCheckPeriod, app.supplyKeeper, auth.FeeCollectorName)
-	app.tokenKeeper = token.NewKeeper(app.cdc, keys[token.StoreKey], app.distrKeeper, app.supplyKeeper, tokenSubspace)
+	app.tokenKeeper = token.NewKeeper(app.cdc, keys[token.StoreKey], app.accountKeeper, app.distrKeeper, app.supplyKeeper, tokenSubspace,
+		app.ModuleAccountAddrs())
 
-	//set tokenKeeper in bank keeper
+	//set tokenKeeper and supplyKeeper in bank keeper
 	bk := app.bankKeeper.(bank.BaseKeeper)
 	bk.SetTokenKeeper(&app.tokenKeeper)
+	bk.SetSupplyKeeper(app.supplyKeeper)
 	app.bankKeeper = bk
 
 	// register the proposal types
@@ -197,16 +203,18 @@ func NewSimApp(
 	// During begin block slashing happens after distr.BeginBlocker so that
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
-	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName)
+	app.mm.SetOrderBeginBlockers(mint.ModuleName, distr.ModuleName, slashing.ModuleName, token.ModuleName)
 
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName, bank.ModuleName, token.ModuleName)
 
 	// NOTE: The genutils moodule must occur after staking so that pools are
 	// properly initialized with tokens from genesis accounts.
+	// The token module must occur before crisis so that the token invariants
+	// asserted at genesis find the genesis token infos.
 	app.mm.SetOrderInitGenesis(
 		genaccounts.ModuleName, distr.ModuleName, staking.ModuleName,
-		auth.ModuleName, bank.ModuleName, slashing.ModuleName, gov.ModuleName,
-		mint.ModuleName, supply.ModuleName, crisis.ModuleName, genutil.ModuleName, token.ModuleName,
+		auth.ModuleName, slashing.ModuleName, gov.ModuleName, bank.ModuleName,
+		mint.ModuleName, supply.ModuleName, token.ModuleName, crisis.ModuleName, genutil.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -219,7 +227,7 @@ func NewSimApp(
 	// initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.supplyKeeper, &app.tokenKeeper, auth.DefaultSigVerificationGasConsumer))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
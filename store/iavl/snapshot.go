@@ -0,0 +1,242 @@
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/go-amino"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Layout of the nodes and roots persisted by the iavl nodeDB, which does not
+// expose them. Snapshots copy the persisted nodes as is so that the restored
+// trees, including the node versions, hash exactly like the original ones.
+var (
+	nodeKeyFormat = iavl.NewKeyFormat('n', tmhash.Size) // n<hash>
+	rootKeyFormat = iavl.NewKeyFormat('r', 8)           // r<version>
+)
+
+// importBatchSize is the number of nodes written per batch when importing.
+const importBatchSize = 10000
+
+// ExportNodes walks the tree of the given version persisted in db and calls fn
+// with the persisted bytes of every node, in pre-order: a node always comes
+// before its left subtree, which comes before its right subtree. It returns the
+// root hash of the tree, which is empty for an empty tree.
+func ExportNodes(db dbm.DB, version int64, fn func(node []byte) error) ([]byte, error) {
+	rootHash := db.Get(rootKeyFormat.Key(version))
+	if rootHash == nil {
+		return nil, iavl.ErrVersionDoesNotExist
+	}
+	if len(rootHash) == 0 {
+		return rootHash, nil
+	}
+
+	stack := [][]byte{rootHash}
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		bz := db.Get(nodeKeyFormat.KeyBytes(hash))
+		if bz == nil {
+			return nil, fmt.Errorf("missing node %X of version %d", hash, version)
+		}
+		node, err := decodeNode(bz)
+		if err != nil {
+			return nil, err
+		}
+		if err := fn(bz); err != nil {
+			return nil, err
+		}
+		if !node.isLeaf() {
+			stack = append(stack, node.rightHash, node.leftHash)
+		}
+	}
+
+	return rootHash, nil
+}
+
+// NodeImporter writes the nodes of a tree exported by ExportNodes into the db
+// of an empty tree. Every node is checked against the hash expected by its
+// parent, starting from the root hash, so that a complete import is exactly
+// the exported tree. The root is only written by Commit, an import that does
+// not complete leaves no loadable version behind.
+type NodeImporter struct {
+	db       dbm.DB
+	batch    dbm.Batch
+	pending  int
+	version  int64
+	rootHash []byte
+	expected [][]byte
+}
+
+// NewNodeImporter returns an importer of the tree of the given version and
+// root hash into db, which must not hold any version yet.
+func NewNodeImporter(db dbm.DB, version int64, rootHash []byte) (*NodeImporter, error) {
+	if version <= 0 {
+		return nil, fmt.Errorf("invalid version %d", version)
+	}
+
+	iter := dbm.IteratePrefix(db, []byte(rootKeyFormat.Prefix()))
+	defer iter.Close()
+	if iter.Valid() {
+		return nil, errors.New("cannot import into a tree that already has versions")
+	}
+
+	imp := &NodeImporter{
+		db:       db,
+		batch:    db.NewBatch(),
+		version:  version,
+		rootHash: rootHash,
+	}
+	if len(rootHash) > 0 {
+		imp.expected = [][]byte{rootHash}
+	}
+	return imp, nil
+}
+
+// Add verifies and writes the next node of the tree.
+func (imp *NodeImporter) Add(bz []byte) error {
+	if len(imp.expected) == 0 {
+		return errors.New("unexpected node, the tree is already complete")
+	}
+
+	node, err := decodeNode(bz)
+	if err != nil {
+		return err
+	}
+	if node.version > imp.version {
+		return fmt.Errorf("node version %d is greater than the tree version %d", node.version, imp.version)
+	}
+
+	hash := imp.expected[len(imp.expected)-1]
+	if !bytes.Equal(node.hash(), hash) {
+		return fmt.Errorf("node hash %X does not match the expected hash %X", node.hash(), hash)
+	}
+	imp.expected = imp.expected[:len(imp.expected)-1]
+	if !node.isLeaf() {
+		imp.expected = append(imp.expected, node.rightHash, node.leftHash)
+	}
+
+	imp.batch.Set(nodeKeyFormat.KeyBytes(hash), bz)
+	imp.pending++
+	if imp.pending >= importBatchSize {
+		imp.batch.Write()
+		imp.batch.Close()
+		imp.batch = imp.db.NewBatch()
+		imp.pending = 0
+	}
+	return nil
+}
+
+// Done returns true once all the nodes of the tree have been added.
+func (imp *NodeImporter) Done() bool {
+	return len(imp.expected) == 0
+}
+
+// Commit writes the root of the tree once all its nodes have been added.
+func (imp *NodeImporter) Commit() error {
+	defer imp.batch.Close()
+
+	if len(imp.expected) > 0 {
+		return fmt.Errorf("incomplete tree, %d subtrees are missing", len(imp.expected))
+	}
+
+	imp.batch.Set(rootKeyFormat.Key(imp.version), imp.rootHash)
+	imp.batch.WriteSync()
+	return nil
+}
+
+// snapshotNode is a decoded persisted node, see the iavl Node encoding.
+type snapshotNode struct {
+	height    int8
+	size      int64
+	version   int64
+	key       []byte
+	value     []byte
+	leftHash  []byte
+	rightHash []byte
+}
+
+func decodeNode(bz []byte) (node snapshotNode, err error) {
+	buf := bz
+
+	var n int
+	node.height, n, err = amino.DecodeInt8(buf)
+	if err != nil {
+		return node, errors.Wrap(err, "decoding node height")
+	}
+	buf = buf[n:]
+
+	node.size, n, err = amino.DecodeVarint(buf)
+	if err != nil {
+		return node, errors.Wrap(err, "decoding node size")
+	}
+	buf = buf[n:]
+
+	node.version, n, err = amino.DecodeVarint(buf)
+	if err != nil {
+		return node, errors.Wrap(err, "decoding node version")
+	}
+	buf = buf[n:]
+
+	node.key, n, err = amino.DecodeByteSlice(buf)
+	if err != nil {
+		return node, errors.Wrap(err, "decoding node key")
+	}
+	buf = buf[n:]
+
+	if node.isLeaf() {
+		node.value, n, err = amino.DecodeByteSlice(buf)
+		if err != nil {
+			return node, errors.Wrap(err, "decoding node value")
+		}
+		buf = buf[n:]
+	} else {
+		node.leftHash, n, err = amino.DecodeByteSlice(buf)
+		if err != nil {
+			return node, errors.Wrap(err, "decoding node left hash")
+		}
+		buf = buf[n:]
+
+		node.rightHash, n, err = amino.DecodeByteSlice(buf)
+		if err != nil {
+			return node, errors.Wrap(err, "decoding node right hash")
+		}
+		buf = buf[n:]
+
+		if len(node.leftHash) != tmhash.Size || len(node.rightHash) != tmhash.Size {
+			return node, errors.New("invalid node child hash")
+		}
+	}
+
+	if len(buf) != 0 {
+		return node, errors.New("invalid node, trailing bytes")
+	}
+	return node, nil
+}
+
+func (node snapshotNode) isLeaf() bool {
+	return node.height == 0
+}
+
+// hash computes the node hash like the iavl Node, which hashes the value of
+// leaves and the hashes of the children of inner nodes.
+func (node snapshotNode) hash() []byte {
+	var buf bytes.Buffer
+	// writes to a bytes.Buffer never fail
+	_ = amino.EncodeInt8(&buf, node.height)
+	_ = amino.EncodeVarint(&buf, node.size)
+	_ = amino.EncodeVarint(&buf, node.version)
+	if node.isLeaf() {
+		_ = amino.EncodeByteSlice(&buf, node.key)
+		_ = amino.EncodeByteSlice(&buf, tmhash.Sum(node.value))
+	} else {
+		_ = amino.EncodeByteSlice(&buf, node.leftHash)
+		_ = amino.EncodeByteSlice(&buf, node.rightHash)
+	}
+	return tmhash.Sum(buf.Bytes())
+}
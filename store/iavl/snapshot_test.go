@@ -0,0 +1,90 @@
+package iavl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestExportImportNodes(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := iavl.NewMutableTree(db, cacheSize)
+	for v := 0; v < 3; v++ {
+		for i := 0; i < 100; i++ {
+			tree.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%d", v*i)))
+		}
+		tree.Remove([]byte(fmt.Sprintf("key%03d", v)))
+		_, _, err := tree.SaveVersion()
+		require.NoError(t, err)
+	}
+
+	var nodes [][]byte
+	rootHash, err := ExportNodes(db, 2, func(node []byte) error {
+		nodes = append(nodes, node)
+		return nil
+	})
+	require.NoError(t, err)
+	itree, err := tree.GetImmutable(2)
+	require.NoError(t, err)
+	require.Equal(t, itree.Hash(), rootHash)
+
+	_, err = ExportNodes(db, 4, func([]byte) error { return nil })
+	require.Equal(t, iavl.ErrVersionDoesNotExist, err)
+
+	// a tampered node is rejected
+	imp, err := NewNodeImporter(dbm.NewMemDB(), 2, rootHash)
+	require.NoError(t, err)
+	require.NoError(t, imp.Add(nodes[0]))
+	tampered := append([]byte{}, nodes[1]...)
+	tampered[len(tampered)-1] ^= 0xff
+	require.Error(t, imp.Add(tampered))
+
+	// an incomplete tree cannot be committed
+	imp, err = NewNodeImporter(dbm.NewMemDB(), 2, rootHash)
+	require.NoError(t, err)
+	require.NoError(t, imp.Add(nodes[0]))
+	require.False(t, imp.Done())
+	require.Error(t, imp.Commit())
+
+	importDB := dbm.NewMemDB()
+	imp, err = NewNodeImporter(importDB, 2, rootHash)
+	require.NoError(t, err)
+	for _, node := range nodes {
+		require.NoError(t, imp.Add(node))
+	}
+	require.True(t, imp.Done())
+	require.Error(t, imp.Add(nodes[0]))
+	require.NoError(t, imp.Commit())
+
+	_, err = NewNodeImporter(importDB, 2, rootHash)
+	require.Error(t, err)
+
+	imported := iavl.NewMutableTree(importDB, cacheSize)
+	version, err := imported.LoadVersion(2)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), version)
+	require.Equal(t, rootHash, imported.Hash())
+	itree.Iterate(func(key, value []byte) bool {
+		_, v := imported.Get(key)
+		require.Equal(t, value, v)
+		return false
+	})
+
+	// the imported tree keeps versioning like the original one
+	for _, tr := range []*iavl.MutableTree{tree, imported} {
+		if tr == tree {
+			_, err := tr.LoadVersionForOverwriting(2)
+			require.NoError(t, err)
+		}
+		tr.Set([]byte("key050"), []byte("updated"))
+		tr.Remove([]byte("key060"))
+	}
+	hash1, _, err := tree.SaveVersion()
+	require.NoError(t, err)
+	hash2, _, err := imported.SaveVersion()
+	require.NoError(t, err)
+	require.Equal(t, hash1, hash2)
+}
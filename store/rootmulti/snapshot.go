@@ -0,0 +1,501 @@
+package rootmulti
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pocblockchain/pocc/store/iavl"
+	"github.com/pocblockchain/pocc/store/types"
+)
+
+// SnapshotFormat is the format of the snapshots written by WriteSnapshot. It
+// must be increased whenever the manifest or the chunk stream changes, as
+// RestoreSnapshot rejects the snapshots of any other format.
+const SnapshotFormat uint32 = 1
+
+const (
+	// SnapshotManifestFile is the name of the manifest of a snapshot directory.
+	SnapshotManifestFile = "manifest.json"
+
+	// DefaultSnapshotChunkSize is the default maximum size of a snapshot chunk.
+	DefaultSnapshotChunkSize = 16 << 20
+
+	snapshotChunkFileFmt = "%06d.chunk"
+
+	// maxSnapshotItemSize bounds the size of a single item of the chunk stream.
+	maxSnapshotItemSize = 64 << 20
+)
+
+// SnapshotManifest describes a snapshot of the IAVL stores of a multistore at
+// a committed version. The chunks, concatenated in order, are a stream of
+// length-prefixed items: for every store in the order of the manifest, an item
+// naming the store followed by the persisted nodes of its tree in pre-order.
+type SnapshotManifest struct {
+	Format uint32          `json:"format"`
+	Height int64           `json:"height"`
+	Hash   cmn.HexBytes    `json:"hash"`
+	Stores []SnapshotStore `json:"stores"`
+	Chunks []SnapshotChunk `json:"chunks"`
+}
+
+// SnapshotStore is the commit of a store included in a snapshot.
+type SnapshotStore struct {
+	Name    string       `json:"name"`
+	Version int64        `json:"version"`
+	Hash    cmn.HexBytes `json:"hash"`
+}
+
+// SnapshotChunk is a chunk file of a snapshot along with its SHA-256 hash.
+type SnapshotChunk struct {
+	File string       `json:"file"`
+	Size int64        `json:"size"`
+	Hash cmn.HexBytes `json:"hash"`
+}
+
+// CommitID returns the commit of the multistore the snapshot was taken at.
+func (m SnapshotManifest) CommitID() types.CommitID {
+	return types.CommitID{Version: m.Height, Hash: m.Hash}
+}
+
+func (m SnapshotManifest) commitInfo() commitInfo {
+	ci := commitInfo{Version: m.Height}
+	for _, s := range m.Stores {
+		si := storeInfo{Name: s.Name}
+		si.Core.CommitID = types.CommitID{Version: s.Version, Hash: s.Hash}
+		ci.StoreInfos = append(ci.StoreInfos, si)
+	}
+	return ci
+}
+
+// snapshotItem is an item of the chunk stream, either the start of the nodes
+// of a store or a node of the current store.
+type snapshotItem struct {
+	Store string
+	Node  []byte
+}
+
+// LatestVersion returns the latest version committed in the multistore db, or
+// 0 if none has been committed yet.
+func LatestVersion(db dbm.DB) int64 {
+	return getLatestVersion(db)
+}
+
+// WriteSnapshot writes a snapshot of the IAVL stores committed at height in the
+// multistore db into dir, which must not exist or be empty. A height of 0
+// selects the latest committed height. The height must not have been pruned.
+// Only stores mounted without their own DB are supported.
+func WriteSnapshot(db dbm.DB, height int64, dir string, chunkSize int64) (SnapshotManifest, error) {
+	if chunkSize <= 0 {
+		return SnapshotManifest{}, fmt.Errorf("invalid chunk size %d", chunkSize)
+	}
+	if height == 0 {
+		height = getLatestVersion(db)
+		if height == 0 {
+			return SnapshotManifest{}, fmt.Errorf("no committed state to snapshot")
+		}
+	}
+
+	cInfo, err := getCommitInfo(db, height)
+	if err != nil {
+		return SnapshotManifest{}, fmt.Errorf("no commit at height %d: %v", height, err)
+	}
+	sort.Slice(cInfo.StoreInfos, func(i, j int) bool {
+		return cInfo.StoreInfos[i].Name < cInfo.StoreInfos[j].Name
+	})
+
+	if err := ensureEmptyDir(dir); err != nil {
+		return SnapshotManifest{}, err
+	}
+
+	manifest := SnapshotManifest{
+		Format: SnapshotFormat,
+		Height: height,
+		Hash:   cInfo.Hash(),
+	}
+
+	cw := &chunkWriter{dir: dir, size: chunkSize}
+	for _, si := range cInfo.StoreInfos {
+		id := si.Core.CommitID
+		if id.Version <= 0 {
+			return SnapshotManifest{}, fmt.Errorf("store %s is not an IAVL store", si.Name)
+		}
+
+		if err := writeSnapshotItem(cw, snapshotItem{Store: si.Name}); err != nil {
+			return SnapshotManifest{}, err
+		}
+		storeDB := dbm.NewPrefixDB(db, storePrefix(si.Name))
+		rootHash, err := iavl.ExportNodes(storeDB, id.Version, func(node []byte) error {
+			return writeSnapshotItem(cw, snapshotItem{Node: node})
+		})
+		if err != nil {
+			return SnapshotManifest{}, fmt.Errorf("failed to export store %s at version %d: %v", si.Name, id.Version, err)
+		}
+		if !bytes.Equal(rootHash, id.Hash) {
+			return SnapshotManifest{}, fmt.Errorf("store %s root hash %X does not match its commit hash %X", si.Name, rootHash, id.Hash)
+		}
+
+		manifest.Stores = append(manifest.Stores, SnapshotStore{Name: si.Name, Version: id.Version, Hash: id.Hash})
+	}
+
+	manifest.Chunks, err = cw.Close()
+	if err != nil {
+		return SnapshotManifest{}, err
+	}
+
+	// the manifest is written last, a snapshot without manifest is incomplete
+	bz, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return SnapshotManifest{}, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, SnapshotManifestFile), bz, 0644); err != nil {
+		return SnapshotManifest{}, err
+	}
+	return manifest, nil
+}
+
+// ReadSnapshotManifest reads and validates the manifest of the snapshot in dir.
+func ReadSnapshotManifest(dir string) (SnapshotManifest, error) {
+	var manifest SnapshotManifest
+
+	bz, err := ioutil.ReadFile(filepath.Join(dir, SnapshotManifestFile))
+	if err != nil {
+		return manifest, err
+	}
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return manifest, fmt.Errorf("invalid snapshot manifest: %v", err)
+	}
+
+	if manifest.Format != SnapshotFormat {
+		return manifest, fmt.Errorf("unsupported snapshot format %d, expected %d", manifest.Format, SnapshotFormat)
+	}
+	if manifest.Height <= 0 {
+		return manifest, fmt.Errorf("invalid snapshot height %d", manifest.Height)
+	}
+	for i, s := range manifest.Stores {
+		if len(s.Hash) == 0 {
+			// empty trees have no hash
+			manifest.Stores[i].Hash = nil
+		}
+		if s.Name == "" || s.Version <= 0 {
+			return manifest, fmt.Errorf("invalid snapshot store %q at version %d", s.Name, s.Version)
+		}
+		if i > 0 && manifest.Stores[i-1].Name >= s.Name {
+			return manifest, fmt.Errorf("snapshot stores must be sorted by unique names")
+		}
+	}
+	for _, c := range manifest.Chunks {
+		if c.File == "" || filepath.Base(c.File) != c.File {
+			return manifest, fmt.Errorf("invalid snapshot chunk file %q", c.File)
+		}
+	}
+	if hash := manifest.commitInfo().Hash(); !bytes.Equal(hash, manifest.Hash) {
+		return manifest, fmt.Errorf("snapshot stores hash to %X instead of %X", hash, manifest.Hash)
+	}
+
+	return manifest, nil
+}
+
+// RestoreSnapshot rebuilds the multistore db from the snapshot in dir. The db
+// must not hold any committed state nor any data of the stores of the snapshot.
+// Every chunk is checked against its hash and every node against its tree,
+// then the restored stores are loaded and their commit must hash to the commit
+// the snapshot was taken at. If the restore fails, the data it wrote is
+// removed so that it can be retried.
+func RestoreSnapshot(db dbm.DB, dir string) (SnapshotManifest, error) {
+	manifest, err := ReadSnapshotManifest(dir)
+	if err != nil {
+		return manifest, err
+	}
+	if getLatestVersion(db) != 0 {
+		return manifest, fmt.Errorf("cannot restore a snapshot into a DB that already holds committed state")
+	}
+	for _, s := range manifest.Stores {
+		if !isEmptyPrefix(db, storePrefix(s.Name)) {
+			return manifest, fmt.Errorf("cannot restore a snapshot into a DB that already holds data of store %s", s.Name)
+		}
+	}
+
+	if err := importSnapshotStores(db, dir, manifest); err != nil {
+		rollbackSnapshot(db, manifest)
+		return manifest, err
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+	setCommitInfo(batch, manifest.Height, manifest.commitInfo())
+	setLatestVersion(batch, manifest.Height)
+	batch.WriteSync()
+
+	if err := verifyRestoredStores(db, manifest); err != nil {
+		rollbackSnapshot(db, manifest)
+		return manifest, err
+	}
+	return manifest, nil
+}
+
+// importSnapshotStores imports the nodes of all the stores of the snapshot
+// from its chunk stream.
+func importSnapshotStores(db dbm.DB, dir string, manifest SnapshotManifest) error {
+	r := bufio.NewReader(&chunkReader{dir: dir, chunks: manifest.Chunks})
+	for _, s := range manifest.Stores {
+		item, err := readSnapshotItem(r)
+		if err != nil {
+			return err
+		}
+		if item.Store != s.Name {
+			return fmt.Errorf("expected the nodes of store %s, got store %q", s.Name, item.Store)
+		}
+
+		storeDB := dbm.NewPrefixDB(db, storePrefix(s.Name))
+		imp, err := iavl.NewNodeImporter(storeDB, s.Version, s.Hash)
+		if err != nil {
+			return fmt.Errorf("failed to import store %s: %v", s.Name, err)
+		}
+		for !imp.Done() {
+			item, err := readSnapshotItem(r)
+			if err != nil {
+				return err
+			}
+			if item.Store != "" {
+				return fmt.Errorf("store %s is incomplete", s.Name)
+			}
+			if err := imp.Add(item.Node); err != nil {
+				return fmt.Errorf("failed to import store %s: %v", s.Name, err)
+			}
+		}
+		if err := imp.Commit(); err != nil {
+			return fmt.Errorf("failed to import store %s: %v", s.Name, err)
+		}
+	}
+	if _, err := readSnapshotItem(r); err != io.EOF {
+		return fmt.Errorf("unexpected data after the last store of the snapshot")
+	}
+	return nil
+}
+
+// rollbackSnapshot removes the data written by a failed restore of the
+// snapshot, the stores of which were empty before it.
+func rollbackSnapshot(db dbm.DB, manifest SnapshotManifest) {
+	batch := db.NewBatch()
+	defer batch.Close()
+	for _, s := range manifest.Stores {
+		iter := dbm.IteratePrefix(db, storePrefix(s.Name))
+		for ; iter.Valid(); iter.Next() {
+			batch.Delete(iter.Key())
+		}
+		iter.Close()
+	}
+	batch.Delete([]byte(fmt.Sprintf(commitInfoKeyFmt, manifest.Height)))
+	batch.Delete([]byte(latestVersionKey))
+	batch.WriteSync()
+}
+
+func isEmptyPrefix(db dbm.DB, prefix []byte) bool {
+	iter := dbm.IteratePrefix(db, prefix)
+	defer iter.Close()
+	return !iter.Valid()
+}
+
+// verifyRestoredStores loads the restored stores and checks that their commits
+// hash to the commit of the snapshot.
+func verifyRestoredStores(db dbm.DB, manifest SnapshotManifest) error {
+	rs := NewStore(db)
+	keys := make(map[string]types.StoreKey, len(manifest.Stores))
+	for _, s := range manifest.Stores {
+		keys[s.Name] = types.NewKVStoreKey(s.Name)
+		rs.MountStoreWithDB(keys[s.Name], types.StoreTypeIAVL, nil)
+	}
+	if err := rs.LoadLatestVersion(); err != nil {
+		return fmt.Errorf("failed to load the restored stores: %v", err)
+	}
+
+	ci := commitInfo{Version: rs.LastCommitID().Version}
+	for _, s := range manifest.Stores {
+		si := storeInfo{Name: s.Name}
+		si.Core.CommitID = rs.GetCommitStore(keys[s.Name]).LastCommitID()
+		ci.StoreInfos = append(ci.StoreInfos, si)
+	}
+
+	if id, want := ci.CommitID(), manifest.CommitID(); id.Version != want.Version || !bytes.Equal(id.Hash, want.Hash) {
+		return fmt.Errorf("restored commit %d/%X does not match the snapshot commit %d/%X",
+			id.Version, id.Hash, want.Version, want.Hash)
+	}
+	return nil
+}
+
+func writeSnapshotItem(w io.Writer, item snapshotItem) error {
+	bz, err := cdc.MarshalBinaryLengthPrefixed(item)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(bz)
+	return err
+}
+
+// readSnapshotItem returns io.EOF at the end of a stream ending on an item.
+func readSnapshotItem(r io.Reader) (item snapshotItem, err error) {
+	n, err := cdc.UnmarshalBinaryLengthPrefixedReader(r, &item, maxSnapshotItemSize)
+	if err == io.EOF && n == 0 {
+		return item, io.EOF
+	}
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return item, fmt.Errorf("invalid snapshot item: %v", err)
+	}
+	if (item.Store == "") == (len(item.Node) == 0) {
+		return item, fmt.Errorf("invalid snapshot item")
+	}
+	return item, nil
+}
+
+func ensureEmptyDir(dir string) error {
+	entries, err := ioutil.ReadDir(dir)
+	switch {
+	case os.IsNotExist(err):
+		return os.MkdirAll(dir, 0755)
+	case err != nil:
+		return err
+	case len(entries) > 0:
+		return fmt.Errorf("snapshot directory %s is not empty", dir)
+	default:
+		return nil
+	}
+}
+
+// chunkWriter splits the stream written to it into chunk files of at most
+// size bytes, hashing every chunk.
+type chunkWriter struct {
+	dir  string
+	size int64
+
+	file    *os.File
+	buf     *bufio.Writer
+	hasher  hash.Hash
+	written int64
+	chunks  []SnapshotChunk
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	total := 0
+	for len(p) > 0 {
+		if w.file == nil {
+			if err := w.openChunk(); err != nil {
+				return total, err
+			}
+		}
+
+		n := int64(len(p))
+		if room := w.size - w.written; n > room {
+			n = room
+		}
+		if _, err := w.buf.Write(p[:n]); err != nil {
+			return total, err
+		}
+		w.hasher.Write(p[:n])
+		w.written += n
+		total += int(n)
+		p = p[n:]
+
+		if w.written == w.size {
+			if err := w.closeChunk(); err != nil {
+				return total, err
+			}
+		}
+	}
+	return total, nil
+}
+
+// Close closes the last chunk and returns all the chunks written.
+func (w *chunkWriter) Close() ([]SnapshotChunk, error) {
+	if w.file != nil {
+		if err := w.closeChunk(); err != nil {
+			return nil, err
+		}
+	}
+	return w.chunks, nil
+}
+
+func (w *chunkWriter) openChunk() error {
+	name := fmt.Sprintf(snapshotChunkFileFmt, len(w.chunks))
+	file, err := os.OpenFile(filepath.Join(w.dir, name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	w.file = file
+	w.buf = bufio.NewWriter(file)
+	w.hasher = sha256.New()
+	w.written = 0
+	return nil
+}
+
+func (w *chunkWriter) closeChunk() error {
+	if err := w.buf.Flush(); err != nil {
+		w.file.Close()
+		return err
+	}
+	if err := w.file.Sync(); err != nil {
+		w.file.Close()
+		return err
+	}
+	if err := w.file.Close(); err != nil {
+		return err
+	}
+
+	w.chunks = append(w.chunks, SnapshotChunk{
+		File: filepath.Base(w.file.Name()),
+		Size: w.written,
+		Hash: w.hasher.Sum(nil),
+	})
+	w.file = nil
+	return nil
+}
+
+// chunkReader reads the stream of the chunks of a snapshot, checking every
+// chunk against its size and hash before returning any of its content.
+type chunkReader struct {
+	dir    string
+	chunks []SnapshotChunk
+
+	next int
+	cur  *bytes.Reader
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for r.cur == nil || r.cur.Len() == 0 {
+		if r.next == len(r.chunks) {
+			return 0, io.EOF
+		}
+		if err := r.loadChunk(r.chunks[r.next]); err != nil {
+			return 0, err
+		}
+		r.next++
+	}
+	return r.cur.Read(p)
+}
+
+func (r *chunkReader) loadChunk(chunk SnapshotChunk) error {
+	bz, err := ioutil.ReadFile(filepath.Join(r.dir, chunk.File))
+	if err != nil {
+		return err
+	}
+	hash := sha256.Sum256(bz)
+	if int64(len(bz)) != chunk.Size || !bytes.Equal(hash[:], chunk.Hash) {
+		return fmt.Errorf("snapshot chunk %s is corrupted", chunk.File)
+	}
+	r.cur = bytes.NewReader(bz)
+	return nil
+}
@@ -0,0 +1,224 @@
+package rootmulti
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pocblockchain/pocc/store/types"
+)
+
+// newSnapshotStore returns a store with three committed versions of data,
+// including updates and deletions, and an empty store4.
+func newSnapshotStore(t *testing.T, db dbm.DB) *Store {
+	store := newMultiStoreWithMounts(db)
+	store.pruningOpts = types.PruneNothing
+	store.MountStoreWithDB(types.NewKVStoreKey("store4"), types.StoreTypeIAVL, nil)
+	require.NoError(t, store.LoadLatestVersion())
+
+	for v := 0; v < 3; v++ {
+		for i, name := range []string{"store1", "store2", "store3"} {
+			kv := store.getStoreByName(name).(types.KVStore)
+			for k := 0; k < 50*(i+1); k++ {
+				kv.Set([]byte(fmt.Sprintf("key%03d", k)), []byte(fmt.Sprintf("value%d-%d", v, k)))
+			}
+			kv.Delete([]byte(fmt.Sprintf("key%03d", v)))
+		}
+		store.Commit()
+	}
+	return store
+}
+
+func snapshotDir(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+	return filepath.Join(dir, "snapshot"), func() { os.RemoveAll(dir) }
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newSnapshotStore(t, db)
+
+	dir, cleanup := snapshotDir(t)
+	defer cleanup()
+
+	// small chunks so the stream spans many of them
+	manifest, err := WriteSnapshot(db, 0, dir, 1024)
+	require.NoError(t, err)
+	require.Equal(t, SnapshotFormat, manifest.Format)
+	require.Equal(t, store.LastCommitID(), manifest.CommitID())
+	require.Len(t, manifest.Stores, 4)
+	require.True(t, len(manifest.Chunks) > 1)
+
+	read, err := ReadSnapshotManifest(dir)
+	require.NoError(t, err)
+	require.Equal(t, manifest, read)
+
+	restoredDB := dbm.NewMemDB()
+	restored, err := RestoreSnapshot(restoredDB, dir)
+	require.NoError(t, err)
+	require.Equal(t, manifest, restored)
+
+	store2 := newMultiStoreWithMounts(restoredDB)
+	store2.MountStoreWithDB(types.NewKVStoreKey("store4"), types.StoreTypeIAVL, nil)
+	require.NoError(t, store2.LoadLatestVersion())
+	require.Equal(t, store.LastCommitID(), store2.LastCommitID())
+
+	for _, name := range []string{"store1", "store2", "store3", "store4"} {
+		kv1 := store.getStoreByName(name).(types.KVStore)
+		kv2 := store2.getStoreByName(name).(types.KVStore)
+		iter1, iter2 := kv1.Iterator(nil, nil), kv2.Iterator(nil, nil)
+		for ; iter1.Valid(); iter1.Next() {
+			require.True(t, iter2.Valid())
+			require.Equal(t, iter1.Key(), iter2.Key())
+			require.Equal(t, iter1.Value(), iter2.Value())
+			iter2.Next()
+		}
+		require.False(t, iter2.Valid())
+		iter1.Close()
+		iter2.Close()
+	}
+
+	// both stores keep committing the same state
+	for _, s := range []*Store{store, store2} {
+		kv := s.getStoreByName("store2").(types.KVStore)
+		kv.Set([]byte("key001"), []byte("new"))
+		kv.Delete([]byte("key010"))
+	}
+	require.Equal(t, store.Commit(), store2.Commit())
+}
+
+func TestSnapshotPastHeight(t *testing.T) {
+	db := dbm.NewMemDB()
+	store := newSnapshotStore(t, db)
+	want := store.LastCommitID()
+
+	dir, cleanup := snapshotDir(t)
+	defer cleanup()
+
+	manifest, err := WriteSnapshot(db, 2, dir, DefaultSnapshotChunkSize)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), manifest.Height)
+	require.Len(t, manifest.Chunks, 1)
+
+	restoredDB := dbm.NewMemDB()
+	_, err = RestoreSnapshot(restoredDB, dir)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), getLatestVersion(restoredDB))
+
+	// replaying the third version on the restored store reaches the same commit
+	store2 := newMultiStoreWithMounts(restoredDB)
+	store2.MountStoreWithDB(types.NewKVStoreKey("store4"), types.StoreTypeIAVL, nil)
+	require.NoError(t, store2.LoadLatestVersion())
+	for i, name := range []string{"store1", "store2", "store3"} {
+		kv := store2.getStoreByName(name).(types.KVStore)
+		for k := 0; k < 50*(i+1); k++ {
+			kv.Set([]byte(fmt.Sprintf("key%03d", k)), []byte(fmt.Sprintf("value%d-%d", 2, k)))
+		}
+		kv.Delete([]byte(fmt.Sprintf("key%03d", 2)))
+	}
+	require.Equal(t, want, store2.Commit())
+}
+
+func TestWriteSnapshotErrors(t *testing.T) {
+	dir, cleanup := snapshotDir(t)
+	defer cleanup()
+
+	_, err := WriteSnapshot(dbm.NewMemDB(), 0, dir, DefaultSnapshotChunkSize)
+	require.Error(t, err)
+
+	db := dbm.NewMemDB()
+	newSnapshotStore(t, db)
+
+	_, err = WriteSnapshot(db, 4, dir, DefaultSnapshotChunkSize)
+	require.Error(t, err)
+	_, err = WriteSnapshot(db, 3, dir, 0)
+	require.Error(t, err)
+
+	_, err = WriteSnapshot(db, 3, dir, DefaultSnapshotChunkSize)
+	require.NoError(t, err)
+	// the directory is not empty anymore
+	_, err = WriteSnapshot(db, 3, dir, DefaultSnapshotChunkSize)
+	require.Error(t, err)
+}
+
+func TestRestoreSnapshotErrors(t *testing.T) {
+	db := dbm.NewMemDB()
+	newSnapshotStore(t, db)
+
+	dir, cleanup := snapshotDir(t)
+	defer cleanup()
+
+	manifest, err := WriteSnapshot(db, 0, dir, 1024)
+	require.NoError(t, err)
+
+	// the DB already holds committed state
+	_, err = RestoreSnapshot(db, dir)
+	require.Error(t, err)
+
+	writeManifest := func(m SnapshotManifest) {
+		bz, err := json.Marshal(m)
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, SnapshotManifestFile), bz, 0644))
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(m *SnapshotManifest)
+	}{
+		{"unknown format", func(m *SnapshotManifest) { m.Format = SnapshotFormat + 1 }},
+		{"wrong hash", func(m *SnapshotManifest) { m.Hash = m.Stores[0].Hash }},
+		{"wrong store hash", func(m *SnapshotManifest) { m.Stores[0].Hash = m.Stores[1].Hash }},
+		{"missing chunk", func(m *SnapshotManifest) { m.Chunks = m.Chunks[:len(m.Chunks)-1] }},
+		{"chunk out of the directory", func(m *SnapshotManifest) { m.Chunks[0].File = "../" + m.Chunks[0].File }},
+	}
+	for _, tc := range tests {
+		m := manifest
+		m.Stores = append([]SnapshotStore{}, manifest.Stores...)
+		m.Chunks = append([]SnapshotChunk{}, manifest.Chunks...)
+		tc.mutate(&m)
+		writeManifest(m)
+
+		_, err := RestoreSnapshot(dbm.NewMemDB(), dir)
+		require.Error(t, err, tc.name)
+	}
+	writeManifest(manifest)
+
+	// a corrupted chunk is detected
+	path := filepath.Join(dir, manifest.Chunks[1].File)
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	bz[10] ^= 0xff
+	require.NoError(t, ioutil.WriteFile(path, bz, 0644))
+
+	restoredDB := dbm.NewMemDB()
+	_, err = RestoreSnapshot(restoredDB, dir)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "corrupted")
+	require.Equal(t, int64(0), getLatestVersion(restoredDB))
+
+	// the stores imported before the corrupted chunk are removed, so the
+	// restore can be retried once the chunk is repaired
+	for _, s := range manifest.Stores {
+		require.True(t, isEmptyPrefix(restoredDB, storePrefix(s.Name)), s.Name)
+	}
+	bz[10] ^= 0xff
+	require.NoError(t, ioutil.WriteFile(path, bz, 0644))
+	_, err = RestoreSnapshot(restoredDB, dir)
+	require.NoError(t, err)
+	require.Equal(t, manifest.Height, getLatestVersion(restoredDB))
+
+	// a DB holding data of a store of the snapshot is refused
+	otherDB := dbm.NewMemDB()
+	otherDB.Set(append(storePrefix(manifest.Stores[2].Name), 'x'), []byte("value"))
+	_, err = RestoreSnapshot(otherDB, dir)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), manifest.Stores[2].Name)
+	require.Equal(t, []byte("value"), otherDB.Get(append(storePrefix(manifest.Stores[2].Name), 'x')))
+}
@@ -387,7 +387,7 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 	if params.db != nil {
 		db = dbm.NewPrefixDB(params.db, []byte("s/_/"))
 	} else {
-		db = dbm.NewPrefixDB(rs.db, []byte("s/k:"+params.key.Name()+"/"))
+		db = dbm.NewPrefixDB(rs.db, storePrefix(params.key.Name()))
 	}
 
 	switch params.typ {
@@ -413,6 +413,12 @@ func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID
 	}
 }
 
+// storePrefix is the prefix of the data of the store with the name in the
+// multistore DB, unless the store was mounted with its own DB.
+func storePrefix(name string) []byte {
+	return []byte("s/k:" + name + "/")
+}
+
 func (rs *Store) nameToKey(name string) types.StoreKey {
 	for key := range rs.storesParams {
 		if key.Name() == name {
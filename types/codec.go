@@ -6,4 +6,6 @@ import "github.com/pocblockchain/pocc/codec"
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*Msg)(nil), nil)
 	cdc.RegisterInterface((*Tx)(nil), nil)
+	cdc.RegisterInterface((*Flow)(nil), nil)
+	cdc.RegisterConcrete(BalanceFlow{}, "poc/BalanceFlow", nil)
 }
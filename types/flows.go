@@ -1,7 +1,11 @@
 package types
 
 import (
+	"encoding/binary"
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/pocblockchain/pocc/codec"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -14,8 +18,77 @@ type CategoryType uint64
 const (
 	CategoryTypeTransfer      CategoryType = 0x1
 	CategoryTypeMultiTransfer CategoryType = 0x2
+	CategoryTypeEscrow        CategoryType = 0x3
+	CategoryTypeBonusSend     CategoryType = 0x4
+	CategoryTypeReclaimSend   CategoryType = 0x5
+	CategoryTypeNewToken      CategoryType = 0x6
+	CategoryTypeInflateToken  CategoryType = 0x7
+	CategoryTypeBurnToken     CategoryType = 0x8
+	CategoryTypeReleaseEscrow CategoryType = 0x9
+	CategoryTypeReclaimEscrow CategoryType = 0xa
+	CategoryTypeCreateHTLC    CategoryType = 0xb
+	CategoryTypeClaimHTLC     CategoryType = 0xc
+	CategoryTypeRefundHTLC    CategoryType = 0xd
+	CategoryTypeCreateVesting CategoryType = 0xe
+	CategoryTypeCreateAirdrop CategoryType = 0xf
+	CategoryTypeClaimAirdrop  CategoryType = 0x10
+
+	CategoryTypeScheduleTransfer        CategoryType = 0x11
+	CategoryTypeCancelScheduledTransfer CategoryType = 0x12
 )
 
+// String implements the Stringer interface.
+func (c CategoryType) String() string {
+	switch c {
+	case CategoryTypeTransfer:
+		return "transfer"
+	case CategoryTypeMultiTransfer:
+		return "multi_transfer"
+	case CategoryTypeEscrow:
+		return "escrow"
+	case CategoryTypeBonusSend:
+		return "bonus_send"
+	case CategoryTypeReclaimSend:
+		return "reclaim_send"
+	case CategoryTypeNewToken:
+		return "new_token"
+	case CategoryTypeInflateToken:
+		return "inflate_token"
+	case CategoryTypeBurnToken:
+		return "burn_token"
+	case CategoryTypeReleaseEscrow:
+		return "release_escrow"
+	case CategoryTypeReclaimEscrow:
+		return "reclaim_escrow"
+	case CategoryTypeCreateHTLC:
+		return "create_htlc"
+	case CategoryTypeClaimHTLC:
+		return "claim_htlc"
+	case CategoryTypeRefundHTLC:
+		return "refund_htlc"
+	case CategoryTypeCreateVesting:
+		return "create_vesting_account"
+	case CategoryTypeCreateAirdrop:
+		return "create_airdrop"
+	case CategoryTypeClaimAirdrop:
+		return "claim_airdrop"
+	case CategoryTypeScheduleTransfer:
+		return "schedule_transfer"
+	case CategoryTypeCancelScheduledTransfer:
+		return "cancel_scheduled_transfer"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint64(c))
+	}
+}
+
+// receiptCdc encodes receipts written by handlers, any codec passed to
+// RegisterCodec decodes them.
+var receiptCdc = codec.New()
+
+func init() {
+	RegisterCodec(receiptCdc)
+}
+
 // Receipt defines basic interface for all kind of receipts
 type Receipt struct {
 	// Category for the transaction that causes the receipt.
@@ -25,6 +98,21 @@ type Receipt struct {
 	Flows []Flow
 }
 
+// Receipts are the receipts of all msgs in a tx
+type Receipts []Receipt
+
+// String implements the Stringer interface.
+func (rs Receipts) String() string {
+	var b strings.Builder
+	for i, rc := range rs {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(fmt.Sprintf("%+v", rc))
+	}
+	return b.String()
+}
+
 // Flow defines the interface of the flow in the receipt
 type Flow interface{}
 
@@ -43,6 +131,109 @@ type BalanceFlow struct {
 	BalanceChange Int
 }
 
+// NewReceipt creates a receipt
+func NewReceipt(category CategoryType, flows []Flow) Receipt {
+	return Receipt{
+		Category: category,
+		Flows:    flows,
+	}
+}
+
+// NewBalanceFlow creates a balance flow
+func NewBalanceFlow(addr AccAddress, symbol Symbol, previousBalance, balanceChange Int) BalanceFlow {
+	return BalanceFlow{
+		AccAddress:      addr,
+		Symbol:          symbol,
+		PreviousBalance: previousBalance,
+		BalanceChange:   balanceChange,
+	}
+}
+
+// NewBalanceFlows returns a flow for each symbol whose balance of addr changes from prev to cur
+func NewBalanceFlows(addr AccAddress, prev, cur Coins) []Flow {
+	denoms := make(map[string]struct{})
+	for _, coin := range prev {
+		denoms[coin.Denom] = struct{}{}
+	}
+	for _, coin := range cur {
+		denoms[coin.Denom] = struct{}{}
+	}
+
+	sorted := make([]string, 0, len(denoms))
+	for denom := range denoms {
+		sorted = append(sorted, denom)
+	}
+	sort.Strings(sorted)
+
+	var flows []Flow
+	for _, denom := range sorted {
+		prevAmt := prev.AmountOf(denom)
+		change := cur.AmountOf(denom).Sub(prevAmt)
+		if change.IsZero() {
+			continue
+		}
+		flows = append(flows, NewBalanceFlow(addr, Symbol(denom), prevAmt, change))
+	}
+	return flows
+}
+
+// BalanceSnapshot holds the balances of some addresses taken before a msg is handled,
+// the flows of the msg's receipt are the differences to their balances afterwards.
+type BalanceSnapshot struct {
+	addrs    []AccAddress
+	balances []Coins
+}
+
+// NewBalanceSnapshot takes the balances of addrs, duplicated addresses are taken once
+func NewBalanceSnapshot(getCoins func(AccAddress) Coins, addrs ...AccAddress) BalanceSnapshot {
+	s := BalanceSnapshot{}
+	seen := make(map[string]struct{}, len(addrs))
+	for _, addr := range addrs {
+		if _, ok := seen[addr.String()]; ok {
+			continue
+		}
+		seen[addr.String()] = struct{}{}
+		s.addrs = append(s.addrs, addr)
+		s.balances = append(s.balances, getCoins(addr))
+	}
+	return s
+}
+
+// Flows returns the balance flows of the snapshot's addresses since the snapshot was taken
+func (s BalanceSnapshot) Flows(getCoins func(AccAddress) Coins) []Flow {
+	var flows []Flow
+	for i, addr := range s.addrs {
+		flows = append(flows, NewBalanceFlows(addr, s.balances[i], getCoins(addr))...)
+	}
+	return flows
+}
+
+// MustMarshalReceipt encodes a receipt for Result.Data. The receipt is length prefixed,
+// so the receipts of all msgs in a tx can be concatenated.
+func MustMarshalReceipt(rc Receipt) []byte {
+	return receiptCdc.MustMarshalBinaryLengthPrefixed(rc)
+}
+
+// GetReceiptsFromData decodes all receipts concatenated in a tx's Result.Data
+func GetReceiptsFromData(cdc *codec.Codec, data []byte) (Receipts, error) {
+	var receipts Receipts
+	for len(data) > 0 {
+		size, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < size {
+			return nil, errors.New("invalid receipt length prefix")
+		}
+
+		end := n + int(size)
+		var rc Receipt
+		if err := cdc.UnmarshalBinaryLengthPrefixed(data[:end], &rc); err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, rc)
+		data = data[end:]
+	}
+	return receipts, nil
+}
+
 func GetReceiptFromResult(cdc *codec.Codec, result *Result) (Receipt, error) {
 	if result.Data == nil {
 		return Receipt{}, errors.New("invalid data")
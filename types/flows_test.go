@@ -0,0 +1,60 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/pocblockchain/pocc/codec"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBalanceFlows(t *testing.T) {
+	addr := AccAddress([]byte("addr1_______________"))
+	prev := NewCoins(NewInt64Coin("btc", 10), NewInt64Coin("eth", 5))
+	cur := NewCoins(NewInt64Coin("eth", 5), NewInt64Coin("poc", 7))
+
+	flows := NewBalanceFlows(addr, prev, cur)
+	require.Equal(t, []Flow{
+		NewBalanceFlow(addr, "btc", NewInt(10), NewInt(-10)),
+		NewBalanceFlow(addr, "poc", ZeroInt(), NewInt(7)),
+	}, flows)
+
+	require.Nil(t, NewBalanceFlows(addr, prev, prev))
+}
+
+func TestBalanceSnapshot(t *testing.T) {
+	addr1 := AccAddress([]byte("addr1_______________"))
+	addr2 := AccAddress([]byte("addr2_______________"))
+	balances := map[string]Coins{
+		addr1.String(): NewCoins(NewInt64Coin("btc", 10)),
+	}
+	getCoins := func(addr AccAddress) Coins { return balances[addr.String()] }
+
+	snapshot := NewBalanceSnapshot(getCoins, addr1, addr2, addr1)
+	balances[addr1.String()] = NewCoins(NewInt64Coin("btc", 4))
+	balances[addr2.String()] = NewCoins(NewInt64Coin("btc", 6))
+
+	require.Equal(t, []Flow{
+		NewBalanceFlow(addr1, "btc", NewInt(10), NewInt(-6)),
+		NewBalanceFlow(addr2, "btc", ZeroInt(), NewInt(6)),
+	}, snapshot.Flows(getCoins))
+}
+
+func TestGetReceiptsFromData(t *testing.T) {
+	cdc := codec.New()
+	RegisterCodec(cdc)
+
+	addr := AccAddress([]byte("addr1_______________"))
+	rc1 := NewReceipt(CategoryTypeTransfer, []Flow{NewBalanceFlow(addr, "btc", NewInt(10), NewInt(-6))})
+	rc2 := NewReceipt(CategoryTypeBurnToken, []Flow{NewBalanceFlow(addr, "eth", NewInt(3), NewInt(-1))})
+
+	receipts, err := GetReceiptsFromData(cdc, append(MustMarshalReceipt(rc1), MustMarshalReceipt(rc2)...))
+	require.NoError(t, err)
+	require.Equal(t, Receipts{rc1, rc2}, receipts)
+
+	receipts, err = GetReceiptsFromData(cdc, nil)
+	require.NoError(t, err)
+	require.Empty(t, receipts)
+
+	_, err = GetReceiptsFromData(cdc, []byte{0x10, 0x01})
+	require.Error(t, err)
+}
@@ -124,11 +124,11 @@ func NewResponseResultTx(cdc *codec.Codec, res *ctypes.ResultTx, tx Tx, timestam
 	}
 }
 func StringifyData(cdc *codec.Codec, data []byte) string {
-	var rc Receipt
-	if err := cdc.UnmarshalBinaryLengthPrefixed(data, &rc); err != nil {
+	receipts, err := GetReceiptsFromData(cdc, data)
+	if err != nil || len(receipts) == 0 {
 		return strings.ToUpper(hex.EncodeToString(data))
 	}
-	return fmt.Sprintf("%+v", rc)
+	return receipts.String()
 }
 
 // NewResponseFormatBroadcastTxCommit returns a TxResponse given a
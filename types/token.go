@@ -9,8 +9,24 @@ const (
 	NativeTokenDecimal = 18
 )
 
+// token metadata length limits
+const (
+	MaxTokenNameLength        = 64
+	MaxTokenDescriptionLength = 280
+	MaxTokenLogoURILength     = 256
+	MaxTokenWebsiteLength     = 140
+)
+
+// constant used in flags to indicate that metadata field should not be updated
+const DoNotModifyTokenMetadata = "[do-not-modify]"
+
 var (
 	KeyIsSendEnabled = "is_send_enabled"
+	KeyMaxSupply     = "max_supply"
+	KeyMintable      = "mintable"
+	KeyBurnable      = "burnable"
+	KeyFreezable     = "freezable"
+	KeyPausable      = "pausable"
 )
 
 //TokenInfo defines information in token module
@@ -20,6 +36,16 @@ type TokenInfo struct {
 	IsSendEnabled bool   `json:"is_send_enabled" yaml:"is_send_enabled"` //whether send enabled or not
 	Decimals      uint64 `json:"decimals" yaml:"decimals"`               //token's decimals, represents by the decimals's
 	TotalSupply   Int    `json:"total_supply" yaml:"total_supply" `      //token's total supply
+	PendingIssuer string `json:"pending_issuer" yaml:"pending_issuer"`   //new issuer waiting to accept the ownership
+	MintRenounced bool   `json:"mint_renounced" yaml:"mint_renounced"`   //whether the issuer has given up minting forever
+	MaxSupply     Int    `json:"max_supply" yaml:"max_supply"`           //upper bound of total supply, zero means no cap
+	Mintable      bool   `json:"mintable" yaml:"mintable"`               //whether the token can be inflated
+	Burnable      bool   `json:"burnable" yaml:"burnable"`               //whether the token can be burned
+	Freezable     bool   `json:"freezable" yaml:"freezable"`             //whether the issuer can freeze a holder's balance
+	Pausable      bool   `json:"pausable" yaml:"pausable"`               //whether the issuer can pause all transfers of the token
+
+	Status   TokenStatus   `json:"status" yaml:"status"`     //lifecycle state, governs send, burn, inflate and fee payment
+	Metadata TokenMetadata `json:"metadata" yaml:"metadata"` //human-readable information set by the issuer
 }
 
 type TokenInfoWithoutSupply struct {
@@ -27,6 +53,16 @@ type TokenInfoWithoutSupply struct {
 	Issuer        string `json:"issuer" yaml:"issuer"`                   //token's issuer
 	IsSendEnabled bool   `json:"is_send_enabled" yaml:"is_send_enabled"` //whether send enabled or not
 	Decimals      uint64 `json:"decimals" yaml:"decimals"`               //token's decimals, represents by the decimals's
+	PendingIssuer string `json:"pending_issuer" yaml:"pending_issuer"`   //new issuer waiting to accept the ownership
+	MintRenounced bool   `json:"mint_renounced" yaml:"mint_renounced"`   //whether the issuer has given up minting forever
+	MaxSupply     Int    `json:"max_supply" yaml:"max_supply"`           //upper bound of total supply, zero means no cap
+	Mintable      bool   `json:"mintable" yaml:"mintable"`               //whether the token can be inflated
+	Burnable      bool   `json:"burnable" yaml:"burnable"`               //whether the token can be burned
+	Freezable     bool   `json:"freezable" yaml:"freezable"`             //whether the issuer can freeze a holder's balance
+	Pausable      bool   `json:"pausable" yaml:"pausable"`               //whether the issuer can pause all transfers of the token
+
+	Status   TokenStatus   `json:"status" yaml:"status"`     //lifecycle state, governs send, burn, inflate and fee payment
+	Metadata TokenMetadata `json:"metadata" yaml:"metadata"` //human-readable information set by the issuer
 }
 
 func NewTokenInfo(symbol Symbol, issuer string, isSendEnabled bool, decimals uint64, totalSupply Int) *TokenInfo {
@@ -55,7 +91,16 @@ func (t TokenInfoWithoutSupply) String() string {
 	Issuer:%v
 	IsSendEnabled:%v
 	Decimals:%v
-	`, t.Symbol, t.Issuer, t.IsSendEnabled, t.Decimals)
+	PendingIssuer:%v
+	MintRenounced:%v
+	MaxSupply:%v
+	Mintable:%v
+	Burnable:%v
+	Freezable:%v
+	Pausable:%v
+	Status:%v
+	Metadata:%v
+	`, t.Symbol, t.Issuer, t.IsSendEnabled, t.Decimals, t.PendingIssuer, t.MintRenounced, t.MaxSupply, t.Mintable, t.Burnable, t.Freezable, t.Pausable, t.Status, t.Metadata)
 }
 
 func (t TokenInfoWithoutSupply) IsValid() bool {
@@ -67,6 +112,24 @@ func (t TokenInfoWithoutSupply) IsValid() bool {
 		return false
 	}
 
+	if !t.MaxSupply.IsNil() && t.MaxSupply.IsNegative() {
+		return false
+	}
+
+	if t.PendingIssuer != "" {
+		if _, err := AccAddressFromBech32(t.PendingIssuer); err != nil {
+			return false
+		}
+	}
+
+	if !ValidTokenStatus(t.Status) {
+		return false
+	}
+
+	if err := t.Metadata.EnsureLength(); err != nil {
+		return false
+	}
+
 	return true
 }
 
@@ -77,7 +140,16 @@ func (t TokenInfo) String() string {
 	IsSendEnabled:%v
 	Decimals:%v
 	TotalSupply:%v
-	`, t.Symbol, t.Issuer, t.IsSendEnabled, t.Decimals, t.TotalSupply)
+	PendingIssuer:%v
+	MintRenounced:%v
+	MaxSupply:%v
+	Mintable:%v
+	Burnable:%v
+	Freezable:%v
+	Pausable:%v
+	Status:%v
+	Metadata:%v
+	`, t.Symbol, t.Issuer, t.IsSendEnabled, t.Decimals, t.TotalSupply, t.PendingIssuer, t.MintRenounced, t.MaxSupply, t.Mintable, t.Burnable, t.Freezable, t.Pausable, t.Status, t.Metadata)
 }
 
 func (t TokenInfo) IsValid() bool {
@@ -89,9 +161,101 @@ func (t TokenInfo) IsValid() bool {
 		return false
 	}
 
+	if !t.MaxSupply.IsNil() && t.MaxSupply.IsNegative() {
+		return false
+	}
+
+	if t.IsCapped() && t.TotalSupply.GT(t.MaxSupply) {
+		return false
+	}
+
+	if t.PendingIssuer != "" {
+		if _, err := AccAddressFromBech32(t.PendingIssuer); err != nil {
+			return false
+		}
+	}
+
+	if !ValidTokenStatus(t.Status) {
+		return false
+	}
+
+	if err := t.Metadata.EnsureLength(); err != nil {
+		return false
+	}
+
 	return true
 }
 
+// IsCapped returns whether the token's total supply is bounded by MaxSupply
+func (t TokenInfoWithoutSupply) IsCapped() bool {
+	return !t.MaxSupply.IsNil() && t.MaxSupply.IsPositive()
+}
+
+// IsCapped returns whether the token's total supply is bounded by MaxSupply
+func (t TokenInfo) IsCapped() bool {
+	return !t.MaxSupply.IsNil() && t.MaxSupply.IsPositive()
+}
+
+// TokenMetadata defines the human-readable information of a token
+type TokenMetadata struct {
+	Name        string `json:"name" yaml:"name"`               // full name of the token
+	Description string `json:"description" yaml:"description"` // optional description
+	LogoURI     string `json:"logo_uri" yaml:"logo_uri"`       // optional logo link
+	Website     string `json:"website" yaml:"website"`         // optional website link
+}
+
+// NewTokenMetadata returns a new TokenMetadata with the provided values.
+func NewTokenMetadata(name, description, logoURI, website string) TokenMetadata {
+	return TokenMetadata{
+		Name:        name,
+		Description: description,
+		LogoURI:     logoURI,
+		Website:     website,
+	}
+}
+
+func (m TokenMetadata) String() string {
+	return fmt.Sprintf("{Name:%s Description:%s LogoURI:%s Website:%s}", m.Name, m.Description, m.LogoURI, m.Website)
+}
+
+// UpdateMetadata updates the fields of a given metadata, fields of m2 equal to
+// DoNotModifyTokenMetadata are kept. An error is returned if the resulting
+// metadata contains an invalid length.
+func (m TokenMetadata) UpdateMetadata(m2 TokenMetadata) (TokenMetadata, error) {
+	if m2.Name == DoNotModifyTokenMetadata {
+		m2.Name = m.Name
+	}
+	if m2.Description == DoNotModifyTokenMetadata {
+		m2.Description = m.Description
+	}
+	if m2.LogoURI == DoNotModifyTokenMetadata {
+		m2.LogoURI = m.LogoURI
+	}
+	if m2.Website == DoNotModifyTokenMetadata {
+		m2.Website = m.Website
+	}
+
+	return m2, m2.EnsureLength()
+}
+
+// EnsureLength ensures the length of a token's metadata.
+func (m TokenMetadata) EnsureLength() error {
+	if len(m.Name) > MaxTokenNameLength {
+		return fmt.Errorf("bad metadata length for name, got length %v, max is %v", len(m.Name), MaxTokenNameLength)
+	}
+	if len(m.Description) > MaxTokenDescriptionLength {
+		return fmt.Errorf("bad metadata length for description, got length %v, max is %v", len(m.Description), MaxTokenDescriptionLength)
+	}
+	if len(m.LogoURI) > MaxTokenLogoURILength {
+		return fmt.Errorf("bad metadata length for logo_uri, got length %v, max is %v", len(m.LogoURI), MaxTokenLogoURILength)
+	}
+	if len(m.Website) > MaxTokenWebsiteLength {
+		return fmt.Errorf("bad metadata length for website, got length %v, max is %v", len(m.Website), MaxTokenWebsiteLength)
+	}
+
+	return nil
+}
+
 type Symbol string
 
 // IsValidTokenName check token name.
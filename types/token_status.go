@@ -0,0 +1,109 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// TokenStatus is the lifecycle state of a token
+type TokenStatus byte
+
+//nolint
+const (
+	TokenStatusActive    TokenStatus = 0x00
+	TokenStatusPaused    TokenStatus = 0x01
+	TokenStatusDelisted  TokenStatus = 0x02
+	TokenStatusMigrating TokenStatus = 0x03
+)
+
+// TokenStatusFromString turns a string into a TokenStatus
+func TokenStatusFromString(str string) (TokenStatus, error) {
+	switch str {
+	case "Active":
+		return TokenStatusActive, nil
+
+	case "Paused":
+		return TokenStatusPaused, nil
+
+	case "Delisted":
+		return TokenStatusDelisted, nil
+
+	case "Migrating":
+		return TokenStatusMigrating, nil
+
+	default:
+		return TokenStatus(0xff), fmt.Errorf("'%s' is not a valid token status", str)
+	}
+}
+
+// ValidTokenStatus returns true if the token status is valid and false
+// otherwise.
+func ValidTokenStatus(status TokenStatus) bool {
+	return status == TokenStatusActive ||
+		status == TokenStatusPaused ||
+		status == TokenStatusDelisted ||
+		status == TokenStatusMigrating
+}
+
+// AllowsSend returns whether coins of a token in this state can be transferred
+func (status TokenStatus) AllowsSend() bool {
+	return status == TokenStatusActive || status == TokenStatusMigrating
+}
+
+// AllowsBurn returns whether coins of a token in this state can be burned,
+// holders of a delisted or migrating token may still burn their balance
+func (status TokenStatus) AllowsBurn() bool {
+	return status != TokenStatusPaused
+}
+
+// AllowsInflate returns whether a token in this state can be inflated
+func (status TokenStatus) AllowsInflate() bool {
+	return status == TokenStatusActive
+}
+
+// AllowsFeePayment returns whether a token in this state can pay transaction fees
+func (status TokenStatus) AllowsFeePayment() bool {
+	return status == TokenStatusActive
+}
+
+// Marshals to JSON using string
+func (status TokenStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(status.String())
+}
+
+// Unmarshals from JSON assuming string encoding
+func (status *TokenStatus) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+
+	bz2, err := TokenStatusFromString(s)
+	if err != nil {
+		return err
+	}
+
+	*status = bz2
+	return nil
+}
+
+// String implements the Stringer interface.
+func (status TokenStatus) String() string {
+	switch status {
+	case TokenStatusActive:
+		return "Active"
+
+	case TokenStatusPaused:
+		return "Paused"
+
+	case TokenStatusDelisted:
+		return "Delisted"
+
+	case TokenStatusMigrating:
+		return "Migrating"
+
+	default:
+		return ""
+	}
+}
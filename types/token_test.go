@@ -56,13 +56,14 @@ func TestIsValidTokenName(t *testing.T) {
 }
 
 func TestTokenInfoString(t *testing.T) {
-	expected := "\n\tSymbol:btc\n\tIssuer:iss\n\tIsSendEnabled:false\n\tDecimals:8\n\tTotalSupply:1000000\n\t"
+	expected := "\n\tSymbol:btc\n\tIssuer:iss\n\tIsSendEnabled:false\n\tDecimals:8\n\tTotalSupply:1000000\n\tPendingIssuer:\n\tMintRenounced:false\n\tMaxSupply:0\n\tMintable:false\n\tBurnable:false\n\tFreezable:false\n\tPausable:false\n\tStatus:Active\n\tMetadata:{Name: Description: LogoURI: Website:}\n\t"
 	d := TokenInfo{
 		Symbol:        "btc",
 		Issuer:        "iss",
 		IsSendEnabled: false,
 		Decimals:      8,
 		TotalSupply:   NewInt(1000000),
+		MaxSupply:     ZeroInt(),
 	}
 	assert.Equal(t, expected, d.String())
 }
@@ -102,15 +103,28 @@ func TestTokenInfoIsValid(t *testing.T) {
 	//TotalSupply is 0
 	tokenInfo.TotalSupply = NewInt(0)
 	assert.True(t, tokenInfo.IsValid())
+
+	//TotalSupply exceeds MaxSupply
+	tokenInfo.TotalSupply = NewInt(100)
+	tokenInfo.MaxSupply = NewInt(99)
+	assert.False(t, tokenInfo.IsValid())
+
+	tokenInfo.MaxSupply = NewInt(100)
+	assert.True(t, tokenInfo.IsValid())
+
+	//MaxSupply is negative
+	tokenInfo.MaxSupply = NewInt(-1)
+	assert.False(t, tokenInfo.IsValid())
 }
 
 func TestTokenInfoWithoutSupplyString(t *testing.T) {
-	expected := "\n\tSymbol:btc\n\tIssuer:iss\n\tIsSendEnabled:false\n\tDecimals:8\n\t"
+	expected := "\n\tSymbol:btc\n\tIssuer:iss\n\tIsSendEnabled:false\n\tDecimals:8\n\tPendingIssuer:\n\tMintRenounced:false\n\tMaxSupply:0\n\tMintable:false\n\tBurnable:false\n\tFreezable:false\n\tPausable:false\n\tStatus:Active\n\tMetadata:{Name: Description: LogoURI: Website:}\n\t"
 	d := TokenInfoWithoutSupply{
 		Symbol:        "btc",
 		Issuer:        "iss",
 		IsSendEnabled: false,
 		Decimals:      8,
+		MaxSupply:     ZeroInt(),
 	}
 	assert.Equal(t, expected, d.String())
 }
@@ -141,4 +155,55 @@ func TestTokenInfoWithoutSupplyIsValid(t *testing.T) {
 	tokenInfo.Decimals = 18
 	assert.True(t, tokenInfo.IsValid())
 
+	//PendingIssuer is illegal
+	tokenInfo.PendingIssuer = "iss"
+	assert.False(t, tokenInfo.IsValid())
+}
+
+func TestTokenMetadataUpdate(t *testing.T) {
+	m := NewTokenMetadata("Bitcoin", "digital gold", "https://bitcoin.org/logo.png", "https://bitcoin.org")
+
+	got, err := m.UpdateMetadata(NewTokenMetadata(DoNotModifyTokenMetadata, "peer to peer cash", DoNotModifyTokenMetadata, ""))
+	assert.Nil(t, err)
+	assert.Equal(t, NewTokenMetadata("Bitcoin", "peer to peer cash", "https://bitcoin.org/logo.png", ""), got)
+
+	_, err = m.UpdateMetadata(NewTokenMetadata(strings.Repeat("a", MaxTokenNameLength+1), DoNotModifyTokenMetadata, DoNotModifyTokenMetadata, DoNotModifyTokenMetadata))
+	assert.NotNil(t, err)
+
+	//metadata too long makes the token info invalid
+	tokenInfo := TokenInfoWithoutSupply{
+		Symbol:   Symbol("btc"),
+		Decimals: 8,
+		Metadata: NewTokenMetadata("", "", strings.Repeat("a", MaxTokenLogoURILength+1), ""),
+	}
+	assert.False(t, tokenInfo.IsValid())
+}
+
+func TestTokenStatus(t *testing.T) {
+	for _, status := range []TokenStatus{TokenStatusActive, TokenStatusPaused, TokenStatusDelisted, TokenStatusMigrating} {
+		assert.True(t, ValidTokenStatus(status))
+
+		got, err := TokenStatusFromString(status.String())
+		assert.Nil(t, err)
+		assert.Equal(t, status, got)
+
+		bz, err := status.MarshalJSON()
+		assert.Nil(t, err)
+		assert.Nil(t, got.UnmarshalJSON(bz))
+		assert.Equal(t, status, got)
+	}
+
+	_, err := TokenStatusFromString("Frozen")
+	assert.NotNil(t, err)
+	assert.False(t, ValidTokenStatus(TokenStatus(0x04)))
+
+	//the zero value keeps tokens issued before statuses existed active
+	assert.Equal(t, TokenStatusActive, TokenInfo{}.Status)
+
+	assert.True(t, TokenStatusActive.AllowsSend() && TokenStatusActive.AllowsBurn() && TokenStatusActive.AllowsInflate() && TokenStatusActive.AllowsFeePayment())
+	assert.False(t, TokenStatusPaused.AllowsSend() || TokenStatusPaused.AllowsBurn() || TokenStatusPaused.AllowsInflate() || TokenStatusPaused.AllowsFeePayment())
+	assert.True(t, TokenStatusMigrating.AllowsSend() && TokenStatusMigrating.AllowsBurn())
+	assert.False(t, TokenStatusMigrating.AllowsInflate() || TokenStatusMigrating.AllowsFeePayment())
+	assert.True(t, TokenStatusDelisted.AllowsBurn())
+	assert.False(t, TokenStatusDelisted.AllowsSend() || TokenStatusDelisted.AllowsInflate() || TokenStatusDelisted.AllowsFeePayment())
 }
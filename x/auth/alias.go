@@ -33,6 +33,8 @@ var (
 	NewContinuousVestingAccount    = types.NewContinuousVestingAccount
 	NewDelayedVestingAccountRaw    = types.NewDelayedVestingAccountRaw
 	NewDelayedVestingAccount       = types.NewDelayedVestingAccount
+	NewPeriodicVestingAccountRaw   = types.NewPeriodicVestingAccountRaw
+	NewPeriodicVestingAccount      = types.NewPeriodicVestingAccount
 	RegisterCodec                  = types.RegisterCodec
 	NewGenesisState                = types.NewGenesisState
 	DefaultGenesisState            = types.DefaultGenesisState
@@ -71,6 +73,9 @@ type (
 	BaseVestingAccount       = types.BaseVestingAccount
 	ContinuousVestingAccount = types.ContinuousVestingAccount
 	DelayedVestingAccount    = types.DelayedVestingAccount
+	PeriodicVestingAccount   = types.PeriodicVestingAccount
+	Period                   = types.Period
+	Periods                  = types.Periods
 	GenesisState             = types.GenesisState
 	Params                   = types.Params
 	QueryAccountParams       = types.QueryAccountParams
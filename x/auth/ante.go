@@ -34,8 +34,9 @@ type SignatureVerificationGasConsumer = func(meter sdk.GasMeter, sig []byte, pub
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
-func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
+// signer. Fees paid in denoms not accepted by the feeTokenKeeper are rejected,
+// a nil feeTokenKeeper accepts any denom.
+func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, feeTokenKeeper types.FeeTokenKeeper, sigGasConsumer SignatureVerificationGasConsumer) sdk.AnteHandler {
 	return func(
 		ctx sdk.Context, tx sdk.Tx, simulate bool,
 	) (newCtx sdk.Context, res sdk.Result, abort bool) {
@@ -55,11 +56,17 @@ func NewAnteHandler(ak AccountKeeper, supplyKeeper types.SupplyKeeper, sigGasCon
 
 		params := ak.GetParams(ctx)
 
+		if res := ValidateFeeTokens(ctx, feeTokenKeeper, stdTx.Fee); !res.IsOK() {
+			return newCtx, res, true
+		}
+
 		// Ensure that the provided fees meet a minimum threshold for the validator,
 		// if this is a CheckTx. This is only for local mempool purposes, and thus
 		// is only ran on check tx.
 		if ctx.IsCheckTx() && !simulate {
-			res := EnsureSufficientMempoolFees(ctx, stdTx.Fee)
+			fee := stdTx.Fee
+			fee.Amount = ConvertFeeTokens(ctx, feeTokenKeeper, fee.Amount)
+			res := EnsureSufficientMempoolFees(ctx, fee)
 			if !res.IsOK() {
 				return newCtx, res, true
 			}
@@ -297,7 +304,7 @@ func DefaultSigVerificationGasConsumer(
 	switch pubkey := pubkey.(type) {
 	case ed25519.PubKeyEd25519:
 		meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
-		return sdk.ErrInvalidPubKey("ED25519 public keys are unsupported").Result()
+		return sdk.Result{}
 
 	case secp256k1.PubKeySecp256k1:
 		meter.ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
@@ -397,6 +404,46 @@ func EnsureSufficientMempoolFees(ctx sdk.Context, stdFee StdFee) sdk.Result {
 	return sdk.Result{}
 }
 
+// ValidateFeeTokens ensures every denom of the provided fees is accepted as fee
+// by the feeTokenKeeper. It is a no-op if feeTokenKeeper is nil.
+func ValidateFeeTokens(ctx sdk.Context, feeTokenKeeper types.FeeTokenKeeper, stdFee StdFee) sdk.Result {
+	if feeTokenKeeper == nil {
+		return sdk.Result{}
+	}
+
+	for _, coin := range stdFee.Amount {
+		if _, ok := feeTokenKeeper.GetFeeTokenRate(ctx, coin.Denom); !ok {
+			return sdk.ErrInvalidCoins(
+				fmt.Sprintf("%s is not an accepted fee token", coin.Denom),
+			).Result()
+		}
+	}
+
+	return sdk.Result{}
+}
+
+// ConvertFeeTokens returns the fees with the value of every accepted fee token,
+// i.e. its amount times its rate, added to the native token amount, so that the
+// fees can be checked against minimum gas prices set in the native token. It
+// returns the fees unchanged if feeTokenKeeper is nil.
+func ConvertFeeTokens(ctx sdk.Context, feeTokenKeeper types.FeeTokenKeeper, fees sdk.Coins) sdk.Coins {
+	if feeTokenKeeper == nil {
+		return fees
+	}
+
+	value := sdk.ZeroInt()
+	for _, coin := range fees {
+		if coin.Denom == sdk.NativeToken {
+			continue
+		}
+		if rate, ok := feeTokenKeeper.GetFeeTokenRate(ctx, coin.Denom); ok {
+			value = value.Add(rate.MulInt(coin.Amount).TruncateInt())
+		}
+	}
+
+	return fees.Add(sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, value)))
+}
+
 // SetGasMeter returns a new context with a gas meter set from a given context.
 func SetGasMeter(simulate bool, ctx sdk.Context, gasLimit uint64) sdk.Context {
 	// In various cases such as simulation and during the genesis block, we do not
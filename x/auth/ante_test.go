@@ -12,6 +12,7 @@ import (
 	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
+	"github.com/pocblockchain/pocc/crypto/keys"
 	sdk "github.com/pocblockchain/pocc/types"
 	"github.com/pocblockchain/pocc/x/auth/types"
 )
@@ -49,7 +50,7 @@ func TestAnteHandlerSigErrors(t *testing.T) {
 	// setup
 	input := setupTestInput()
 	ctx := input.ctx
-	anteHandler := NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -97,7 +98,7 @@ func TestAnteHandlerSigErrors(t *testing.T) {
 func TestAnteHandlerAccountNumbers(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -154,7 +155,7 @@ func TestAnteHandlerAccountNumbers(t *testing.T) {
 func TestAnteHandlerAccountNumbersAtBlockHeightZero(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(0)
 
 	// keys and addresses
@@ -210,7 +211,7 @@ func TestAnteHandlerAccountNumbersAtBlockHeightZero(t *testing.T) {
 func TestAnteHandlerSequences(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -288,7 +289,7 @@ func TestAnteHandlerFees(t *testing.T) {
 	// setup
 	input := setupTestInput()
 	ctx := input.ctx
-	anteHandler := NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 
 	// keys and addresses
 	priv1, _, addr1 := types.KeyTestPubAddr()
@@ -323,11 +324,118 @@ func TestAnteHandlerFees(t *testing.T) {
 	require.True(sdk.IntEq(t, input.ak.GetAccount(ctx, addr1).GetCoins().AmountOf("atom"), sdk.NewInt(0)))
 }
 
+type mockFeeTokenKeeper map[string]sdk.Dec
+
+func (fk mockFeeTokenKeeper) GetFeeTokenRate(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	rate, ok := fk[denom]
+	return rate, ok
+}
+
+// Test fees paid in denoms not accepted by the fee token keeper are rejected.
+func TestAnteHandlerFeeTokens(t *testing.T) {
+	// setup
+	input := setupTestInput()
+	ctx := input.ctx
+	feeTokenKeeper := mockFeeTokenKeeper{"btc": sdk.OneDec()}
+	anteHandler := NewAnteHandler(input.ak, input.sk, feeTokenKeeper, DefaultSigVerificationGasConsumer)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// set the accounts
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 150), sdk.NewInt64Coin("btc", 150)))
+	input.ak.SetAccount(ctx, acc1)
+
+	// msg and signatures
+	var tx sdk.Tx
+	msg := types.NewTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	msgs := []sdk.Msg{msg}
+
+	// atom is not an accepted fee token
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, types.NewTestStdFee())
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInvalidCoins)
+
+	fee := NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("btc", 100)))
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInvalidCoins)
+	require.True(t, input.sk.GetModuleAccount(ctx, types.FeeCollectorName).GetCoins().Empty())
+
+	fee = NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("btc", 150)))
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	require.True(sdk.IntEq(t, input.sk.GetModuleAccount(ctx, types.FeeCollectorName).GetCoins().AmountOf("btc"), sdk.NewInt(150)))
+	require.True(sdk.IntEq(t, input.ak.GetAccount(ctx, addr1).GetCoins().AmountOf("atom"), sdk.NewInt(150)))
+}
+
+// Test fees paid in fee tokens are converted with their rate before being checked
+// against the minimum gas prices.
+func TestAnteHandlerFeeTokenRate(t *testing.T) {
+	// setup
+	input := setupTestInput()
+	ctx := input.ctx.WithIsCheckTx(true).WithMinGasPrices(
+		sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.NativeToken, sdk.NewDecWithPrec(1, 2))}, // 0.01poc
+	)
+	feeTokenKeeper := mockFeeTokenKeeper{
+		sdk.NativeToken: sdk.OneDec(),
+		"btc":           sdk.NewDec(4),
+		"eth":           sdk.NewDecWithPrec(5, 1),
+	}
+	anteHandler := NewAnteHandler(input.ak, input.sk, feeTokenKeeper, DefaultSigVerificationGasConsumer)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+
+	// set the accounts
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(sdk.NativeToken, 1000), sdk.NewInt64Coin("btc", 1000), sdk.NewInt64Coin("eth", 1000)))
+	input.ak.SetAccount(ctx, acc1)
+
+	// msg and signatures
+	var tx sdk.Tx
+	msg := types.NewTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	msgs := []sdk.Msg{msg}
+
+	// 50000 gas requires 500poc, 124btc are only worth 496poc
+	fee := NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("btc", 124)))
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInsufficientFee)
+
+	// 999eth are only worth 499poc
+	fee = NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("eth", 999)))
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInsufficientFee)
+
+	// the value of the fee tokens adds up with the native token
+	fee = NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin(sdk.NativeToken, 100), sdk.NewInt64Coin("btc", 50), sdk.NewInt64Coin("eth", 399)))
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInsufficientFee)
+
+	// 125btc are worth 500poc
+	fee = NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin("btc", 125)))
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	seqs = []uint64{1}
+	fee = NewStdFee(50000, sdk.NewCoins(sdk.NewInt64Coin(sdk.NativeToken, 100), sdk.NewInt64Coin("btc", 50), sdk.NewInt64Coin("eth", 400)))
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	// the fees are paid in the tokens given, not converted
+	collected := input.sk.GetModuleAccount(ctx, types.FeeCollectorName).GetCoins()
+	require.True(sdk.IntEq(t, collected.AmountOf(sdk.NativeToken), sdk.NewInt(100)))
+	require.True(sdk.IntEq(t, collected.AmountOf("btc"), sdk.NewInt(175)))
+	require.True(sdk.IntEq(t, collected.AmountOf("eth"), sdk.NewInt(400)))
+}
+
 // Test logic around memo gas consumption.
 func TestAnteHandlerMemoGas(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -367,7 +475,7 @@ func TestAnteHandlerMemoGas(t *testing.T) {
 func TestAnteHandlerMultiSigner(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -417,7 +525,7 @@ func TestAnteHandlerMultiSigner(t *testing.T) {
 func TestAnteHandlerBadSignBytes(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -494,7 +602,7 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 func TestAnteHandlerSetPubKey(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -603,7 +711,7 @@ func TestConsumeSignatureVerificationGas(t *testing.T) {
 		gasConsumed uint64
 		shouldErr   bool
 	}{
-		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, DefaultSigVerifyCostED25519, true},
+		{"PubKeyEd25519", args{sdk.NewInfiniteGasMeter(), nil, ed25519.GenPrivKey().PubKey(), params}, DefaultSigVerifyCostED25519, false},
 		{"PubKeySecp256k1", args{sdk.NewInfiniteGasMeter(), nil, secp256k1.GenPrivKey().PubKey(), params}, DefaultSigVerifyCostSecp256k1, false},
 		{"Multisig", args{sdk.NewInfiniteGasMeter(), multisignature1.Marshal(), multisigKey1, params}, expectedCost1, false},
 		{"unknown key", args{sdk.NewInfiniteGasMeter(), nil, nil, params}, 0, true},
@@ -690,7 +798,7 @@ func TestCountSubkeys(t *testing.T) {
 func TestAnteHandlerSigLimitExceeded(t *testing.T) {
 	// setup
 	input := setupTestInput()
-	anteHandler := NewAnteHandler(input.ak, input.sk, DefaultSigVerificationGasConsumer)
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
 	ctx := input.ctx.WithBlockHeight(1)
 
 	// keys and addresses
@@ -780,7 +888,7 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	// setup
 	input := setupTestInput()
 	// setup an ante handler that only accepts PubKeyEd25519
-	anteHandler := NewAnteHandler(input.ak, input.sk, func(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params Params) sdk.Result {
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, func(meter sdk.GasMeter, sig []byte, pubkey crypto.PubKey, params Params) sdk.Result {
 		switch pubkey := pubkey.(type) {
 		case ed25519.PubKeyEd25519:
 			meter.ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
@@ -820,3 +928,58 @@ func TestCustomSignatureVerificationGasConsumer(t *testing.T) {
 	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
+
+// Test that the default ante handler accepts ed25519 accounts, including ones
+// derived from a mnemonic by the keybase, and charges them the ed25519 cost
+func TestAnteHandlerEd25519Accounts(t *testing.T) {
+	// setup
+	input := setupTestInput()
+	anteHandler := NewAnteHandler(input.ak, input.sk, nil, DefaultSigVerificationGasConsumer)
+	ctx := input.ctx.WithBlockHeight(1)
+
+	kb := keys.NewInMemory()
+	mnemonic := "lounge degree orphan snap fox prefer rail jealous rebuild flock mistake spell put know peace skate game laptop mixture amount unique fan sound gallery"
+	info, err := kb.CreateAccount("ed", mnemonic, "", "12345678", 0, 0, keys.Ed25519)
+	require.NoError(t, err)
+	require.Equal(t, keys.Ed25519, info.GetAlgo())
+	priv1, err := kb.ExportPrivateKeyObject("ed", "12345678")
+	require.NoError(t, err)
+	addr1 := info.GetAddress()
+
+	priv2 := ed25519.GenPrivKey()
+	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+
+	acc1 := input.ak.NewAccountWithAddress(ctx, addr1)
+	require.NoError(t, acc1.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+	input.ak.SetAccount(ctx, acc1)
+	acc2 := input.ak.NewAccountWithAddress(ctx, addr2)
+	require.NoError(t, acc2.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+	require.NoError(t, acc2.SetAccountNumber(1))
+	input.ak.SetAccount(ctx, acc2)
+
+	// a single ed25519 signer is charged the ed25519 verification cost
+	var tx sdk.Tx
+	msg := types.NewTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	fee := types.NewTestStdFee()
+	msgs := []sdk.Msg{msg}
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+
+	newCtx, result, abort := anteHandler(ctx, tx, false)
+	require.False(t, abort)
+	require.True(t, result.IsOK(), result.Log)
+	require.True(t, newCtx.GasMeter().GasConsumed() >= DefaultSigVerifyCostED25519)
+	require.Equal(t, priv1.PubKey(), input.ak.GetAccount(ctx, addr1).GetPubKey())
+
+	// ed25519 signers can be mixed with each other in one tx
+	msgs = []sdk.Msg{types.NewTestMsg(addr1, addr2)}
+	privs, accnums, seqs = []crypto.PrivKey{priv1, priv2}, []uint64{0, 1}, []uint64{1, 0}
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	// a signature from the wrong ed25519 key is rejected
+	msgs = []sdk.Msg{types.NewTestMsg(addr1)}
+	privs, accnums, seqs = []crypto.PrivKey{priv2}, []uint64{0}, []uint64{2}
+	tx = types.NewTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+}
@@ -0,0 +1,399 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+
+	"github.com/pocblockchain/pocc/client/context"
+	"github.com/pocblockchain/pocc/client/flags"
+	"github.com/pocblockchain/pocc/client/input"
+	"github.com/pocblockchain/pocc/client/keys"
+	"github.com/pocblockchain/pocc/codec"
+	"github.com/pocblockchain/pocc/crypto"
+	crkeys "github.com/pocblockchain/pocc/crypto/keys"
+	"github.com/pocblockchain/pocc/crypto/keys/hd"
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/auth/exported"
+	"github.com/pocblockchain/pocc/x/auth/types"
+)
+
+const (
+	flagDeriveAccount  = "account"
+	flagDeriveAccounts = "accounts"
+	flagDeriveIndex    = "index"
+	flagDeriveCount    = "count"
+	flagDeriveAlgo     = "algo"
+	flagFromKey        = "from-key"
+	flagDiscover       = "discover"
+	flagGapLimit       = "gap-limit"
+	flagSave           = "save"
+	flagInteractive    = "interactive"
+
+	saveLocal   = "local"
+	saveOffline = "offline"
+
+	// defaultGapLimit is the BIP44 number of consecutive unused addresses
+	// after which the discovery of an account stops.
+	defaultGapLimit = 20
+)
+
+// derivedKey is a key derived by the keys derive command.
+type derivedKey struct {
+	Name     string    `json:"name" yaml:"name"`
+	Path     string    `json:"path" yaml:"path"`
+	Address  string    `json:"address" yaml:"address"`
+	PubKey   string    `json:"pubkey" yaml:"pubkey"`
+	Used     bool      `json:"used" yaml:"used"`
+	Coins    sdk.Coins `json:"coins,omitempty" yaml:"coins,omitempty"`
+	Sequence uint64    `json:"sequence,omitempty" yaml:"sequence,omitempty"`
+
+	pubKey tmcrypto.PubKey
+	params hd.BIP44Params
+}
+
+type derivedKeys []derivedKey
+
+func (dks derivedKeys) String() string {
+	var sb strings.Builder
+	for _, dk := range dks {
+		sb.WriteString(fmt.Sprintf("%s\t%s\t%s", dk.Name, dk.Path, dk.Address))
+		if dk.Used {
+			sb.WriteString(fmt.Sprintf("\tused\t%s", dk.Coins))
+		}
+		sb.WriteString("\n")
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+// addressChecker returns the on chain account of an address, or nil if the
+// address has never been used.
+type addressChecker func(addr sdk.AccAddress) (exported.Account, error)
+
+// deriveSource is what the keys are derived from, either a mnemonic or the
+// Ledger device holding a stored Ledger key.
+type deriveSource struct {
+	mnemonic        string
+	bip39Passphrase string
+	algo            crkeys.SigningAlgo
+	ledger          bool
+}
+
+// pubKey returns the public key of the BIP44 path, tmp is a transient keybase
+// the mnemonic keys are derived into.
+func (src deriveSource) pubKey(tmp crkeys.Keybase, params hd.BIP44Params) (tmcrypto.PubKey, error) {
+	if src.ledger {
+		priv, err := crypto.NewPrivKeyLedgerSecp256k1Unsafe(params)
+		if err != nil {
+			return nil, err
+		}
+		return priv.PubKey(), nil
+	}
+
+	info, err := tmp.Derive(params.String(), src.mnemonic, src.bip39Passphrase, "", params, src.algo)
+	if err != nil {
+		return nil, err
+	}
+	return info.GetPubKey(), nil
+}
+
+// ledgerSource returns the source deriving the keys on the Ledger device of
+// the stored key, after checking the device does hold it. The other types of
+// keys only hold the private key of their own path and cannot derive others.
+func ledgerSource(kb crkeys.Keybase, name string) (deriveSource, error) {
+	info, err := kb.Get(name)
+	if err != nil {
+		return deriveSource{}, err
+	}
+	if info.GetType() != crkeys.TypeLedger {
+		return deriveSource{}, fmt.Errorf("%s is a %s key which cannot derive other paths, only Ledger keys can, "+
+			"use its mnemonic instead", name, info.GetType())
+	}
+
+	src := deriveSource{algo: crkeys.Secp256k1, ledger: true}
+	path, err := info.GetPath()
+	if err != nil {
+		return deriveSource{}, err
+	}
+	pub, err := src.pubKey(nil, *path)
+	if err != nil {
+		return deriveSource{}, err
+	}
+	if !pub.Equals(info.GetPubKey()) {
+		return deriveSource{}, fmt.Errorf("the connected Ledger device does not hold key %s", name)
+	}
+	return src, nil
+}
+
+// deriveRange is the range of BIP44 paths the keys are derived for.
+type deriveRange struct {
+	account  uint32
+	accounts uint32
+	index    uint32
+	count    uint32
+	gapLimit uint32
+}
+
+// GetDeriveKeysCommand returns the command deriving keys for a range of BIP44
+// accounts and indexes from a mnemonic or a stored Ledger key, it is mounted
+// under the keys command.
+func GetDeriveKeysCommand(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derive <name-prefix>",
+		Short: "Derive the keys of a range of accounts and indexes from a mnemonic or a stored Ledger key",
+		Long: `Derive the keys of a range of BIP44 accounts and address indexes from a
+BIP39 mnemonic read from STDIN. The keys are named <name-prefix>-<account>-<index>.
+
+With --from-key, the keys are derived by the Ledger device of the stored Ledger
+key instead, and no mnemonic is read. Local and offline keys only hold the key
+of their own path, they cannot be used to derive other paths.
+
+With --discover, the chain is queried for the accounts of the derived addresses
+and the indexes of every account are scanned until --gap-limit consecutive
+addresses that have never been used are found, --count is then ignored.
+
+With --save, the derived keys are registered in the keybase, either as local
+keys encrypted with one password or as offline keys holding the public key
+only. The local keys derived by a Ledger are registered as Ledger keys, each
+one confirmed on the device. When discovering, only the used addresses are
+registered.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDeriveKeysCmd(cmd, cdc, args[0])
+		},
+	}
+	cmd.Flags().Uint32(flagDeriveAccount, 0, "First account number for HD derivation")
+	cmd.Flags().Uint32(flagDeriveAccounts, 1, "Number of accounts to derive")
+	cmd.Flags().Uint32(flagDeriveIndex, 0, "First address index number for HD derivation")
+	cmd.Flags().Uint32(flagDeriveCount, 20, "Number of address indexes to derive per account")
+	cmd.Flags().String(flagDeriveAlgo, string(crkeys.Secp256k1), "Key signing algorithm to derive the keys from a mnemonic with, secp256k1 or ed25519")
+	cmd.Flags().String(flagFromKey, "", "Derive the keys on the Ledger device of this stored Ledger key instead of from a mnemonic")
+	cmd.Flags().Bool(flagDiscover, false, "Query the chain to find the used addresses")
+	cmd.Flags().Uint32(flagGapLimit, defaultGapLimit, "Consecutive unused addresses after which the discovery of an account stops")
+	cmd.Flags().String(flagSave, "", "Register the derived keys in the keybase as local or offline keys")
+	cmd.Flags().BoolP(flagInteractive, "i", false, "Interactively prompt user for BIP39 passphrase")
+	cmd.Flags().String(flags.FlagNode, "tcp://localhost:26657", "<host>:<port> to Tendermint RPC interface for this chain")
+	cmd.Flags().Bool(flags.FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
+	cmd.Flags().Bool(flags.FlagIndentResponse, false, "Add indent to JSON response")
+	return cmd
+}
+
+func runDeriveKeysCmd(cmd *cobra.Command, cdc *codec.Codec, prefix string) error {
+	save := viper.GetString(flagSave)
+	if save != "" && save != saveLocal && save != saveOffline {
+		return fmt.Errorf("invalid --%s %s, must be %s or %s", flagSave, save, saveLocal, saveOffline)
+	}
+
+	rng := deriveRange{
+		account:  uint32(viper.GetInt(flagDeriveAccount)),
+		accounts: uint32(viper.GetInt(flagDeriveAccounts)),
+		index:    uint32(viper.GetInt(flagDeriveIndex)),
+		count:    uint32(viper.GetInt(flagDeriveCount)),
+		gapLimit: uint32(viper.GetInt(flagGapLimit)),
+	}
+	if rng.accounts == 0 || rng.count == 0 || rng.gapLimit == 0 {
+		return errors.New("the number of accounts, indexes and the gap limit must be positive")
+	}
+
+	kb, err := keys.NewKeyBaseFromHomeFlag()
+	if err != nil {
+		return err
+	}
+
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	var src deriveSource
+	if name := viper.GetString(flagFromKey); name != "" {
+		src, err = ledgerSource(kb, name)
+	} else {
+		src, err = mnemonicSource(inBuf, crkeys.SigningAlgo(viper.GetString(flagDeriveAlgo)))
+	}
+	if err != nil {
+		return err
+	}
+
+	var encryptPassword string
+	if save == saveLocal && !src.ledger {
+		encryptPassword, err = input.GetCheckPassword(
+			"Enter a passphrase to encrypt the derived keys to disk:",
+			"Repeat the passphrase:", inBuf)
+		if err != nil {
+			return err
+		}
+	}
+
+	var check addressChecker
+	if viper.GetBool(flagDiscover) {
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+		check = func(addr sdk.AccAddress) (exported.Account, error) {
+			return queryUsedAccount(cliCtx, addr)
+		}
+	}
+
+	derived, err := deriveKeys(prefix, src, rng, check)
+	if err != nil {
+		return err
+	}
+
+	if save != "" {
+		toSave := derived
+		if check != nil {
+			toSave = usedKeys(derived)
+		}
+		if err := saveDerivedKeys(kb, toSave, save, src, encryptPassword); err != nil {
+			return err
+		}
+		cmd.PrintErrf("%d keys registered as %s keys\n", len(toSave), save)
+	}
+
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+	return cliCtx.PrintOutput(derived)
+}
+
+// mnemonicSource reads the mnemonic, and the BIP39 passphrase if asked to, the
+// keys are derived from.
+func mnemonicSource(inBuf *bufio.Reader, algo crkeys.SigningAlgo) (deriveSource, error) {
+	mnemonic, err := input.GetString("Enter your bip39 mnemonic", inBuf)
+	if err != nil {
+		return deriveSource{}, err
+	}
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return deriveSource{}, errors.New("invalid mnemonic")
+	}
+
+	var bip39Passphrase string
+	if viper.GetBool(flagInteractive) {
+		bip39Passphrase, err = input.GetString(
+			"Enter your bip39 passphrase. This is combined with the mnemonic to derive the seed. "+
+				"Most users should just hit enter to use the default, \"\"", inBuf)
+		if err != nil {
+			return deriveSource{}, err
+		}
+	}
+	return deriveSource{mnemonic: mnemonic, bip39Passphrase: bip39Passphrase, algo: algo}, nil
+}
+
+// deriveKeys derives the keys of the range from the source. If check is not
+// nil, the indexes of every account are scanned until gapLimit consecutive
+// unused addresses are found instead of deriving count indexes.
+func deriveKeys(prefix string, src deriveSource, rng deriveRange, check addressChecker) (derivedKeys, error) {
+	// derive into a transient keybase without password to get the public keys only
+	tmp := crkeys.NewInMemory()
+	coinType := sdk.GetConfig().GetCoinType()
+
+	var res derivedKeys
+	for account := rng.account; account < rng.account+rng.accounts; account++ {
+		unused := uint32(0)
+		for index := rng.index; ; index++ {
+			if check == nil && index >= rng.index+rng.count {
+				break
+			}
+			if check != nil && unused >= rng.gapLimit {
+				break
+			}
+
+			name := fmt.Sprintf("%s-%d-%d", prefix, account, index)
+			params := *hd.NewFundraiserParams(account, coinType, index)
+			pub, err := src.pubKey(tmp, params)
+			if err != nil {
+				return nil, err
+			}
+			pubKey, err := sdk.Bech32ifyAccPub(pub)
+			if err != nil {
+				return nil, err
+			}
+
+			addr := sdk.AccAddress(pub.Address())
+			dk := derivedKey{
+				Name:    name,
+				Path:    params.String(),
+				Address: addr.String(),
+				PubKey:  pubKey,
+				pubKey:  pub,
+				params:  params,
+			}
+			if check != nil {
+				acc, err := check(addr)
+				if err != nil {
+					return nil, err
+				}
+				if acc != nil {
+					dk.Used = true
+					dk.Coins = acc.GetCoins()
+					dk.Sequence = acc.GetSequence()
+					unused = 0
+				} else {
+					unused++
+				}
+			}
+			res = append(res, dk)
+		}
+	}
+	return res, nil
+}
+
+// usedKeys returns the derived keys whose address has been used on chain.
+func usedKeys(dks derivedKeys) derivedKeys {
+	var res derivedKeys
+	for _, dk := range dks {
+		if dk.Used {
+			res = append(res, dk)
+		}
+	}
+	return res
+}
+
+// saveDerivedKeys registers the derived keys in the keybase as local or offline
+// keys, the local keys derived by a Ledger as Ledger keys. Nothing is registered
+// if a key with one of the names already exists.
+func saveDerivedKeys(kb crkeys.Keybase, dks derivedKeys, save string, src deriveSource, encryptPassword string) error {
+
+	for _, dk := range dks {
+		if _, err := kb.Get(dk.Name); err == nil {
+			return fmt.Errorf("cannot overwrite key %s", dk.Name)
+		}
+	}
+
+	for _, dk := range dks {
+		var err error
+		switch save {
+		case saveLocal:
+			if src.ledger {
+				hrp := sdk.GetConfig().GetBech32AccountAddrPrefix()
+				_, err = kb.CreateLedger(dk.Name, src.algo, hrp, dk.params.Account, dk.params.AddressIndex)
+				break
+			}
+			_, err = kb.Derive(dk.Name, src.mnemonic, src.bip39Passphrase, encryptPassword, dk.params, src.algo)
+		case saveOffline:
+			_, err = kb.CreateOffline(dk.Name, dk.pubKey)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queryUsedAccount returns the account of the address, or nil if there is no
+// account, meaning the address never received coins.
+func queryUsedAccount(cliCtx context.CLIContext, addr sdk.AccAddress) (exported.Account, error) {
+	res, _, err := cliCtx.QueryStore(types.AddressStoreKey(addr), types.StoreKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(res) == 0 {
+		return nil, nil
+	}
+
+	var acc exported.Account
+	if err := cliCtx.Codec.UnmarshalBinaryBare(res, &acc); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
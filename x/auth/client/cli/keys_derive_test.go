@@ -0,0 +1,159 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	crkeys "github.com/pocblockchain/pocc/crypto/keys"
+	"github.com/pocblockchain/pocc/tests"
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/auth/exported"
+	"github.com/pocblockchain/pocc/x/auth/types"
+)
+
+func mnemonicTestSource(algo crkeys.SigningAlgo) deriveSource {
+	return deriveSource{mnemonic: tests.TestMnemonic, algo: algo}
+}
+
+func TestDeriveKeys(t *testing.T) {
+	rng := deriveRange{account: 1, accounts: 2, index: 3, count: 2, gapLimit: defaultGapLimit}
+	dks, err := deriveKeys("deposit", mnemonicTestSource(crkeys.Secp256k1), rng, nil)
+	require.NoError(t, err)
+	require.Len(t, dks, 4)
+
+	kb := crkeys.NewInMemory()
+	expected := []struct {
+		name           string
+		account, index uint32
+	}{
+		{"deposit-1-3", 1, 3}, {"deposit-1-4", 1, 4}, {"deposit-2-3", 2, 3}, {"deposit-2-4", 2, 4},
+	}
+	for i, exp := range expected {
+		info, err := kb.CreateAccount(exp.name, tests.TestMnemonic, "", "", exp.account, exp.index, crkeys.Secp256k1)
+		require.NoError(t, err)
+		require.Equal(t, exp.name, dks[i].Name)
+		require.Equal(t, info.GetAddress().String(), dks[i].Address)
+		require.Equal(t, info.GetPubKey(), dks[i].pubKey)
+		require.False(t, dks[i].Used)
+	}
+
+	ed, err := deriveKeys("deposit", mnemonicTestSource(crkeys.Ed25519), rng, nil)
+	require.NoError(t, err)
+	require.NotEqual(t, dks[0].Address, ed[0].Address)
+
+	_, err = deriveKeys("deposit", mnemonicTestSource(crkeys.Sr25519), rng, nil)
+	require.Error(t, err)
+}
+
+func TestDeriveKeysDiscovery(t *testing.T) {
+	all, err := deriveKeys("d", mnemonicTestSource(crkeys.Secp256k1),
+		deriveRange{account: 0, accounts: 2, index: 0, count: 10, gapLimit: defaultGapLimit}, nil)
+	require.NoError(t, err)
+
+	// indexes 1 and 4 of account 0 and none of account 1 have been used
+	used := map[string]sdk.Coins{
+		all[1].Address: sdk.NewCoins(sdk.NewInt64Coin("poc", 10)),
+		all[4].Address: sdk.NewCoins(sdk.NewInt64Coin("poc", 20)),
+	}
+	var queried int
+	check := func(addr sdk.AccAddress) (exported.Account, error) {
+		queried++
+		coins, ok := used[addr.String()]
+		if !ok {
+			return nil, nil
+		}
+		acc := types.NewBaseAccountWithAddress(addr)
+		require.NoError(t, acc.SetCoins(coins))
+		return &acc, nil
+	}
+
+	rng := deriveRange{account: 0, accounts: 2, index: 0, count: 1, gapLimit: 3}
+	dks, err := deriveKeys("d", mnemonicTestSource(crkeys.Secp256k1), rng, check)
+	require.NoError(t, err)
+
+	// account 0 is scanned up to 3 unused addresses after index 4, account 1 up to index 2
+	require.Len(t, dks, 8+3)
+	require.Equal(t, len(dks), queried)
+	require.Equal(t, "d-0-7", dks[7].Name)
+	require.Equal(t, "d-1-2", dks[10].Name)
+
+	found := usedKeys(dks)
+	require.Len(t, found, 2)
+	require.Equal(t, "d-0-1", found[0].Name)
+	require.Equal(t, used[found[0].Address], found[0].Coins)
+	require.Equal(t, "d-0-4", found[1].Name)
+	require.Equal(t, used[found[1].Address], found[1].Coins)
+}
+
+func TestSaveDerivedKeys(t *testing.T) {
+	rng := deriveRange{account: 0, accounts: 1, index: 0, count: 3, gapLimit: defaultGapLimit}
+	dks, err := deriveKeys("k", mnemonicTestSource(crkeys.Ed25519), rng, nil)
+	require.NoError(t, err)
+
+	kb := crkeys.NewInMemory()
+	require.NoError(t, saveDerivedKeys(kb, dks[:2], saveOffline, mnemonicTestSource(crkeys.Ed25519), ""))
+	info, err := kb.Get("k-0-1")
+	require.NoError(t, err)
+	require.Equal(t, crkeys.TypeOffline, info.GetType())
+	require.Equal(t, dks[1].Address, info.GetAddress().String())
+
+	// nothing is registered when a name is taken
+	require.Error(t, saveDerivedKeys(kb, dks, saveLocal, mnemonicTestSource(crkeys.Ed25519), "12345678"))
+	_, err = kb.Get("k-0-2")
+	require.Error(t, err)
+
+	kb = crkeys.NewInMemory()
+	require.NoError(t, saveDerivedKeys(kb, dks, saveLocal, mnemonicTestSource(crkeys.Ed25519), "12345678"))
+	infos, err := kb.List()
+	require.NoError(t, err)
+	require.Len(t, infos, 3)
+	for i, info := range infos {
+		require.Equal(t, crkeys.TypeLocal, info.GetType())
+		require.Equal(t, dks[i].Address, info.GetAddress().String())
+	}
+	_, pub, err := kb.Sign("k-0-2", "12345678", []byte("msg"))
+	require.NoError(t, err)
+	require.Equal(t, dks[2].pubKey, pub)
+}
+
+func TestLedgerSource(t *testing.T) {
+	kb := crkeys.NewInMemory()
+	_, err := ledgerSource(kb, "missing")
+	require.Error(t, err)
+
+	// local and offline keys only hold the key of their own path
+	info, err := kb.CreateAccount("local", tests.TestMnemonic, "", "12345678", 0, 0, crkeys.Secp256k1)
+	require.NoError(t, err)
+	_, err = ledgerSource(kb, "local")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot derive other paths")
+
+	_, err = kb.CreateOffline("offline", info.GetPubKey())
+	require.NoError(t, err)
+	_, err = ledgerSource(kb, "offline")
+	require.Error(t, err)
+
+	// without ledger support the device is not available, with the ledger mock
+	// it derives the keys of the test mnemonic
+	_, err = kb.CreateLedger("ledger", crkeys.Secp256k1, sdk.GetConfig().GetBech32AccountAddrPrefix(), 0, 0)
+	if err != nil {
+		require.Equal(t, "ledger nano S: support for ledger devices is not available in this executable", err.Error())
+		return
+	}
+	src, err := ledgerSource(kb, "ledger")
+	require.NoError(t, err)
+
+	rng := deriveRange{account: 0, accounts: 2, index: 0, count: 2, gapLimit: defaultGapLimit}
+	dks, err := deriveKeys("ledger", src, rng, nil)
+	require.NoError(t, err)
+	expected, err := deriveKeys("ledger", mnemonicTestSource(crkeys.Secp256k1), rng, nil)
+	require.NoError(t, err)
+	require.Equal(t, expected, dks)
+
+	require.NoError(t, saveDerivedKeys(kb, dks[1:2], saveLocal, src, ""))
+	saved, err := kb.Get(dks[1].Name)
+	require.NoError(t, err)
+	require.Equal(t, crkeys.TypeLedger, saved.GetType())
+	require.Equal(t, dks[1].Address, saved.GetAddress().String())
+}
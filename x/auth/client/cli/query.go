@@ -179,3 +179,29 @@ func QueryTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// QueryReceiptsCmd implements the command to query the receipts of a transaction.
+func QueryReceiptsCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "receipts [hash]",
+		Short: "Query for the balance flows of a transaction by hash in a committed block",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			receipts, err := utils.QueryReceipts(cliCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(receipts)
+		},
+	}
+
+	cmd.Flags().StringP(flags.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
+	viper.BindPFlag(flags.FlagNode, cmd.Flags().Lookup(flags.FlagNode))
+	cmd.Flags().Bool(flags.FlagTrustNode, false, "Trust connected full node (don't verify proofs for responses)")
+	viper.BindPFlag(flags.FlagTrustNode, cmd.Flags().Lookup(flags.FlagTrustNode))
+
+	return cmd
+}
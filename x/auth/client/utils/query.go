@@ -3,6 +3,7 @@ package utils
 import (
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
@@ -108,6 +109,43 @@ func QueryTx(cliCtx context.CLIContext, hashHexStr string) (sdk.TxResponse, erro
 	return out, nil
 }
 
+// QueryReceipts queries for a single transaction by a hash string in hex format
+// and decodes the receipts of its msgs. An error is returned if the transaction
+// does not exist, can not be queried or its data holds no receipt.
+func QueryReceipts(cliCtx context.CLIContext, hashHexStr string) (sdk.Receipts, error) {
+	hash, err := hex.DecodeString(hashHexStr)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	resTx, err := node.Tx(hash, !cliCtx.TrustNode)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cliCtx.TrustNode {
+		if err = ValidateTxResult(cliCtx, resTx); err != nil {
+			return nil, err
+		}
+	}
+
+	receipts, err := sdk.GetReceiptsFromData(cliCtx.Codec, resTx.TxResult.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(receipts) == 0 {
+		return nil, fmt.Errorf("no receipt found in transaction %s", hashHexStr)
+	}
+
+	return receipts, nil
+}
+
 // formatTxResults parses the indexed txs into a slice of TxResponse objects.
 func formatTxResults(cdc *codec.Codec, resTxs []*ctypes.ResultTx, resBlocks map[int64]*ctypes.ResultBlock) ([]sdk.TxResponse, error) {
 	var err error
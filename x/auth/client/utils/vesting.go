@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	authtypes "github.com/pocblockchain/pocc/x/auth/types"
+)
+
+// VestingPeriodJSON defines a vesting period used in JSON input, the amount is
+// a comma separated coins string.
+type VestingPeriodJSON struct {
+	Length int64  `json:"length" yaml:"length"`
+	Amount string `json:"amount" yaml:"amount"`
+}
+
+// ReadVestingPeriodsFromFile reads and decodes vesting periods from the given
+// filename, the file contains a list of periods such as:
+//
+// [{"length": 31536000, "amount": "1000poc"}, {"length": 2592000, "amount": "100poc"}]
+func ReadVestingPeriodsFromFile(filename string) (authtypes.Periods, error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var input []VestingPeriodJSON
+	if err := json.Unmarshal(bz, &input); err != nil {
+		return nil, err
+	}
+
+	periods := make(authtypes.Periods, len(input))
+	for i, p := range input {
+		amount, err := sdk.ParseCoins(p.Amount)
+		if err != nil {
+			return nil, fmt.Errorf("invalid amount of vesting period %d: %v", i, err)
+		}
+		periods[i] = authtypes.Period{Length: p.Length, Amount: amount}
+	}
+
+	if err := periods.Validate(); err != nil {
+		return nil, err
+	}
+
+	return periods, nil
+}
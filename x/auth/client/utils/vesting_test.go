@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	authtypes "github.com/pocblockchain/pocc/x/auth/types"
+)
+
+func TestReadVestingPeriodsFromFile(t *testing.T) {
+	tmpFile, err := ioutil.TempFile("", "test_vesting_periods.json")
+	require.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.WriteString(`[{"length": 31536000, "amount": "1000poc"}, {"length": 2592000, "amount": "100poc,10btc"}]`)
+	require.NoError(t, err)
+
+	periods, err := ReadVestingPeriodsFromFile(tmpFile.Name())
+	require.NoError(t, err)
+	require.Equal(t, authtypes.Periods{
+		{Length: 31536000, Amount: sdk.NewCoins(sdk.NewInt64Coin("poc", 1000))},
+		{Length: 2592000, Amount: sdk.NewCoins(sdk.NewInt64Coin("poc", 100), sdk.NewInt64Coin("btc", 10))},
+	}, periods)
+
+	// invalid amount
+	require.NoError(t, ioutil.WriteFile(tmpFile.Name(), []byte(`[{"length": 100, "amount": "-1poc"}]`), 0600))
+	_, err = ReadVestingPeriodsFromFile(tmpFile.Name())
+	require.Error(t, err)
+
+	// no periods
+	require.NoError(t, ioutil.WriteFile(tmpFile.Name(), []byte(`[]`), 0600))
+	_, err = ReadVestingPeriodsFromFile(tmpFile.Name())
+	require.Error(t, err)
+}
@@ -11,3 +11,9 @@ type SupplyKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) exported.ModuleAccountI
 	GetModuleAddress(moduleName string) sdk.AccAddress
 }
+
+// FeeTokenKeeper defines the expected keeper deciding which denoms are accepted as fee
+// and how many native tokens one unit of each of them is worth (noalias)
+type FeeTokenKeeper interface {
+	GetFeeTokenRate(ctx sdk.Context, denom string) (sdk.Dec, bool)
+}
@@ -0,0 +1,80 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/bank/internal/types"
+)
+
+// EndBlocker refunds the expired escrows not reclaimed by their payers within the
+// reclaim window to them and makes the payments of the scheduled transfers due at the current block
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	logger := k.Logger(ctx)
+
+	// a failed refund does not halt the chain, the escrow stays queued and its refund
+	// is retried in the next blocks
+	for _, escrow := range k.GetEscrowsDueForRefund(ctx) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.RefundEscrow(cacheCtx, escrow); err != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeRefundEscrowFailed,
+					sdk.NewAttribute(types.AttributeKeyEscrowID, sdk.NewUint(escrow.EscrowID).String()),
+					sdk.NewAttribute(types.AttributeKeyRecipient, escrow.Payer.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+					sdk.NewAttribute(types.AttributeKeyReason, err.Result().Log),
+				),
+			)
+
+			logger.Info("escrow refund failed", "escrow_id", escrow.EscrowID, "payer", escrow.Payer, "err", err.Result().Log)
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRefundEscrow,
+				sdk.NewAttribute(types.AttributeKeyEscrowID, sdk.NewUint(escrow.EscrowID).String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, escrow.Payer.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+			),
+		)
+
+		logger.Info("unreclaimed escrow refunded", "escrow_id", escrow.EscrowID, "payer", escrow.Payer, "amount", escrow.Amount)
+	}
+
+	// a failed payment is recorded on the scheduled transfer and does not halt the chain,
+	// the payments due beyond the limit of a block are made in the next blocks
+	for _, transfer := range k.GetDueScheduledTransfers(ctx, types.MaxScheduledTransfersPerBlock) {
+		if err := k.ExecuteScheduledTransfer(ctx, transfer); err != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeScheduledTransferFailed,
+					sdk.NewAttribute(types.AttributeKeyTransferID, sdk.NewUint(transfer.TransferID).String()),
+					sdk.NewAttribute(types.AttributeKeySender, transfer.Sender.String()),
+					sdk.NewAttribute(types.AttributeKeyRecipient, transfer.Recipient.String()),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, transfer.Amount.String()),
+					sdk.NewAttribute(types.AttributeKeyReason, err.Result().Log),
+				),
+			)
+
+			logger.Info("scheduled transfer failed", "transfer_id", transfer.TransferID, "sender", transfer.Sender, "err", err.Result().Log)
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeScheduledTransfer,
+				sdk.NewAttribute(types.AttributeKeyTransferID, sdk.NewUint(transfer.TransferID).String()),
+				sdk.NewAttribute(types.AttributeKeySender, transfer.Sender.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, transfer.Recipient.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, transfer.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyRemaining, fmt.Sprintf("%d", transfer.Remaining-1)),
+			),
+		)
+
+		logger.Info("scheduled transfer paid", "transfer_id", transfer.TransferID, "recipient", transfer.Recipient, "amount", transfer.Amount)
+	}
+}
@@ -13,11 +13,55 @@ const (
 	DefaultCodespace         = types.DefaultCodespace
 	CodeSendDisabled         = types.CodeSendDisabled
 	CodeInvalidInputsOutputs = types.CodeInvalidInputsOutputs
+	CodeAccountFrozen        = types.CodeAccountFrozen
+	CodeUnknownEscrow        = types.CodeUnknownEscrow
+	CodeEscrowExpired        = types.CodeEscrowExpired
+	CodeEscrowNotExpired     = types.CodeEscrowNotExpired
+	CodeInvalidEscrowExpiry  = types.CodeInvalidEscrowExpiry
+	CodeUnknownHTLC          = types.CodeUnknownHTLC
+	CodeDuplicateHTLC        = types.CodeDuplicateHTLC
+	CodeInvalidHashLock      = types.CodeInvalidHashLock
+	CodeInvalidPreimage      = types.CodeInvalidPreimage
+	CodeHTLCExpired          = types.CodeHTLCExpired
+	CodeHTLCNotExpired       = types.CodeHTLCNotExpired
+	CodeInvalidHTLCExpiry    = types.CodeInvalidHTLCExpiry
+	CodeInvalidHTLCID        = types.CodeInvalidHTLCID
+	CodeAccountExists        = types.CodeAccountExists
+	CodeInvalidVesting       = types.CodeInvalidVesting
 	ModuleName               = types.ModuleName
 	RouterKey                = types.RouterKey
 	QuerierRoute             = types.QuerierRoute
 	DefaultParamspace        = types.DefaultParamspace
 	DefaultSendEnabled       = types.DefaultSendEnabled
+	StoreKey                 = types.StoreKey
+	EscrowAccountName        = types.EscrowAccountName
+	HTLCAccountName          = types.HTLCAccountName
+	HashLockLength           = types.HashLockLength
+	HTLCIDLength             = types.HTLCIDLength
+	MaxPreimageLength        = types.MaxPreimageLength
+	DefaultStartingEscrowID  = types.DefaultStartingEscrowID
+	EscrowReclaimBlocks      = types.EscrowReclaimBlocks
+	EscrowReclaimPeriod      = types.EscrowReclaimPeriod
+	QueryEscrow              = types.QueryEscrow
+	QueryEscrowsByPayer      = types.QueryEscrowsByPayer
+	QueryEscrowsByPayee      = types.QueryEscrowsByPayee
+	QueryHTLC                = types.QueryHTLC
+	QueryHTLCs               = types.QueryHTLCs
+	QueryHolders             = types.QueryHolders
+	QueryHolder              = types.QueryHolder
+	DefaultHoldersLimit      = types.DefaultHoldersLimit
+
+	CodeUnknownSchedule                = types.CodeUnknownSchedule
+	CodeInvalidSchedule                = types.CodeInvalidSchedule
+	ScheduleAccountName                = types.ScheduleAccountName
+	DefaultStartingScheduledTransferID = types.DefaultStartingScheduledTransferID
+	MinScheduleInterval                = types.MinScheduleInterval
+	MaxScheduleTimes                   = types.MaxScheduleTimes
+	ScheduleExecutionGas               = types.ScheduleExecutionGas
+	MaxScheduledTransfersPerBlock      = types.MaxScheduledTransfersPerBlock
+	MaxScheduleFailures                = types.MaxScheduleFailures
+	QueryScheduledTransfer             = types.QueryScheduledTransfer
+	QueryScheduledTransfersBySender    = types.QueryScheduledTransfersBySender
 
 	EventTypeTransfer      = types.EventTypeTransfer
 	AttributeKeyRecipient  = types.AttributeKeyRecipient
@@ -32,10 +76,50 @@ var (
 	ErrNoOutputs           = types.ErrNoOutputs
 	ErrInputOutputMismatch = types.ErrInputOutputMismatch
 	ErrSendDisabled        = types.ErrSendDisabled
+	ErrAccountFrozen       = types.ErrAccountFrozen
+	ErrUnknownEscrow       = types.ErrUnknownEscrow
+	ErrEscrowExpired       = types.ErrEscrowExpired
+	ErrEscrowNotExpired    = types.ErrEscrowNotExpired
+	ErrInvalidEscrowExpiry = types.ErrInvalidEscrowExpiry
+	ErrUnknownHTLC         = types.ErrUnknownHTLC
+	ErrDuplicateHTLC       = types.ErrDuplicateHTLC
+	ErrInvalidHashLock     = types.ErrInvalidHashLock
+	ErrInvalidPreimage     = types.ErrInvalidPreimage
+	ErrHTLCExpired         = types.ErrHTLCExpired
+	ErrHTLCNotExpired      = types.ErrHTLCNotExpired
+	ErrInvalidHTLCExpiry   = types.ErrInvalidHTLCExpiry
+	ErrInvalidHTLCID       = types.ErrInvalidHTLCID
+	ErrAccountExists       = types.ErrAccountExists
+	ErrInvalidVesting      = types.ErrInvalidVesting
 	NewBaseKeeper          = keeper.NewBaseKeeper
 	NewInput               = types.NewInput
 	NewOutput              = types.NewOutput
+	NewEscrow              = types.NewEscrow
+	NewMsgEscrow           = types.NewMsgEscrow
+	NewMsgReleaseEscrow    = types.NewMsgReleaseEscrow
+	NewMsgReclaim          = types.NewMsgReclaim
+	NewHTLC                = types.NewHTLC
+	NewMsgCreateHTLC       = types.NewMsgCreateHTLC
+	NewMsgClaimHTLC        = types.NewMsgClaimHTLC
+	NewMsgRefundHTLC       = types.NewMsgRefundHTLC
+	GetHashLock            = types.GetHashLock
+	MatchHashLock          = types.MatchHashLock
+	GetHTLCID              = types.GetHTLCID
 	ParamKeyTable          = types.ParamKeyTable
+	NewHolder              = types.NewHolder
+	NewQueryHoldersParams  = types.NewQueryHoldersParams
+	NewQueryHolderParams   = types.NewQueryHolderParams
+
+	NewMsgCreateVestingAccount         = types.NewMsgCreateVestingAccount
+	NewMsgCreatePeriodicVestingAccount = types.NewMsgCreatePeriodicVestingAccount
+
+	ErrUnknownScheduledTransfer      = types.ErrUnknownScheduledTransfer
+	ErrInvalidSchedule               = types.ErrInvalidSchedule
+	NewScheduledTransfer             = types.NewScheduledTransfer
+	NewMsgScheduleTransfer           = types.NewMsgScheduleTransfer
+	NewMsgCancelScheduledTransfer    = types.NewMsgCancelScheduledTransfer
+	NewQueryScheduledTransferParams  = types.NewQueryScheduledTransferParams
+	NewQueryScheduledTransfersParams = types.NewQueryScheduledTransfersParams
 
 	// variable aliases
 	ModuleCdc                = types.ModuleCdc
@@ -43,14 +127,32 @@ var (
 )
 
 type (
-	BaseKeeper     = keeper.BaseKeeper // ibc module depends on this
-	Keeper         = keeper.Keeper
-	MsgSend        = types.MsgSend
-	MsgMultiSend   = types.MsgMultiSend
-	MsgEscrow      = types.MsgEscrow
-	MsgBonusSend   = types.MsgBonusSend
-	MsgReclaim     = types.MsgReclaim
-	MsgReclaimSend = types.MsgReclaimSend
-	Input          = types.Input
-	Output         = types.Output
+	BaseKeeper       = keeper.BaseKeeper // ibc module depends on this
+	Keeper           = keeper.Keeper
+	MsgSend          = types.MsgSend
+	MsgMultiSend     = types.MsgMultiSend
+	MsgEscrow        = types.MsgEscrow
+	MsgBonusSend     = types.MsgBonusSend
+	MsgReclaim       = types.MsgReclaim
+	MsgReleaseEscrow = types.MsgReleaseEscrow
+	MsgReclaimSend   = types.MsgReclaimSend
+	MsgCreateHTLC    = types.MsgCreateHTLC
+	MsgClaimHTLC     = types.MsgClaimHTLC
+	MsgRefundHTLC    = types.MsgRefundHTLC
+	Input            = types.Input
+	Output           = types.Output
+	Escrow           = types.Escrow
+	Escrows          = types.Escrows
+	HTLC             = types.HTLC
+	HTLCs            = types.HTLCs
+	Holder           = types.Holder
+	Holders          = types.Holders
+	HoldersPage      = types.HoldersPage
+
+	MsgCreateVestingAccount = types.MsgCreateVestingAccount
+
+	MsgScheduleTransfer        = types.MsgScheduleTransfer
+	MsgCancelScheduledTransfer = types.MsgCancelScheduledTransfer
+	ScheduledTransfer          = types.ScheduledTransfer
+	ScheduledTransfers         = types.ScheduledTransfers
 )
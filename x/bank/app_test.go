@@ -2,11 +2,14 @@ package bank_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/pocblockchain/pocc/types"
 	"github.com/pocblockchain/pocc/x/auth"
+	"github.com/pocblockchain/pocc/x/bank"
 	"github.com/pocblockchain/pocc/x/bank/internal/types"
 	"github.com/pocblockchain/pocc/x/mock"
+	"github.com/pocblockchain/pocc/x/supply"
 
 	"github.com/stretchr/testify/require"
 
@@ -625,7 +628,7 @@ func TestEscrowNotEnoughBalance(t *testing.T) {
 	require.True(t, tk.IsSendEnabled(ctxCheck, "foocoin"))
 	require.True(t, tk.IsTokenSupported(ctxCheck, "barcoin"))
 	require.True(t, tk.IsSendEnabled(ctxCheck, "barcoin"))
-	escrowMsg := types.NewMsgEscrow(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 100)})
+	escrowMsg := types.NewMsgEscrow(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 100)}, 0, time.Time{})
 	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
 	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{escrowMsg}, []uint64{origAccNum}, []uint64{origSeq}, false, false, priv1)
 
@@ -648,7 +651,12 @@ func TestEscrowSuccess(t *testing.T) {
 		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 67)},
 	}
 
-	mock.SetGenesis(mapp, []auth.Account{acc})
+	acc2 := &auth.BaseAccount{
+		Address: addr2,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 1)},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc, acc2})
 
 	res1 := mapp.AccountKeeper.GetAccount(ctxCheck, addr1)
 	require.NotNil(t, res1)
@@ -657,30 +665,74 @@ func TestEscrowSuccess(t *testing.T) {
 	origAccNum := res1.GetAccountNumber()
 	origSeq := res1.GetSequence()
 
-	require.True(t, tk.IsTokenSupported(ctxCheck, sdk.NativeToken))
-	require.True(t, tk.IsSendEnabled(ctxCheck, sdk.NativeToken))
 	require.True(t, tk.IsTokenSupported(ctxCheck, "foocoin"))
 	require.True(t, tk.IsSendEnabled(ctxCheck, "foocoin"))
-	require.True(t, tk.IsTokenSupported(ctxCheck, "barcoin"))
-	require.True(t, tk.IsSendEnabled(ctxCheck, "barcoin"))
-	escrowMsg := types.NewMsgEscrow(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)})
+	escrowMsg := types.NewMsgEscrow(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)}, 0, time.Time{})
 	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
 	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{escrowMsg}, []uint64{origAccNum}, []uint64{origSeq}, true, true, priv1)
 
+	// the escrowed coins are locked in the escrow module account
+	escrowAddr := supply.NewModuleAddress(types.EscrowAccountName)
 	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 37)})
-	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)})
+	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 1)})
+	mock.CheckBalance(t, mapp, escrowAddr, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)})
 
 	res2 := mapp.AccountKeeper.GetAccount(mapp.NewContext(true, abci.Header{}), addr1)
 	require.NotNil(t, res2)
 
 	require.True(t, res2.GetAccountNumber() == origAccNum)
 	require.True(t, res2.GetSequence() == origSeq+1)
+
+	escrow, found := input.bk.GetEscrow(mapp.NewContext(true, abci.Header{}), types.DefaultStartingEscrowID)
+	require.True(t, found)
+	require.Equal(t, addr1, escrow.Payer)
+	require.Equal(t, addr2, escrow.Payee)
+
+	// the payer releases the escrow to the payee
+	releaseMsg := types.NewMsgReleaseEscrow(addr1, escrow.EscrowID)
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{releaseMsg}, []uint64{origAccNum}, []uint64{origSeq + 1}, true, true, priv1)
+
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 37)})
+	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 31)})
+	mock.CheckBalance(t, mapp, escrowAddr, sdk.Coins(nil))
+
+	_, found = input.bk.GetEscrow(mapp.NewContext(true, abci.Header{}), escrow.EscrowID)
+	require.False(t, found)
+}
+
+func TestEscrowRefundByPayee(t *testing.T) {
+	input := getMockApp(t)
+	mapp := input.mApp
+	acc := &auth.BaseAccount{
+		Address: addr1,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 67)},
+	}
+
+	acc2 := &auth.BaseAccount{
+		Address: addr2,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 1)},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc, acc2})
+
+	escrowMsg := types.NewMsgEscrow(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)}, 0, time.Time{})
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{escrowMsg}, []uint64{0}, []uint64{0}, true, true, priv1)
+
+	// the payee gives the escrowed coins back to the payer
+	releaseMsg := types.NewMsgReleaseEscrow(addr2, types.DefaultStartingEscrowID)
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{releaseMsg}, []uint64{1}, []uint64{0}, true, true, priv2)
+
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 67)})
+	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 1)})
+	mock.CheckBalance(t, mapp, supply.NewModuleAddress(types.EscrowAccountName), sdk.Coins(nil))
 }
 
 func TestReclaimSuccess(t *testing.T) {
 	input := getMockApp(t)
 	mapp := input.mApp
-	tk := input.tk
 	ctxCheck := mapp.BaseApp.NewContext(true, abci.Header{})
 	acc := &auth.BaseAccount{
 		Address: addr1,
@@ -696,29 +748,281 @@ func TestReclaimSuccess(t *testing.T) {
 
 	res1 := mapp.AccountKeeper.GetAccount(ctxCheck, addr1)
 	require.NotNil(t, res1)
-	require.Equal(t, acc, res1.(*auth.BaseAccount))
-
 	origAccNum := res1.GetAccountNumber()
 	origSeq := res1.GetSequence()
 
-	require.True(t, tk.IsTokenSupported(ctxCheck, sdk.NativeToken))
-	require.True(t, tk.IsSendEnabled(ctxCheck, sdk.NativeToken))
-	require.True(t, tk.IsTokenSupported(ctxCheck, "foocoin"))
-	require.True(t, tk.IsSendEnabled(ctxCheck, "foocoin"))
-	require.True(t, tk.IsTokenSupported(ctxCheck, "barcoin"))
-	require.True(t, tk.IsSendEnabled(ctxCheck, "barcoin"))
-	relcaimMsg := types.NewMsgReclaim(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 100)})
 	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
-	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{relcaimMsg}, []uint64{origAccNum}, []uint64{origSeq}, true, true, priv1)
+	escrowMsg := types.NewMsgEscrow(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)}, header.Height+2, time.Time{})
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{escrowMsg}, []uint64{origAccNum}, []uint64{origSeq}, true, true, priv1)
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 37)})
+
+	// the escrow cannot be reclaimed before it expires
+	reclaimMsg := types.NewMsgReclaim(addr1, types.DefaultStartingEscrowID)
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{reclaimMsg}, []uint64{origAccNum}, []uint64{origSeq + 1}, false, false, priv1)
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 37)})
+
+	// the escrow has expired in this block and is not refunded before the reclaim window
+	// is over, so the payer can reclaim it
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{reclaimMsg}, []uint64{origAccNum}, []uint64{origSeq + 2}, false, true, priv1)
 
 	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 67)})
 	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 1)})
+	mock.CheckBalance(t, mapp, supply.NewModuleAddress(types.EscrowAccountName), sdk.Coins(nil))
+}
 
-	res2 := mapp.AccountKeeper.GetAccount(mapp.NewContext(true, abci.Header{}), addr1)
-	require.NotNil(t, res2)
+func TestEscrowExpiredRefund(t *testing.T) {
+	input := getMockApp(t)
+	mapp := input.mApp
+	acc := &auth.BaseAccount{
+		Address: addr1,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 67)},
+	}
 
-	require.True(t, res2.GetAccountNumber() == origAccNum)
-	require.True(t, res2.GetSequence() == origSeq+1)
+	acc2 := &auth.BaseAccount{
+		Address: addr2,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 1)},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc, acc2})
+
+	now := time.Now().UTC()
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1, Time: now}
+	escrowMsg := types.NewMsgEscrow(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)}, 0, now.Add(time.Hour))
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{escrowMsg}, []uint64{0}, []uint64{0}, true, true, priv1)
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 37)})
+
+	// the payee can no longer release an expired escrow, it stays locked for the payer to reclaim
+	releaseMsg := types.NewMsgReleaseEscrow(addr2, types.DefaultStartingEscrowID)
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1, Time: now.Add(time.Hour)}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{releaseMsg}, []uint64{1}, []uint64{0}, true, false, priv2)
+
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 37)})
+	mock.CheckBalance(t, mapp, supply.NewModuleAddress(types.EscrowAccountName), sdk.Coins{sdk.NewInt64Coin("foocoin", 30)})
+
+	// the end blocker refunds the payer once the reclaim window is over
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1, Time: now.Add(time.Hour + types.EscrowReclaimPeriod)}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{releaseMsg}, []uint64{1}, []uint64{1}, false, false, priv2)
+
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 67)})
+	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 1)})
+	mock.CheckBalance(t, mapp, supply.NewModuleAddress(types.EscrowAccountName), sdk.Coins(nil))
+
+	_, found := input.bk.GetEscrow(mapp.NewContext(true, abci.Header{}), types.DefaultStartingEscrowID)
+	require.False(t, found)
+}
+
+func TestEscrowRefundFailure(t *testing.T) {
+	input := getMockApp(t)
+	mapp := input.mApp
+	acc := &auth.BaseAccount{
+		Address: addr1,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 67)},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc})
+
+	now := time.Now().UTC()
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1, Time: now}
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := mapp.BaseApp.NewContext(false, header)
+
+	coins := sdk.Coins{sdk.NewInt64Coin("foocoin", 30)}
+	escrowID, err := input.bk.CreateEscrow(ctx, addr1, addr2, coins, 0, now)
+	require.Nil(t, err)
+
+	// the refund fails without halting the chain, the escrow stays queued
+	escrowAddr := supply.NewModuleAddress(types.EscrowAccountName)
+	require.Nil(t, input.bk.SetCoins(ctx, escrowAddr, nil))
+	ctx = ctx.WithBlockTime(now.Add(types.EscrowReclaimPeriod)).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { bank.EndBlocker(ctx, input.bk) })
+	require.Equal(t, types.EventTypeRefundEscrowFailed, ctx.EventManager().Events()[0].Type)
+	_, found := input.bk.GetEscrow(ctx, escrowID)
+	require.True(t, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("foocoin", 37)}, input.bk.GetCoins(ctx, addr1))
+
+	// and is refunded in a later block
+	require.Nil(t, input.bk.SetCoins(ctx, escrowAddr, coins))
+	bank.EndBlocker(ctx.WithBlockHeight(header.Height+1), input.bk)
+	_, found = input.bk.GetEscrow(ctx, escrowID)
+	require.False(t, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("foocoin", 67)}, input.bk.GetCoins(ctx, addr1))
+}
+
+func TestScheduledTransfer(t *testing.T) {
+	input := getMockApp(t)
+	mapp := input.mApp
+	acc := &auth.BaseAccount{
+		Address: addr1,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 67)},
+	}
+
+	acc2 := &auth.BaseAccount{
+		Address: addr2,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 1)},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc, acc2})
+
+	// the coins of the three payments are locked, the first one is due in the next block
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	scheduleMsg := types.NewMsgScheduleTransfer(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 10)},
+		types.MinScheduleInterval, 3, header.Height+1)
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{scheduleMsg}, []uint64{0}, []uint64{0}, true, true, priv1)
+
+	scheduleAddr := supply.NewModuleAddress(types.ScheduleAccountName)
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 37)})
+	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 1)})
+	mock.CheckBalance(t, mapp, scheduleAddr, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)})
+
+	// only the sender can cancel, the end blocker makes the first payment
+	cancelMsg := types.NewMsgCancelScheduledTransfer(addr2, types.DefaultStartingScheduledTransferID)
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{cancelMsg}, []uint64{1}, []uint64{0}, false, false, priv2)
+
+	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 11)})
+	mock.CheckBalance(t, mapp, scheduleAddr, sdk.Coins{sdk.NewInt64Coin("foocoin", 20)})
+
+	transfer, found := input.bk.GetScheduledTransfer(mapp.NewContext(true, abci.Header{}), types.DefaultStartingScheduledTransferID)
+	require.True(t, found)
+	require.Equal(t, uint64(1), transfer.Executed)
+	require.Equal(t, uint64(2), transfer.Remaining)
+
+	// the sender takes back the coins of the remaining payments
+	cancelMsg = types.NewMsgCancelScheduledTransfer(addr1, types.DefaultStartingScheduledTransferID)
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{cancelMsg}, []uint64{0}, []uint64{1}, true, true, priv1)
+
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 57)})
+	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 11)})
+	mock.CheckBalance(t, mapp, scheduleAddr, sdk.Coins(nil))
+
+	_, found = input.bk.GetScheduledTransfer(mapp.NewContext(true, abci.Header{}), types.DefaultStartingScheduledTransferID)
+	require.False(t, found)
+}
+
+func TestHTLCClaim(t *testing.T) {
+	input := getMockApp(t)
+	mapp := input.mApp
+	acc := &auth.BaseAccount{
+		Address: addr1,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 67)},
+	}
+
+	acc2 := &auth.BaseAccount{
+		Address: addr2,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 1)},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc, acc2})
+
+	preimage := []byte("secret")
+	hashLock := types.GetHashLock(preimage)
+	htlcID := types.GetHTLCID(addr1, addr2, hashLock)
+	htlcAddr := supply.NewModuleAddress(types.HTLCAccountName)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	createMsg := types.NewMsgCreateHTLC(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)}, hashLock, header.Height+10)
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{createMsg}, []uint64{0}, []uint64{0}, true, true, priv1)
+
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 37)})
+	mock.CheckBalance(t, mapp, htlcAddr, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)})
+
+	// the same hash lock cannot be reused for the same recipient
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{createMsg}, []uint64{0}, []uint64{1}, false, false, priv1)
+
+	// the htlc cannot be refunded before it expires
+	refundMsg := types.NewMsgRefundHTLC(addr1, htlcID)
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{refundMsg}, []uint64{0}, []uint64{2}, false, false, priv1)
+
+	// the preimage must match the hash lock
+	wrongClaimMsg := types.NewMsgClaimHTLC(addr2, htlcID, []byte("wrong"))
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{wrongClaimMsg}, []uint64{1}, []uint64{0}, false, false, priv2)
+
+	// revealing the preimage pays the recipient
+	claimMsg := types.NewMsgClaimHTLC(addr2, htlcID, preimage)
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{claimMsg}, []uint64{1}, []uint64{1}, true, true, priv2)
+
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 37)})
+	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 31)})
+	mock.CheckBalance(t, mapp, htlcAddr, sdk.Coins(nil))
+
+	_, found := input.bk.GetHTLC(mapp.NewContext(true, abci.Header{}), htlcID)
+	require.False(t, found)
+}
+
+func TestHTLCRefund(t *testing.T) {
+	input := getMockApp(t)
+	mapp := input.mApp
+	acc := &auth.BaseAccount{
+		Address: addr1,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 67)},
+	}
+
+	acc2 := &auth.BaseAccount{
+		Address: addr2,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 1)},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc, acc2})
+
+	preimage := []byte("secret")
+	hashLock := types.GetHashLock(preimage)
+	htlcID := types.GetHTLCID(addr1, addr2, hashLock)
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	createMsg := types.NewMsgCreateHTLC(addr1, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)}, hashLock, header.Height+1)
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{createMsg}, []uint64{0}, []uint64{0}, true, true, priv1)
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 37)})
+
+	// the htlc cannot be claimed after it expires
+	claimMsg := types.NewMsgClaimHTLC(addr2, htlcID, preimage)
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{claimMsg}, []uint64{1}, []uint64{0}, true, false, priv2)
+	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 1)})
+
+	// anyone can return the coins of an expired htlc to the sender
+	refundMsg := types.NewMsgRefundHTLC(addr2, htlcID)
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{refundMsg}, []uint64{1}, []uint64{1}, true, true, priv2)
+
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 67)})
+	mock.CheckBalance(t, mapp, addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 1)})
+	mock.CheckBalance(t, mapp, supply.NewModuleAddress(types.HTLCAccountName), sdk.Coins(nil))
+}
+
+func TestCreateVestingAccount(t *testing.T) {
+	input := getMockApp(t)
+	mapp := input.mApp
+	acc := &auth.BaseAccount{
+		Address: addr1,
+		Coins:   sdk.Coins{sdk.NewInt64Coin("foocoin", 67)},
+	}
+
+	mock.SetGenesis(mapp, []auth.Account{acc})
+
+	header := abci.Header{Height: mapp.LastBlockHeight() + 1}
+	endTime := time.Now().Add(24 * time.Hour).Unix()
+	createMsg := types.NewMsgCreateVestingAccount(addr1, addr4, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)}, 0, endTime)
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{createMsg}, []uint64{0}, []uint64{0}, true, true, priv1)
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 37)})
+	mock.CheckBalance(t, mapp, addr4, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)})
+
+	ctxCheck := mapp.BaseApp.NewContext(true, abci.Header{})
+	vacc, ok := mapp.AccountKeeper.GetAccount(ctxCheck, addr4).(*auth.DelayedVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("foocoin", 30)}, vacc.GetOriginalVesting())
+	require.Equal(t, endTime, vacc.GetEndTime())
+
+	// a vesting account can only be created at a new address
+	header = abci.Header{Height: mapp.LastBlockHeight() + 1}
+	mock.SignCheckDeliver(t, mapp.Cdc, mapp.BaseApp, header, []sdk.Msg{createMsg}, []uint64{0}, []uint64{1}, false, false, priv1)
+	mock.CheckBalance(t, mapp, addr1, sdk.Coins{sdk.NewInt64Coin("foocoin", 37)})
 }
 
 func TestMsgBonusSend(t *testing.T) {
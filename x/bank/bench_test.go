@@ -61,6 +61,7 @@ func getInitChainer(mapp *mock.App, keeper keeper.BaseKeeper, tk token.Keeper) s
 type testInput struct {
 	mApp *mock.App
 	tk   token.Keeper
+	bk   keeper.BaseKeeper
 }
 
 // getBenchmarkMockApp initializes a mock application for this module, for purposes of benchmarking
@@ -71,24 +72,46 @@ func getBenchmarkMockApp() (testInput, error) {
 
 	token.RegisterCodec(mapp.Cdc)
 	keyToken := sdk.NewKVStoreKey(token.StoreKey)
-	tokenKeeper := token.NewKeeper(mapp.Cdc, keyToken, nil, nil, mapp.ParamsKeeper.Subspace(token.DefaultParamspace))
+	tokenKeeper := token.NewKeeper(mapp.Cdc, keyToken, mapp.AccountKeeper, nil, nil, mapp.ParamsKeeper.Subspace(token.DefaultParamspace), nil)
 
 	blacklistedAddrs := make(map[string]bool)
 	blacklistedAddrs[moduleAccAddr.String()] = true
+	blacklistedAddrs[supply.NewModuleAddress(types.EscrowAccountName).String()] = true
+	blacklistedAddrs[supply.NewModuleAddress(types.HTLCAccountName).String()] = true
+	blacklistedAddrs[supply.NewModuleAddress(types.ScheduleAccountName).String()] = true
 
+	keyBank := sdk.NewKVStoreKey(types.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
 	bankKeeper := keeper.NewBaseKeeper(
-		mapp.AccountKeeper, &tokenKeeper,
+		mapp.AccountKeeper, &tokenKeeper, keyBank,
 		mapp.ParamsKeeper.Subspace(types.DefaultParamspace),
 		types.DefaultCodespace,
 		blacklistedAddrs,
 	)
 
 	//	(&bankKeeper).SetTokenKeeper(&tokenKeeper)
+	setupModuleAccounts(mapp, &bankKeeper, keySupply)
 	mapp.Router().AddRoute(types.RouterKey, bank.NewHandler(bankKeeper))
 	mapp.SetInitChainer(getInitChainer(mapp, bankKeeper, tokenKeeper))
+	mapp.SetEndBlocker(getEndBlocker(bankKeeper))
 
-	err := mapp.CompleteSetup(keyToken)
-	return testInput{mapp, tokenKeeper}, err
+	err := mapp.CompleteSetup(keyToken, keyBank, keySupply)
+	return testInput{mapp, tokenKeeper, bankKeeper}, err
+}
+
+// setupModuleAccounts wires a supply keeper holding the escrow, htlc and schedule module accounts into the bank keeper
+func setupModuleAccounts(mapp *mock.App, bankKeeper *keeper.BaseKeeper, keySupply *sdk.KVStoreKey) {
+	maccPerms := map[string][]string{types.EscrowAccountName: nil, types.HTLCAccountName: nil, types.ScheduleAccountName: nil}
+	supplyKeeper := supply.NewKeeper(mapp.Cdc, keySupply, mapp.AccountKeeper, *bankKeeper, maccPerms)
+	bankKeeper.SetSupplyKeeper(supplyKeeper)
+}
+
+// getEndBlocker returns the bank end blocker for the mock application
+func getEndBlocker(keeper keeper.BaseKeeper) sdk.EndBlocker {
+	return func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+		bank.EndBlocker(ctx, keeper)
+		return abci.ResponseEndBlock{}
+	}
 }
 
 // initialize the mock application for this module
@@ -107,24 +130,31 @@ func getBenchmarkMockAppWithSetTokenKeeper() (testInput, error) {
 
 	token.RegisterCodec(mapp.Cdc)
 	keyToken := sdk.NewKVStoreKey(token.StoreKey)
-	tokenKeeper := token.NewKeeper(mapp.Cdc, keyToken, nil, nil, mapp.ParamsKeeper.Subspace(token.DefaultParamspace))
+	tokenKeeper := token.NewKeeper(mapp.Cdc, keyToken, mapp.AccountKeeper, nil, nil, mapp.ParamsKeeper.Subspace(token.DefaultParamspace), nil)
 
 	blacklistedAddrs := make(map[string]bool)
 	blacklistedAddrs[moduleAccAddr.String()] = true
+	blacklistedAddrs[supply.NewModuleAddress(types.EscrowAccountName).String()] = true
+	blacklistedAddrs[supply.NewModuleAddress(types.HTLCAccountName).String()] = true
+	blacklistedAddrs[supply.NewModuleAddress(types.ScheduleAccountName).String()] = true
 
+	keyBank := sdk.NewKVStoreKey(types.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
 	bankKeeper := keeper.NewBaseKeeper(
-		mapp.AccountKeeper, nil,
+		mapp.AccountKeeper, nil, keyBank,
 		mapp.ParamsKeeper.Subspace(types.DefaultParamspace),
 		types.DefaultCodespace,
 		blacklistedAddrs,
 	)
 
 	bankKeeper.SetTokenKeeper(&tokenKeeper)
+	setupModuleAccounts(mapp, &bankKeeper, keySupply)
 	mapp.Router().AddRoute(types.RouterKey, bank.NewHandler(bankKeeper))
 	mapp.SetInitChainer(getInitChainer(mapp, bankKeeper, tokenKeeper))
+	mapp.SetEndBlocker(getEndBlocker(bankKeeper))
 
-	err := mapp.CompleteSetup(keyToken)
-	return testInput{mapp, tokenKeeper}, err
+	err := mapp.CompleteSetup(keyToken, keyBank, keySupply)
+	return testInput{mapp, tokenKeeper, bankKeeper}, err
 }
 
 func BenchmarkOneBankSendTxPerBlock(b *testing.B) {
@@ -186,3 +216,32 @@ func BenchmarkOneBankMultiSendTxPerBlock(b *testing.B) {
 		benchmarkApp.mApp.Commit()
 	}
 }
+
+func benchmarkIsCoinsSendEnabled(b *testing.B, cacheSize uint64) {
+	benchmarkApp, _ := getBenchmarkMockAppWithSetTokenKeeper()
+	supply.RegisterCodec(benchmarkApp.mApp.Cdc)
+	mock.SetGenesis(benchmarkApp.mApp, []auth.Account{})
+
+	ctx := benchmarkApp.mApp.BaseApp.NewContext(true, abci.Header{})
+	params := benchmarkApp.tk.GetParams(ctx)
+	params.TokenCacheSize = cacheSize
+	benchmarkApp.tk.SetParams(ctx, params)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("barcoin", 10), sdk.NewInt64Coin("foocoin", 10), sdk.NewInt64Coin(sdk.NativeToken, 10))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !benchmarkApp.bk.IsCoinsSendEnabled(ctx, coins) {
+			panic("coins are expected to be send enabled")
+		}
+	}
+}
+
+// BenchmarkIsCoinsSendEnabledWithoutCache decodes token info from the store on every call
+func BenchmarkIsCoinsSendEnabledWithoutCache(b *testing.B) {
+	benchmarkIsCoinsSendEnabled(b, 0)
+}
+
+// BenchmarkIsCoinsSendEnabledWithCache serves token info from the LRU cache sized by TokenCacheSize
+func BenchmarkIsCoinsSendEnabledWithCache(b *testing.B) {
+	benchmarkIsCoinsSendEnabled(b, token.DefaultParams().TokenCacheSize)
+}
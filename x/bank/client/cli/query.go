@@ -0,0 +1,300 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/pocblockchain/pocc/client"
+	"github.com/pocblockchain/pocc/client/context"
+	"github.com/pocblockchain/pocc/client/flags"
+	"github.com/pocblockchain/pocc/codec"
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/bank/internal/types"
+)
+
+// GetQueryCmd returns the query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the bank module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryEscrow(cdc),
+		GetCmdQueryEscrowsByPayer(cdc),
+		GetCmdQueryEscrowsByPayee(cdc),
+		GetCmdQueryHTLC(cdc),
+		GetCmdQueryHTLCs(cdc),
+		GetCmdQueryHolders(cdc),
+		GetCmdQueryHolder(cdc),
+		GetCmdQueryScheduledTransfer(cdc),
+		GetCmdQueryScheduledTransfersBySender(cdc),
+	)...)
+	return queryCmd
+}
+
+// GetCmdQueryEscrow implements the query escrow command.
+func GetCmdQueryEscrow(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "escrow [escrow_id]",
+		Short: "Query an escrow by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			escrowID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("escrow id %s not a valid uint, please input a valid escrow id", args[0])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryEscrowParams(escrowID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryEscrow)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var escrow types.Escrow
+			cdc.MustUnmarshalJSON(res, &escrow)
+			return cliCtx.PrintOutput(escrow)
+		},
+	}
+}
+
+// GetCmdQueryEscrowsByPayer implements the query escrows by payer command.
+func GetCmdQueryEscrowsByPayer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "escrows-by-payer [payer_address]",
+		Short: "Query the escrows paid by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryEscrows(cdc, types.QueryEscrowsByPayer, args[0])
+		},
+	}
+}
+
+// GetCmdQueryEscrowsByPayee implements the query escrows by payee command.
+func GetCmdQueryEscrowsByPayee(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "escrows-by-payee [payee_address]",
+		Short: "Query the escrows payable to an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryEscrows(cdc, types.QueryEscrowsByPayee, args[0])
+		},
+	}
+}
+
+func queryEscrows(cdc *codec.Codec, path, bech32Addr string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	addr, err := sdk.AccAddressFromBech32(bech32Addr)
+	if err != nil {
+		return err
+	}
+
+	bz, err := cdc.MarshalJSON(types.NewQueryEscrowsParams(addr))
+	if err != nil {
+		return err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, path)
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	var escrows types.Escrows
+	cdc.MustUnmarshalJSON(res, &escrows)
+	return cliCtx.PrintOutput(escrows)
+}
+
+// GetCmdQueryHTLC implements the query htlc command.
+func GetCmdQueryHTLC(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "htlc [htlc_id]",
+		Short: "Query an htlc by its hex encoded ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			htlcID, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("htlc id %s is not valid hex: %v", args[0], err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryHTLCParams(htlcID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHTLC)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var htlc types.HTLC
+			cdc.MustUnmarshalJSON(res, &htlc)
+			return cliCtx.PrintOutput(htlc)
+		},
+	}
+}
+
+// GetCmdQueryHTLCs implements the query htlcs command.
+func GetCmdQueryHTLCs(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "htlcs",
+		Short: "Query all the htlcs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHTLCs)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var htlcs types.HTLCs
+			cdc.MustUnmarshalJSON(res, &htlcs)
+			return cliCtx.PrintOutput(htlcs)
+		},
+	}
+}
+
+// GetCmdQueryHolders implements the query holders command.
+func GetCmdQueryHolders(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holders [denom]",
+		Short: "Query the holder count and the holders of a denom from the largest balance",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryHoldersParams(args[0], viper.GetInt(FlagPage), viper.GetInt(FlagLimit)))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHolders)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var page types.HoldersPage
+			cdc.MustUnmarshalJSON(res, &page)
+			return cliCtx.PrintOutput(page)
+		},
+	}
+	cmd.Flags().Int(FlagPage, 1, "page of the holders to query")
+	cmd.Flags().Int(FlagLimit, types.DefaultHoldersLimit, "number of holders per page")
+	return cmd
+}
+
+// GetCmdQueryHolder implements the query holder command.
+func GetCmdQueryHolder(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "holder [denom] [address]",
+		Short: "Query the balance of a denom held by an address in the holder index",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryHolderParams(args[0], addr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHolder)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var holder types.Holder
+			cdc.MustUnmarshalJSON(res, &holder)
+			return cliCtx.PrintOutput(holder)
+		},
+	}
+}
+
+// GetCmdQueryScheduledTransfer implements the query scheduled transfer command.
+func GetCmdQueryScheduledTransfer(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "scheduled-transfer [transfer_id]",
+		Short: "Query a scheduled transfer by its ID",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			transferID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("transfer id %s not a valid uint, please input a valid transfer id", args[0])
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryScheduledTransferParams(transferID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryScheduledTransfer)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var transfer types.ScheduledTransfer
+			cdc.MustUnmarshalJSON(res, &transfer)
+			return cliCtx.PrintOutput(transfer)
+		},
+	}
+}
+
+// GetCmdQueryScheduledTransfersBySender implements the query scheduled transfers by sender command.
+func GetCmdQueryScheduledTransfersBySender(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "scheduled-transfers-by-sender [sender_address]",
+		Short: "Query the scheduled transfers paid by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryScheduledTransfersParams(addr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryScheduledTransfersBySender)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var transfers types.ScheduledTransfers
+			cdc.MustUnmarshalJSON(res, &transfers)
+			return cliCtx.PrintOutput(transfers)
+		},
+	}
+}
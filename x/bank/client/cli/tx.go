@@ -1,7 +1,13 @@
 package cli
 
 import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/pocblockchain/pocc/client"
 	"github.com/pocblockchain/pocc/client/context"
@@ -12,6 +18,18 @@ import (
 	"github.com/pocblockchain/pocc/x/bank/internal/types"
 )
 
+// bank flags
+const (
+	FlagExpireHeight = "expire-height"
+	FlagExpireTime   = "expire-time"
+	FlagVestingStart = "vesting-start-time"
+	FlagVestingEnd   = "vesting-end-time"
+	FlagVestingPrds  = "vesting-periods"
+	FlagPage         = "page"
+	FlagLimit        = "limit"
+	FlagStartHeight  = "start-height"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd := &cobra.Command{
@@ -24,7 +42,14 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(
 		SendTxCmd(cdc),
 		EscrowTxCmd(cdc),
+		ReleaseEscrowTxCmd(cdc),
 		ReclaimTxCmd(cdc),
+		CreateHTLCTxCmd(cdc),
+		ClaimHTLCTxCmd(cdc),
+		RefundHTLCTxCmd(cdc),
+		CreateVestingAccountTxCmd(cdc),
+		ScheduleTransferTxCmd(cdc),
+		CancelScheduledTransferTxCmd(cdc),
 	)
 	return txCmd
 }
@@ -65,7 +90,7 @@ func SendTxCmd(cdc *codec.Codec) *cobra.Command {
 func EscrowTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "escrow [from_key_or_address] [to_address] [amount]",
-		Short: "Create and sign a escrow tx",
+		Short: "Create and sign a escrow tx, the coins are locked until released or reclaimed after expiry",
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -82,8 +107,44 @@ func EscrowTxCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			var expireTime time.Time
+			if s := viper.GetString(FlagExpireTime); s != "" {
+				expireTime, err = time.Parse(time.RFC3339, s)
+				if err != nil {
+					return fmt.Errorf("failed to parse expire time %s: %v", s, err)
+				}
+			}
+
 			// build and sign the transaction, then broadcast to Tendermint
-			msg := types.NewMsgEscrow(cliCtx.GetFromAddress(), to, coins)
+			msg := types.NewMsgEscrow(cliCtx.GetFromAddress(), to, coins, viper.GetInt64(FlagExpireHeight), expireTime)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(FlagExpireHeight, 0, "block height at which the escrow expires, 0 for none")
+	cmd.Flags().String(FlagExpireTime, "", "block time at which the escrow expires in RFC3339 format, empty for none")
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// ReleaseEscrowTxCmd will create a release escrow tx and sign it with the given key.
+func ReleaseEscrowTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-escrow [from_key_or_address] [escrow_id]",
+		Short: "Create and sign a release escrow tx, the payer releases the coins to the payee and the payee back to the payer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			escrowID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("escrow id %s not a valid uint, please input a valid escrow id", args[1])
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgReleaseEscrow(cliCtx.GetFromAddress(), escrowID)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
@@ -96,13 +157,199 @@ func EscrowTxCmd(cdc *codec.Codec) *cobra.Command {
 // ReclaimTxCmd will create a reclaim tx and sign it with the given key.
 func ReclaimTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "reclaim [from_key_or_address] [to_address] [amount]",
-		Short: "Create and sign a relcaim tx",
+		Use:   "reclaim [from_key_or_address] [escrow_id]",
+		Short: "Create and sign a reclaim tx, the payer takes back the coins of an expired escrow, which are refunded automatically after the reclaim window",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			escrowID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("escrow id %s not a valid uint, please input a valid escrow id", args[1])
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgReclaim(cliCtx.GetFromAddress(), escrowID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// CreateHTLCTxCmd will create a create htlc tx and sign it with the given key.
+func CreateHTLCTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-htlc [from_key_or_address] [to_address] [amount] [hash_lock] [expire_height]",
+		Short: "Create and sign a create htlc tx, the coins are locked until claimed with the preimage of the hex encoded SHA-256 hash lock or refunded after expiry",
+		Long: `Create and sign a create htlc tx. The coins are locked until claimed with the
+preimage of the hex encoded SHA-256 hash lock or refunded after expiry. The htlc
+is identified by the htlc_id attribute of the create_htlc event, the SHA-256 of
+the sender, the recipient and the hash lock.`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// parse coins trying to be locked
+			coins, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			hashLock, err := hex.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("hash lock %s is not valid hex: %v", args[3], err)
+			}
+
+			expireHeight, err := strconv.ParseInt(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("expire height %s not a valid int, please input a valid expire height", args[4])
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgCreateHTLC(cliCtx.GetFromAddress(), to, coins, hashLock, expireHeight)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// ClaimHTLCTxCmd will create a claim htlc tx and sign it with the given key.
+func ClaimHTLCTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-htlc [from_key_or_address] [htlc_id] [preimage]",
+		Short: "Create and sign a claim htlc tx, the hex encoded preimage pays the locked coins of the htlc to the recipient",
 		Args:  cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
 
+			htlcID, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("htlc id %s is not valid hex: %v", args[1], err)
+			}
+
+			preimage, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("preimage %s is not valid hex: %v", args[2], err)
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgClaimHTLC(cliCtx.GetFromAddress(), htlcID, preimage)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// RefundHTLCTxCmd will create a refund htlc tx and sign it with the given key.
+func RefundHTLCTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "refund-htlc [from_key_or_address] [htlc_id]",
+		Short: "Create and sign a refund htlc tx, the coins of an expired htlc are returned to the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			htlcID, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("htlc id %s is not valid hex: %v", args[1], err)
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgRefundHTLC(cliCtx.GetFromAddress(), htlcID)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// CreateVestingAccountTxCmd will create a create vesting account tx and sign it with the given key.
+func CreateVestingAccountTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [from_key_or_address] [to_address] [amount]",
+		Short: "Create and sign a create vesting account tx, the new account is funded with amount which vests on the given schedule",
+		Long: `Create and sign a create vesting account tx. The account at to_address must not exist yet.
+With --vesting-periods a periodic vesting account is created, the amount must equal the total of the periods.
+Otherwise a delayed vesting account is created if --vesting-start-time is zero and a continuous one if not.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			to, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// parse coins trying to be vested
+			coins, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateVestingAccount(cliCtx.GetFromAddress(), to, coins,
+				viper.GetInt64(FlagVestingStart), viper.GetInt64(FlagVestingEnd))
+
+			if periodsFile := viper.GetString(FlagVestingPrds); periodsFile != "" {
+				periods, err := utils.ReadVestingPeriodsFromFile(periodsFile)
+				if err != nil {
+					return err
+				}
+
+				msg = types.NewMsgCreatePeriodicVestingAccount(cliCtx.GetFromAddress(), to, viper.GetInt64(FlagVestingStart), periods)
+				if !msg.Amount.IsEqual(coins) {
+					return fmt.Errorf("amount %s does not equal the total %s of vesting periods", coins, msg.Amount)
+				}
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(FlagVestingStart, 0, "schedule start time (unix epoch) of the vesting account, 0 for a delayed vesting account")
+	cmd.Flags().Int64(FlagVestingEnd, 0, "schedule end time (unix epoch) of the vesting account")
+	cmd.Flags().String(FlagVestingPrds, "", "path to a JSON file of vesting periods for a periodic vesting account, e.g. [{\"length\":2592000,\"amount\":\"100poc\"}]")
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// ScheduleTransferTxCmd will create a schedule transfer tx and sign it with the given key.
+func ScheduleTransferTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-transfer [from_key_or_address] [to_address] [amount] [interval] [times]",
+		Short: "Create and sign a schedule transfer tx, the amount is paid every interval blocks times times and the coins of all the payments are locked at once",
+		Long: fmt.Sprintf(`Create and sign a schedule transfer tx. The interval must be at least %d blocks
+and at most %d payments can be scheduled, %d gas is charged for each of them when the
+transfer is scheduled. The transfer is cancelled and the coins of the remaining payments
+refunded after %d payments in a row have failed.`,
+			types.MinScheduleInterval, types.MaxScheduleTimes, types.ScheduleExecutionGas, types.MaxScheduleFailures),
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
 			to, err := sdk.AccAddressFromBech32(args[1])
 			if err != nil {
 				return err
@@ -114,8 +361,45 @@ func ReclaimTxCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			interval, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("interval %s not a valid int, please input a valid number of blocks", args[3])
+			}
+
+			times, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("times %s not a valid uint, please input a valid number of payments", args[4])
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgScheduleTransfer(cliCtx.GetFromAddress(), to, coins, interval, times, viper.GetInt64(FlagStartHeight))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(FlagStartHeight, 0, "block height of the first payment, 0 for an interval after the current block")
+	cmd = client.PostCommands(cmd)[0]
+
+	return cmd
+}
+
+// CancelScheduledTransferTxCmd will create a cancel scheduled transfer tx and sign it with the given key.
+func CancelScheduledTransferTxCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-transfer [from_key_or_address] [transfer_id]",
+		Short: "Create and sign a cancel scheduled transfer tx, the sender takes back the coins of the remaining payments",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithFrom(args[0]).WithCodec(cdc)
+
+			transferID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("transfer id %s not a valid uint, please input a valid transfer id", args[1])
+			}
+
 			// build and sign the transaction, then broadcast to Tendermint
-			msg := types.NewMsgReclaim(cliCtx.GetFromAddress(), to, coins)
+			msg := types.NewMsgCancelScheduledTransfer(cliCtx.GetFromAddress(), transferID)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
@@ -1,14 +1,19 @@
 package rest
 
 import (
+	"encoding/hex"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
 	"github.com/pocblockchain/pocc/client/context"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
 	sdk "github.com/pocblockchain/pocc/types"
 	"github.com/pocblockchain/pocc/types/rest"
 	"github.com/pocblockchain/pocc/x/auth/client/utils"
+	authtypes "github.com/pocblockchain/pocc/x/auth/types"
 
 	"github.com/pocblockchain/pocc/x/bank/internal/types"
 )
@@ -18,7 +23,23 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/bank/accounts/{address}/transfers", SendRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/bank/accounts/{address}/escrow", EscrowRequestHandlerFn(cliCtx)).Methods("POST")
-	r.HandleFunc("/bank/accounts/{address}/reclaim", ReclaimRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/escrows/{escrowID}/release", ReleaseEscrowRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/escrows/{escrowID}/reclaim", ReclaimRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/escrows/{escrowID}", QueryEscrowRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/payers/{address}/escrows", QueryEscrowsRequestHandlerFn(cliCtx, types.QueryEscrowsByPayer)).Methods("GET")
+	r.HandleFunc("/bank/payees/{address}/escrows", QueryEscrowsRequestHandlerFn(cliCtx, types.QueryEscrowsByPayee)).Methods("GET")
+	r.HandleFunc("/bank/accounts/{address}/htlcs", CreateHTLCRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/htlcs/{htlcID}/claim", ClaimHTLCRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/htlcs/{htlcID}/refund", RefundHTLCRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/htlcs/{htlcID}", QueryHTLCRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/htlcs", QueryHTLCsRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/holders/{denom}", QueryHoldersRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/holders/{denom}/{address}", QueryHolderRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/accounts/{address}/vesting", CreateVestingAccountRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/accounts/{address}/scheduled-transfers", ScheduleTransferRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/scheduled-transfers/{transferID}/cancel", CancelScheduledTransferRequestHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/scheduled-transfers/{transferID}", QueryScheduledTransferRequestHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/senders/{address}/scheduled-transfers", QueryScheduledTransfersBySenderRequestHandlerFn(cliCtx)).Methods("GET")
 }
 
 // SendReq defines the properties of a send request's body.
@@ -60,13 +81,15 @@ func SendRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-// EscrowReq defines the properties of a send request's body.
+// EscrowReq defines the properties of a escrow request's body.
 type EscrowReq struct {
-	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
-	Amount  sdk.Coins    `json:"amount" yaml:"amount"`
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Amount       sdk.Coins    `json:"amount" yaml:"amount"`
+	ExpireHeight int64        `json:"expire_height" yaml:"expire_height"`
+	ExpireTime   time.Time    `json:"expire_time" yaml:"expire_time"`
 }
 
-// EscrowRequestHandlerFn - http request handler to send coins to a address.
+// EscrowRequestHandlerFn - http request handler to escrow coins for a address.
 func EscrowRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
@@ -78,7 +101,7 @@ func EscrowRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		var req SendReq
+		var req EscrowReq
 		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
 			return
 		}
@@ -94,19 +117,70 @@ func EscrowRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgEscrow(fromAddr, toAddr, req.Amount)
+		msg := types.NewMsgEscrow(fromAddr, toAddr, req.Amount, req.ExpireHeight, req.ExpireTime)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
 
-// ReclaimReq defines the properties of a send request's body.
-type ReclaimReq struct {
+// EscrowActionReq defines the properties of a release escrow or reclaim request's body.
+type EscrowActionReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
-	Amount  sdk.Coins    `json:"amount" yaml:"amount"`
 }
 
-// ReclaimRequestHandlerFn - http request handler to send coins to a address.
+// ReleaseEscrowRequestHandlerFn - http request handler to release an escrow.
+func ReleaseEscrowRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return escrowActionRequestHandlerFn(cliCtx, func(from sdk.AccAddress, escrowID uint64) sdk.Msg {
+		return types.NewMsgReleaseEscrow(from, escrowID)
+	})
+}
+
+// ReclaimRequestHandlerFn - http request handler to reclaim an expired escrow.
 func ReclaimRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return escrowActionRequestHandlerFn(cliCtx, func(from sdk.AccAddress, escrowID uint64) sdk.Msg {
+		return types.NewMsgReclaim(from, escrowID)
+	})
+}
+
+func escrowActionRequestHandlerFn(cliCtx context.CLIContext, newMsg func(sdk.AccAddress, uint64) sdk.Msg) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		escrowID, ok := rest.ParseUint64OrReturnBadRequest(w, vars["escrowID"])
+		if !ok {
+			return
+		}
+
+		var req EscrowActionReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := newMsg(fromAddr, escrowID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// CreateHTLCReq defines the properties of a create htlc request's body.
+type CreateHTLCReq struct {
+	BaseReq      rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Amount       sdk.Coins    `json:"amount" yaml:"amount"`
+	HashLock     cmn.HexBytes `json:"hash_lock" yaml:"hash_lock"`
+	ExpireHeight int64        `json:"expire_height" yaml:"expire_height"`
+}
+
+// CreateHTLCRequestHandlerFn - http request handler to lock coins for a address against a hash lock.
+func CreateHTLCRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
 		bech32Addr := vars["address"]
@@ -117,7 +191,203 @@ func ReclaimRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		var req SendReq
+		var req CreateHTLCReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCreateHTLC(fromAddr, toAddr, req.Amount, req.HashLock, req.ExpireHeight)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// ClaimHTLCReq defines the properties of a claim htlc request's body.
+type ClaimHTLCReq struct {
+	BaseReq  rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Preimage cmn.HexBytes `json:"preimage" yaml:"preimage"`
+}
+
+// ClaimHTLCRequestHandlerFn - http request handler to claim an htlc with its preimage.
+func ClaimHTLCRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		htlcID, err := hex.DecodeString(vars["htlcID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req ClaimHTLCReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgClaimHTLC(fromAddr, htlcID, req.Preimage)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// RefundHTLCReq defines the properties of a refund htlc request's body.
+type RefundHTLCReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
+
+// RefundHTLCRequestHandlerFn - http request handler to refund an expired htlc.
+func RefundHTLCRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		htlcID, err := hex.DecodeString(vars["htlcID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req RefundHTLCReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRefundHTLC(fromAddr, htlcID)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// CreateVestingAccountReq defines the properties of a create vesting account request's body.
+type CreateVestingAccountReq struct {
+	BaseReq        rest.BaseReq      `json:"base_req" yaml:"base_req"`
+	Amount         sdk.Coins         `json:"amount" yaml:"amount"`
+	StartTime      int64             `json:"start_time" yaml:"start_time"`
+	EndTime        int64             `json:"end_time" yaml:"end_time"`
+	VestingPeriods authtypes.Periods `json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+// CreateVestingAccountRequestHandlerFn - http request handler to fund a new vesting account at a address.
+func CreateVestingAccountRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32Addr := vars["address"]
+
+		toAddr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req CreateVestingAccountReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCreateVestingAccount(fromAddr, toAddr, req.Amount, req.StartTime, req.EndTime)
+		msg.VestingPeriods = req.VestingPeriods
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// ScheduleTransferReq defines the properties of a schedule transfer request's body.
+type ScheduleTransferReq struct {
+	BaseReq     rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Amount      sdk.Coins    `json:"amount" yaml:"amount"`
+	Interval    int64        `json:"interval" yaml:"interval"`
+	Times       uint64       `json:"times" yaml:"times"`
+	StartHeight int64        `json:"start_height" yaml:"start_height"`
+}
+
+// ScheduleTransferRequestHandlerFn - http request handler to schedule the payments of coins to a address.
+func ScheduleTransferRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		bech32Addr := vars["address"]
+
+		toAddr, err := sdk.AccAddressFromBech32(bech32Addr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var req ScheduleTransferReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgScheduleTransfer(fromAddr, toAddr, req.Amount, req.Interval, req.Times, req.StartHeight)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// CancelScheduledTransferReq defines the properties of a cancel scheduled transfer request's body.
+type CancelScheduledTransferReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
+
+// CancelScheduledTransferRequestHandlerFn - http request handler to cancel a scheduled transfer.
+func CancelScheduledTransferRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		transferID, ok := rest.ParseUint64OrReturnBadRequest(w, vars["transferID"])
+		if !ok {
+			return
+		}
+
+		var req CancelScheduledTransferReq
 		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
 			return
 		}
@@ -133,7 +403,7 @@ func ReclaimRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgReclaim(fromAddr, toAddr, req.Amount)
+		msg := types.NewMsgCancelScheduledTransfer(fromAddr, transferID)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
@@ -1,17 +1,28 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "github.com/pocblockchain/pocc/types"
 )
 
 // GenesisState is the bank state that must be provided at genesis.
 type GenesisState struct {
-	SendEnabled bool `json:"send_enabled" yaml:"send_enabled"`
+	SendEnabled             bool               `json:"send_enabled" yaml:"send_enabled"`
+	NextEscrowID            uint64             `json:"next_escrow_id" yaml:"next_escrow_id"`
+	Escrows                 Escrows            `json:"escrows" yaml:"escrows"`
+	HTLCs                   HTLCs              `json:"htlcs" yaml:"htlcs"`
+	NextScheduledTransferID uint64             `json:"next_scheduled_transfer_id" yaml:"next_scheduled_transfer_id"`
+	ScheduledTransfers      ScheduledTransfers `json:"scheduled_transfers" yaml:"scheduled_transfers"`
 }
 
 // NewGenesisState creates a new genesis state.
 func NewGenesisState(sendEnabled bool) GenesisState {
-	return GenesisState{SendEnabled: sendEnabled}
+	return GenesisState{
+		SendEnabled:             sendEnabled,
+		NextEscrowID:            DefaultStartingEscrowID,
+		NextScheduledTransferID: DefaultStartingScheduledTransferID,
+	}
 }
 
 // DefaultGenesisState returns a default genesis state
@@ -20,13 +31,118 @@ func DefaultGenesisState() GenesisState { return NewGenesisState(true) }
 // InitGenesis sets distribution information for genesis.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	keeper.SetSendEnabled(ctx, data.SendEnabled)
+
+	if data.NextEscrowID == 0 {
+		data.NextEscrowID = DefaultStartingEscrowID
+	}
+	keeper.SetNextEscrowID(ctx, data.NextEscrowID)
+
+	for _, escrow := range data.Escrows {
+		keeper.SetEscrow(ctx, escrow)
+	}
+
+	// add coins if not provided on genesis
+	if len(data.Escrows) > 0 {
+		moduleAcc := keeper.GetEscrowAccount(ctx)
+		if moduleAcc.GetCoins().IsZero() {
+			if err := moduleAcc.SetCoins(data.Escrows.Total()); err != nil {
+				panic(err)
+			}
+			keeper.SetEscrowAccount(ctx, moduleAcc)
+		}
+	}
+
+	for _, htlc := range data.HTLCs {
+		keeper.SetHTLC(ctx, htlc)
+	}
+
+	if len(data.HTLCs) > 0 {
+		moduleAcc := keeper.GetHTLCAccount(ctx)
+		if moduleAcc.GetCoins().IsZero() {
+			if err := moduleAcc.SetCoins(data.HTLCs.Total()); err != nil {
+				panic(err)
+			}
+			keeper.SetHTLCAccount(ctx, moduleAcc)
+		}
+	}
+
+	if data.NextScheduledTransferID == 0 {
+		data.NextScheduledTransferID = DefaultStartingScheduledTransferID
+	}
+	keeper.SetNextScheduledTransferID(ctx, data.NextScheduledTransferID)
+
+	for _, transfer := range data.ScheduledTransfers {
+		keeper.SetScheduledTransfer(ctx, transfer)
+	}
+
+	if len(data.ScheduledTransfers) > 0 {
+		moduleAcc := keeper.GetScheduleAccount(ctx)
+		if moduleAcc.GetCoins().IsZero() {
+			if err := moduleAcc.SetCoins(data.ScheduledTransfers.Total()); err != nil {
+				panic(err)
+			}
+			keeper.SetScheduleAccount(ctx, moduleAcc)
+		}
+	}
+
+	// the genesis accounts and module accounts are set without the bank keeper,
+	// so bank is initialized after them and indexes their holders at once
+	keeper.RebuildHolderIndex(ctx)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
-	return NewGenesisState(keeper.GetSendEnabled(ctx))
+	return GenesisState{
+		SendEnabled:             keeper.GetSendEnabled(ctx),
+		NextEscrowID:            keeper.GetNextEscrowID(ctx),
+		Escrows:                 keeper.GetEscrows(ctx),
+		HTLCs:                   keeper.GetHTLCs(ctx),
+		NextScheduledTransferID: keeper.GetNextScheduledTransferID(ctx),
+		ScheduledTransfers:      keeper.GetScheduledTransfers(ctx),
+	}
 }
 
 // ValidateGenesis performs basic validation of bank genesis data returning an
 // error for any failed validation criteria.
-func ValidateGenesis(data GenesisState) error { return nil }
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[uint64]bool)
+	for _, escrow := range data.Escrows {
+		if err := escrow.Validate(); err != nil {
+			return err
+		}
+		if seen[escrow.EscrowID] {
+			return fmt.Errorf("duplicate escrow %d", escrow.EscrowID)
+		}
+		if escrow.EscrowID >= data.NextEscrowID {
+			return fmt.Errorf("escrow %d is not less than the next escrow ID %d", escrow.EscrowID, data.NextEscrowID)
+		}
+		seen[escrow.EscrowID] = true
+	}
+
+	seenHTLCs := make(map[string]bool)
+	for _, htlc := range data.HTLCs {
+		if err := htlc.Validate(); err != nil {
+			return err
+		}
+		if seenHTLCs[htlc.ID.String()] {
+			return fmt.Errorf("duplicate htlc %s", htlc.ID)
+		}
+		seenHTLCs[htlc.ID.String()] = true
+	}
+
+	seenTransfers := make(map[uint64]bool)
+	for _, transfer := range data.ScheduledTransfers {
+		if err := transfer.Validate(); err != nil {
+			return err
+		}
+		if seenTransfers[transfer.TransferID] {
+			return fmt.Errorf("duplicate scheduled transfer %d", transfer.TransferID)
+		}
+		if transfer.TransferID >= data.NextScheduledTransferID {
+			return fmt.Errorf("scheduled transfer %d is not less than the next scheduled transfer ID %d",
+				transfer.TransferID, data.NextScheduledTransferID)
+		}
+		seenTransfers[transfer.TransferID] = true
+	}
+	return nil
+}
@@ -23,15 +23,36 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case types.MsgEscrow:
 			return handleMsgEscrow(ctx, k, msg)
 
+		case types.MsgReleaseEscrow:
+			return handleMsgReleaseEscrow(ctx, k, msg)
+
 		case types.MsgReclaim:
 			return handleMsgReclaim(ctx, k, msg)
 
+		case types.MsgCreateHTLC:
+			return handleMsgCreateHTLC(ctx, k, msg)
+
+		case types.MsgClaimHTLC:
+			return handleMsgClaimHTLC(ctx, k, msg)
+
+		case types.MsgRefundHTLC:
+			return handleMsgRefundHTLC(ctx, k, msg)
+
+		case types.MsgCreateVestingAccount:
+			return handleMsgCreateVestingAccount(ctx, k, msg)
+
 		case types.MsgBonusSend:
 			return handleMsgBonusSend(ctx, k, msg)
 
 		case types.MsgReclaimSend:
 			return handleMsgReclaimSend(ctx, k, msg)
 
+		case types.MsgScheduleTransfer:
+			return handleMsgScheduleTransfer(ctx, k, msg)
+
+		case types.MsgCancelScheduledTransfer:
+			return handleMsgCancelScheduledTransfer(ctx, k, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized bank message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -53,6 +74,9 @@ func handleMsgSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgSend) sdk.Resu
 		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress)).Result()
 	}
 
+	getCoins := coinsGetter(ctx, k)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, msg.FromAddress, msg.ToAddress)
+
 	err := k.SendCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
 	if err != nil {
 		return err.Result()
@@ -64,7 +88,9 @@ func handleMsgSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgSend) sdk.Resu
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	)
-	return sdk.Result{Events: ctx.EventManager().Events()}
+
+	receipt := sdk.NewReceipt(sdk.CategoryTypeTransfer, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
 }
 
 // Handle MsgMultiSend.
@@ -87,6 +113,9 @@ func handleMsgMultiSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgMultiSend
 
 	}
 
+	getCoins := coinsGetter(ctx, k)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, inputOutputAddrs(msg.Inputs, msg.Outputs)...)
+
 	err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs)
 	if err != nil {
 		return err.Result()
@@ -99,7 +128,8 @@ func handleMsgMultiSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgMultiSend
 		),
 	)
 
-	return sdk.Result{Events: ctx.EventManager().Events()}
+	receipt := sdk.NewReceipt(sdk.CategoryTypeMultiTransfer, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
 }
 
 // Handle MsgEscrow.
@@ -116,7 +146,15 @@ func handleMsgEscrow(ctx sdk.Context, k keeper.Keeper, msg types.MsgEscrow) sdk.
 		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress)).Result()
 	}
 
-	err := k.EscrowCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Amount)
+	expiry := types.NewEscrow(0, msg.FromAddress, msg.ToAddress, msg.Amount, msg.ExpireHeight, msg.ExpireTime)
+	if expiry.HasExpiry() && expiry.IsExpired(ctx.BlockHeight(), ctx.BlockHeader().Time) {
+		return types.ErrInvalidEscrowExpiry(k.Codespace(), "escrow must expire after the current block").Result()
+	}
+
+	getCoins := coinsGetter(ctx, k)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, msg.FromAddress, k.GetEscrowAddress())
+
+	_, err := k.CreateEscrow(ctx, msg.FromAddress, msg.ToAddress, msg.Amount, msg.ExpireHeight, msg.ExpireTime)
 	if err != nil {
 		return err.Result()
 	}
@@ -128,18 +166,204 @@ func handleMsgEscrow(ctx sdk.Context, k keeper.Keeper, msg types.MsgEscrow) sdk.
 		),
 	)
 
-	return sdk.Result{Events: ctx.EventManager().Events()}
+	receipt := sdk.NewReceipt(sdk.CategoryTypeEscrow, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
+}
+
+// Handle MsgReleaseEscrow.
+func handleMsgReleaseEscrow(ctx sdk.Context, k keeper.Keeper, msg types.MsgReleaseEscrow) sdk.Result {
+	escrow, found := k.GetEscrow(ctx, msg.EscrowID)
+	if !found {
+		return types.ErrUnknownEscrow(k.Codespace(), msg.EscrowID).Result()
+	}
+
+	if escrow.IsExpired(ctx.BlockHeight(), ctx.BlockHeader().Time) {
+		return types.ErrEscrowExpired(k.Codespace(), msg.EscrowID).Result()
+	}
+
+	// the payer releases the coins to the payee, the payee releases them back to the payer
+	var to sdk.AccAddress
+	switch {
+	case msg.FromAddress.Equals(escrow.Payer):
+		to = escrow.Payee
+	case msg.FromAddress.Equals(escrow.Payee):
+		to = escrow.Payer
+	default:
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is neither the payer nor the payee of escrow %d", msg.FromAddress, msg.EscrowID)).Result()
+	}
+
+	getCoins := coinsGetter(ctx, k)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, k.GetEscrowAddress(), to)
+
+	var err sdk.Error
+	if to.Equals(escrow.Payee) {
+		err = k.ReleaseEscrow(ctx, escrow)
+	} else {
+		err = k.RefundEscrow(ctx, escrow)
+	}
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReleaseEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, sdk.NewUint(msg.EscrowID).String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, to.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	receipt := sdk.NewReceipt(sdk.CategoryTypeReleaseEscrow, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
 }
 
 // Handle MsgReclaim.
 func handleMsgReclaim(ctx sdk.Context, k keeper.Keeper, msg types.MsgReclaim) sdk.Result {
-	//just record the event,
+	escrow, found := k.GetEscrow(ctx, msg.EscrowID)
+	if !found {
+		return types.ErrUnknownEscrow(k.Codespace(), msg.EscrowID).Result()
+	}
+
+	if !msg.FromAddress.Equals(escrow.Payer) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not the payer of escrow %d", msg.FromAddress, msg.EscrowID)).Result()
+	}
+
+	if !escrow.IsExpired(ctx.BlockHeight(), ctx.BlockHeader().Time) {
+		return types.ErrEscrowNotExpired(k.Codespace(), msg.EscrowID).Result()
+	}
+
+	getCoins := coinsGetter(ctx, k)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, k.GetEscrowAddress(), escrow.Payer)
+
+	if err := k.RefundEscrow(ctx, escrow); err != nil {
+		return err.Result()
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeReclaim,
-			sdk.NewAttribute(types.AttributeKeyReclaimFrom, msg.FromAddress.String()),
-			sdk.NewAttribute(types.AttributeKeyReclaimTo, msg.ToAddress.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEscrowID, sdk.NewUint(msg.EscrowID).String()),
+			sdk.NewAttribute(types.AttributeKeyReclaimFrom, escrow.Payee.String()),
+			sdk.NewAttribute(types.AttributeKeyReclaimTo, escrow.Payer.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, escrow.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	receipt := sdk.NewReceipt(sdk.CategoryTypeReclaimEscrow, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
+}
+
+// Handle MsgCreateHTLC.
+func handleMsgCreateHTLC(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateHTLC) sdk.Result {
+	if !k.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled(k.Codespace()).Result()
+	}
+
+	if !k.IsCoinsSendEnabled(ctx, msg.Amount) {
+		return types.ErrSendDisabled(k.Codespace()).Result()
+	}
+
+	if k.BlacklistedAddr(msg.ToAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress)).Result()
+	}
+
+	if msg.ExpireHeight <= ctx.BlockHeight() {
+		return types.ErrInvalidHTLCExpiry(k.Codespace(), "htlc must expire after the current block").Result()
+	}
+
+	getCoins := coinsGetter(ctx, k)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, msg.FromAddress, k.GetHTLCAddress())
+
+	_, err := k.CreateHTLC(ctx, msg.FromAddress, msg.ToAddress, msg.Amount, msg.HashLock, msg.ExpireHeight)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	receipt := sdk.NewReceipt(sdk.CategoryTypeCreateHTLC, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
+}
+
+// Handle MsgCreateVestingAccount.
+func handleMsgCreateVestingAccount(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreateVestingAccount) sdk.Result {
+	if !k.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled(k.Codespace()).Result()
+	}
+
+	if !k.IsCoinsSendEnabled(ctx, msg.Amount) {
+		return types.ErrSendDisabled(k.Codespace()).Result()
+	}
+
+	if k.BlacklistedAddr(msg.ToAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress)).Result()
+	}
+
+	getCoins := coinsGetter(ctx, k)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, msg.FromAddress, msg.ToAddress)
+
+	err := k.CreateVestingAccount(ctx, msg.FromAddress, msg.ToAddress, msg.Amount, msg.StartTime, msg.EndTime, msg.VestingPeriods)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	receipt := sdk.NewReceipt(sdk.CategoryTypeCreateVesting, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
+}
+
+// Handle MsgClaimHTLC, anyone knowing the preimage may claim the coins for the recipient.
+func handleMsgClaimHTLC(ctx sdk.Context, k keeper.Keeper, msg types.MsgClaimHTLC) sdk.Result {
+	htlc, found := k.GetHTLC(ctx, msg.ID)
+	if !found {
+		return types.ErrUnknownHTLC(k.Codespace(), msg.ID).Result()
+	}
+
+	if htlc.IsExpired(ctx.BlockHeight()) {
+		return types.ErrHTLCExpired(k.Codespace(), msg.ID).Result()
+	}
+
+	if !types.MatchHashLock(htlc.HashLock, msg.Preimage) {
+		return types.ErrInvalidPreimage(k.Codespace(), "preimage does not match the hash lock").Result()
+	}
+
+	getCoins := coinsGetter(ctx, k)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, k.GetHTLCAddress(), htlc.Recipient)
+
+	if err := k.ClaimHTLC(ctx, htlc); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimHTLC,
+			sdk.NewAttribute(types.AttributeKeyHTLCID, htlc.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyHashLock, htlc.HashLock.String()),
+			sdk.NewAttribute(types.AttributeKeyPreimage, msg.Preimage.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, htlc.Recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, htlc.Amount.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -147,7 +371,46 @@ func handleMsgReclaim(ctx sdk.Context, k keeper.Keeper, msg types.MsgReclaim) sd
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
-	return sdk.Result{Events: ctx.EventManager().Events()}
+
+	receipt := sdk.NewReceipt(sdk.CategoryTypeClaimHTLC, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
+}
+
+// Handle MsgRefundHTLC, anyone may return the coins of an expired htlc to the sender.
+func handleMsgRefundHTLC(ctx sdk.Context, k keeper.Keeper, msg types.MsgRefundHTLC) sdk.Result {
+	htlc, found := k.GetHTLC(ctx, msg.ID)
+	if !found {
+		return types.ErrUnknownHTLC(k.Codespace(), msg.ID).Result()
+	}
+
+	if !htlc.IsExpired(ctx.BlockHeight()) {
+		return types.ErrHTLCNotExpired(k.Codespace(), msg.ID).Result()
+	}
+
+	getCoins := coinsGetter(ctx, k)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, k.GetHTLCAddress(), htlc.Sender)
+
+	if err := k.RefundHTLC(ctx, htlc); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRefundHTLC,
+			sdk.NewAttribute(types.AttributeKeyHTLCID, htlc.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyHashLock, htlc.HashLock.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, htlc.Sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, htlc.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	receipt := sdk.NewReceipt(sdk.CategoryTypeRefundHTLC, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
 }
 
 // Handle MsgBonusSend.
@@ -173,6 +436,9 @@ func handleMsgBonusSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgBonusSend
 		outAddr[i] = out.Address.String()
 	}
 
+	getCoins := coinsGetter(ctx, k)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, inputOutputAddrs(msg.Inputs, msg.Outputs)...)
+
 	err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs)
 	if err != nil {
 		return err.Result()
@@ -190,7 +456,8 @@ func handleMsgBonusSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgBonusSend
 		),
 	})
 
-	return sdk.Result{Events: ctx.EventManager().Events()}
+	receipt := sdk.NewReceipt(sdk.CategoryTypeBonusSend, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
 }
 
 // Handle MsgReclaimSend.
@@ -216,6 +483,9 @@ func handleMsgReclaimSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgReclaim
 		outAddr[i] = out.Address.String()
 	}
 
+	getCoins := coinsGetter(ctx, k)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, inputOutputAddrs(msg.Inputs, msg.Outputs)...)
+
 	err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs)
 	if err != nil {
 		return err.Result()
@@ -233,5 +503,105 @@ func handleMsgReclaimSend(ctx sdk.Context, k keeper.Keeper, msg types.MsgReclaim
 		),
 	})
 
-	return sdk.Result{Events: ctx.EventManager().Events()}
+	receipt := sdk.NewReceipt(sdk.CategoryTypeReclaimSend, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
+}
+
+// Handle MsgScheduleTransfer.
+func handleMsgScheduleTransfer(ctx sdk.Context, k keeper.Keeper, msg types.MsgScheduleTransfer) sdk.Result {
+	if !k.GetSendEnabled(ctx) {
+		return types.ErrSendDisabled(k.Codespace()).Result()
+	}
+
+	if !k.IsCoinsSendEnabled(ctx, msg.Amount) {
+		return types.ErrSendDisabled(k.Codespace()).Result()
+	}
+
+	if k.BlacklistedAddr(msg.ToAddress) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.ToAddress)).Result()
+	}
+
+	// the first payment is made after an interval unless a start height is given
+	startHeight := msg.StartHeight
+	if startHeight == 0 {
+		startHeight = ctx.BlockHeight() + msg.Interval
+	} else if startHeight <= ctx.BlockHeight() {
+		return types.ErrInvalidSchedule(k.Codespace(), "schedule must start after the current block").Result()
+	}
+
+	// the payments are made in the EndBlock without gas, so they are all paid for now
+	ctx.GasMeter().ConsumeGas(types.ScheduleExecutionGas*msg.Times, "scheduled transfer payments")
+
+	getCoins := coinsGetter(ctx, k)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, msg.FromAddress, k.GetScheduleAddress())
+
+	_, err := k.CreateScheduledTransfer(ctx, msg.FromAddress, msg.ToAddress, msg.Amount, msg.Interval, msg.Times, startHeight)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	receipt := sdk.NewReceipt(sdk.CategoryTypeScheduleTransfer, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
+}
+
+// Handle MsgCancelScheduledTransfer.
+func handleMsgCancelScheduledTransfer(ctx sdk.Context, k keeper.Keeper, msg types.MsgCancelScheduledTransfer) sdk.Result {
+	transfer, found := k.GetScheduledTransfer(ctx, msg.TransferID)
+	if !found {
+		return types.ErrUnknownScheduledTransfer(k.Codespace(), msg.TransferID).Result()
+	}
+
+	if !msg.FromAddress.Equals(transfer.Sender) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not the sender of scheduled transfer %d", msg.FromAddress, msg.TransferID)).Result()
+	}
+
+	getCoins := coinsGetter(ctx, k)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, k.GetScheduleAddress(), transfer.Sender)
+
+	if err := k.CancelScheduledTransfer(ctx, transfer); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelScheduledTransfer,
+			sdk.NewAttribute(types.AttributeKeyTransferID, sdk.NewUint(msg.TransferID).String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, transfer.Sender.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, transfer.Locked().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress.String()),
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	receipt := sdk.NewReceipt(sdk.CategoryTypeCancelScheduledTransfer, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
+}
+
+// coinsGetter reads balances for the receipt of a msg
+func coinsGetter(ctx sdk.Context, k keeper.Keeper) func(sdk.AccAddress) sdk.Coins {
+	return func(addr sdk.AccAddress) sdk.Coins {
+		return k.GetCoins(ctx, addr)
+	}
+}
+
+// inputOutputAddrs returns the addresses of inputs followed by those of outputs
+func inputOutputAddrs(inputs []types.Input, outputs []types.Output) []sdk.AccAddress {
+	addrs := make([]sdk.AccAddress, 0, len(inputs)+len(outputs))
+	for _, in := range inputs {
+		addrs = append(addrs, in.Address)
+	}
+	for _, out := range outputs {
+		addrs = append(addrs, out.Address)
+	}
+	return addrs
 }
@@ -4,6 +4,7 @@ package keeper
 
 import (
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/pocblockchain/pocc/x/auth"
 	"github.com/pocblockchain/pocc/x/bank/internal/types"
 	"github.com/pocblockchain/pocc/x/params"
+	supplyexported "github.com/pocblockchain/pocc/x/supply/exported"
 )
 
 type testInput struct {
@@ -28,14 +30,18 @@ func setupTestInput() testInput {
 
 	cdc := codec.New()
 	auth.RegisterCodec(cdc)
+	cdc.RegisterConcrete(&mockModuleAccount{}, "test/bank/ModuleAccount", nil)
 	codec.RegisterCrypto(cdc)
 
 	authCapKey := sdk.NewKVStoreKey("authCapKey")
+	keyBank := sdk.NewKVStoreKey(types.StoreKey)
+
 	keyParams := sdk.NewKVStoreKey("params")
 	tkeyParams := sdk.NewTransientStoreKey("transient_params")
 
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(authCapKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	ms.LoadLatestVersion()
@@ -52,8 +58,67 @@ func setupTestInput() testInput {
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
 	ak.SetParams(ctx, auth.DefaultParams())
 
-	bankKeeper := NewBaseKeeper(ak, nil, pk.Subspace(types.DefaultParamspace), types.DefaultCodespace, blacklistedAddrs)
+	bankKeeper := NewBaseKeeper(ak, nil, keyBank, pk.Subspace(types.DefaultParamspace), types.DefaultCodespace, blacklistedAddrs)
 	bankKeeper.SetSendEnabled(ctx, true)
 
+	bankKeeper.SetSupplyKeeper(mockSupplyKeeper{ak: ak, bk: bankKeeper})
+
 	return testInput{cdc: cdc, ctx: ctx, k: bankKeeper, ak: ak, pk: pk}
 }
+
+// mockTokenKeeper enables send for every token and freezes the accounts it is given
+type mockTokenKeeper struct {
+	frozen map[string]bool
+}
+
+func (tk mockTokenKeeper) IsSendEnabled(ctx sdk.Context, symbol sdk.Symbol) bool {
+	return true
+}
+
+func (tk mockTokenKeeper) IsAccountFrozen(ctx sdk.Context, symbol sdk.Symbol, addr sdk.AccAddress) bool {
+	return tk.frozen[symbol.String()+addr.String()]
+}
+
+// mockModuleAccount is the module account held by mockSupplyKeeper
+type mockModuleAccount struct {
+	*auth.BaseAccount
+	Name string
+}
+
+func (acc *mockModuleAccount) GetName() string           { return acc.Name }
+func (acc *mockModuleAccount) GetPermissions() []string  { return nil }
+func (acc *mockModuleAccount) HasPermission(string) bool { return false }
+
+// mockSupplyKeeper creates module accounts on demand and moves coins with the bank keeper
+type mockSupplyKeeper struct {
+	ak auth.AccountKeeper
+	bk BaseKeeper
+}
+
+func (sk mockSupplyKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
+}
+
+func (sk mockSupplyKeeper) GetModuleAccount(ctx sdk.Context, name string) supplyexported.ModuleAccountI {
+	addr := sk.GetModuleAddress(name)
+	if acc, ok := sk.ak.GetAccount(ctx, addr).(supplyexported.ModuleAccountI); ok {
+		return acc
+	}
+
+	base := auth.NewBaseAccountWithAddress(addr)
+	macc := &mockModuleAccount{BaseAccount: &base, Name: name}
+	sk.ak.SetAccount(ctx, sk.ak.NewAccount(ctx, macc))
+	return macc
+}
+
+func (sk mockSupplyKeeper) SetModuleAccount(ctx sdk.Context, macc supplyexported.ModuleAccountI) {
+	sk.ak.SetAccount(ctx, macc)
+}
+
+func (sk mockSupplyKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error {
+	return sk.bk.SendCoins(ctx, senderAddr, sk.GetModuleAccount(ctx, recipientModule).GetAddress(), amt)
+}
+
+func (sk mockSupplyKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	return sk.bk.SendCoins(ctx, sk.GetModuleAddress(senderModule), recipientAddr, amt)
+}
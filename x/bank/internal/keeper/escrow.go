@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/bank/internal/types"
+	supplyexported "github.com/pocblockchain/pocc/x/supply/exported"
+)
+
+// EscrowKeeper defines a module interface that locks coins in the escrow module
+// account until they are released to the payee or refunded to the payer.
+type EscrowKeeper interface {
+	CreateEscrow(ctx sdk.Context, payer, payee sdk.AccAddress, amt sdk.Coins, expireHeight int64, expireTime time.Time) (uint64, sdk.Error)
+	ReleaseEscrow(ctx sdk.Context, escrow types.Escrow) sdk.Error
+	RefundEscrow(ctx sdk.Context, escrow types.Escrow) sdk.Error
+
+	GetEscrow(ctx sdk.Context, escrowID uint64) (types.Escrow, bool)
+	SetEscrow(ctx sdk.Context, escrow types.Escrow)
+	GetEscrows(ctx sdk.Context) types.Escrows
+	GetEscrowsByPayer(ctx sdk.Context, payer sdk.AccAddress) types.Escrows
+	GetEscrowsByPayee(ctx sdk.Context, payee sdk.AccAddress) types.Escrows
+	GetEscrowsDueForRefund(ctx sdk.Context) types.Escrows
+	IterateEscrows(ctx sdk.Context, cb func(escrow types.Escrow) (stop bool))
+
+	GetNextEscrowID(ctx sdk.Context) uint64
+	SetNextEscrowID(ctx sdk.Context, escrowID uint64)
+
+	GetEscrowAddress() sdk.AccAddress
+	GetEscrowAccount(ctx sdk.Context) supplyexported.ModuleAccountI
+	SetEscrowAccount(ctx sdk.Context, macc supplyexported.ModuleAccountI)
+}
+
+var _ EscrowKeeper = (*BaseKeeper)(nil)
+
+// CreateEscrow locks amt of payer in the escrow module account for payee and returns the escrow ID
+func (keeper BaseKeeper) CreateEscrow(ctx sdk.Context, payer, payee sdk.AccAddress, amt sdk.Coins,
+	expireHeight int64, expireTime time.Time) (uint64, sdk.Error) {
+
+	if err := keeper.checkFrozen(ctx, payer, amt); err != nil {
+		return 0, err
+	}
+
+	if err := keeper.supplyKeeper().SendCoinsFromAccountToModule(ctx, payer, types.EscrowAccountName, amt); err != nil {
+		return 0, err
+	}
+
+	escrowID := keeper.GetNextEscrowID(ctx)
+	keeper.SetEscrow(ctx, types.NewEscrow(escrowID, payer, payee, amt, expireHeight, expireTime))
+	keeper.SetNextEscrowID(ctx, escrowID+1)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEscrow,
+			sdk.NewAttribute(types.AttributeKeyEscrowID, sdk.NewUint(escrowID).String()),
+			sdk.NewAttribute(types.AttributeKeySender, payer.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, payee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, payer.String()),
+		),
+	})
+
+	return escrowID, nil
+}
+
+// ReleaseEscrow pays the escrowed coins to the payee and removes the escrow
+func (keeper BaseKeeper) ReleaseEscrow(ctx sdk.Context, escrow types.Escrow) sdk.Error {
+	if err := keeper.supplyKeeper().SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, escrow.Payee, escrow.Amount); err != nil {
+		return err
+	}
+
+	keeper.deleteEscrow(ctx, escrow)
+	return nil
+}
+
+// RefundEscrow returns the escrowed coins to the payer and removes the escrow
+func (keeper BaseKeeper) RefundEscrow(ctx sdk.Context, escrow types.Escrow) sdk.Error {
+	if err := keeper.supplyKeeper().SendCoinsFromModuleToAccount(ctx, types.EscrowAccountName, escrow.Payer, escrow.Amount); err != nil {
+		return err
+	}
+
+	keeper.deleteEscrow(ctx, escrow)
+	return nil
+}
+
+// GetEscrow returns the escrow of escrowID
+func (keeper BaseKeeper) GetEscrow(ctx sdk.Context, escrowID uint64) (types.Escrow, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.EscrowKey(escrowID))
+	if bz == nil {
+		return types.Escrow{}, false
+	}
+
+	var escrow types.Escrow
+	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(bz, &escrow)
+	return escrow, true
+}
+
+// SetEscrow stores the escrow together with its payer, payee and expiry indexes
+func (keeper BaseKeeper) SetEscrow(ctx sdk.Context, escrow types.Escrow) {
+	store := ctx.KVStore(keeper.storeKey)
+	idBz := sdk.Uint64ToBigEndian(escrow.EscrowID)
+
+	store.Set(types.EscrowKey(escrow.EscrowID), types.ModuleCdc.MustMarshalBinaryLengthPrefixed(escrow))
+	store.Set(types.EscrowByPayerKey(escrow.Payer, escrow.EscrowID), idBz)
+	store.Set(types.EscrowByPayeeKey(escrow.Payee, escrow.EscrowID), idBz)
+	if escrow.ExpireHeight > 0 {
+		store.Set(types.EscrowHeightQueueKey(escrow.EscrowID, escrow.ExpireHeight), idBz)
+	}
+	if !escrow.ExpireTime.IsZero() {
+		store.Set(types.EscrowTimeQueueKey(escrow.EscrowID, escrow.ExpireTime), idBz)
+	}
+}
+
+func (keeper BaseKeeper) deleteEscrow(ctx sdk.Context, escrow types.Escrow) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	store.Delete(types.EscrowKey(escrow.EscrowID))
+	store.Delete(types.EscrowByPayerKey(escrow.Payer, escrow.EscrowID))
+	store.Delete(types.EscrowByPayeeKey(escrow.Payee, escrow.EscrowID))
+	if escrow.ExpireHeight > 0 {
+		store.Delete(types.EscrowHeightQueueKey(escrow.EscrowID, escrow.ExpireHeight))
+	}
+	if !escrow.ExpireTime.IsZero() {
+		store.Delete(types.EscrowTimeQueueKey(escrow.EscrowID, escrow.ExpireTime))
+	}
+}
+
+// IterateEscrows iterates over all the escrows in the order of their IDs
+func (keeper BaseKeeper) IterateEscrows(ctx sdk.Context, cb func(escrow types.Escrow) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.EscrowsKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var escrow types.Escrow
+		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &escrow)
+		if cb(escrow) {
+			break
+		}
+	}
+}
+
+// GetEscrows returns all the escrows
+func (keeper BaseKeeper) GetEscrows(ctx sdk.Context) types.Escrows {
+	escrows := types.Escrows{}
+	keeper.IterateEscrows(ctx, func(escrow types.Escrow) bool {
+		escrows = append(escrows, escrow)
+		return false
+	})
+	return escrows
+}
+
+// GetEscrowsByPayer returns the escrows paid by payer
+func (keeper BaseKeeper) GetEscrowsByPayer(ctx sdk.Context, payer sdk.AccAddress) types.Escrows {
+	return keeper.getEscrowsByIndex(ctx, types.EscrowsByPayerKey(payer), nil)
+}
+
+// GetEscrowsByPayee returns the escrows payable to payee
+func (keeper BaseKeeper) GetEscrowsByPayee(ctx sdk.Context, payee sdk.AccAddress) types.Escrows {
+	return keeper.getEscrowsByIndex(ctx, types.EscrowsByPayeeKey(payee), nil)
+}
+
+// GetEscrowsDueForRefund returns the expired escrows whose reclaim window is over at the
+// current block height or time
+func (keeper BaseKeeper) GetEscrowsDueForRefund(ctx sdk.Context) types.Escrows {
+	var escrows types.Escrows
+	seen := make(map[uint64]bool)
+	if height := ctx.BlockHeight() - types.EscrowReclaimBlocks; height > 0 {
+		escrows = keeper.getEscrowsByIndex(ctx, types.EscrowHeightQueueKeyPrefix,
+			sdk.PrefixEndBytes(types.EscrowHeightQueueByHeightKey(height)))
+		for _, escrow := range escrows {
+			seen[escrow.EscrowID] = true
+		}
+	}
+
+	for _, escrow := range keeper.getEscrowsByIndex(ctx, types.EscrowTimeQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.EscrowTimeQueueByTimeKey(ctx.BlockHeader().Time.Add(-types.EscrowReclaimPeriod)))) {
+		if !seen[escrow.EscrowID] {
+			escrows = append(escrows, escrow)
+		}
+	}
+	return escrows
+}
+
+// getEscrowsByIndex returns the escrows whose IDs are stored under prefix, up to end if it is not nil
+func (keeper BaseKeeper) getEscrowsByIndex(ctx sdk.Context, prefix, end []byte) types.Escrows {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var iter sdk.Iterator
+	if end == nil {
+		iter = sdk.KVStorePrefixIterator(store, prefix)
+	} else {
+		iter = store.Iterator(prefix, end)
+	}
+	defer iter.Close()
+
+	escrows := types.Escrows{}
+	for ; iter.Valid(); iter.Next() {
+		escrowID := types.SplitEscrowIDFromKey(iter.Key())
+		escrow, found := keeper.GetEscrow(ctx, escrowID)
+		if !found {
+			panic(fmt.Sprintf("escrow %d indexed but not found", escrowID))
+		}
+		escrows = append(escrows, escrow)
+	}
+	return escrows
+}
+
+// GetNextEscrowID returns the ID of the next escrow
+func (keeper BaseKeeper) GetNextEscrowID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.EscrowIDKey)
+	if bz == nil {
+		return types.DefaultStartingEscrowID
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextEscrowID sets the ID of the next escrow
+func (keeper BaseKeeper) SetNextEscrowID(ctx sdk.Context, escrowID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.EscrowIDKey, sdk.Uint64ToBigEndian(escrowID))
+}
+
+// GetEscrowAddress returns the address of the escrow module account
+func (keeper BaseKeeper) GetEscrowAddress() sdk.AccAddress {
+	return keeper.supplyKeeper().GetModuleAddress(types.EscrowAccountName)
+}
+
+// GetEscrowAccount returns the escrow module account, it is created if it does not exist
+func (keeper BaseKeeper) GetEscrowAccount(ctx sdk.Context) supplyexported.ModuleAccountI {
+	return keeper.supplyKeeper().GetModuleAccount(ctx, types.EscrowAccountName)
+}
+
+// SetEscrowAccount stores the escrow module account
+func (keeper BaseKeeper) SetEscrowAccount(ctx sdk.Context, macc supplyexported.ModuleAccountI) {
+	keeper.supplyKeeper().SetModuleAccount(ctx, macc)
+}
+
+func (keeper BaseKeeper) supplyKeeper() types.SupplyKeeper {
+	if keeper.sk == nil {
+		panic("supplyKeeper is not set")
+	}
+	return keeper.sk
+}
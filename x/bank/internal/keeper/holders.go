@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/auth/exported"
+	"github.com/pocblockchain/pocc/x/bank/internal/types"
+)
+
+// HolderKeeper defines a module interface that looks up the holders of a denom
+// through the holder index, which is updated whenever the coins of an account change.
+type HolderKeeper interface {
+	GetHolderCount(ctx sdk.Context, denom string) uint64
+	GetHolderBalance(ctx sdk.Context, denom string, addr sdk.AccAddress) sdk.Int
+	GetHolders(ctx sdk.Context, denom string, page, limit int) types.Holders
+	IterateHolders(ctx sdk.Context, denom string, cb func(holder types.Holder) (stop bool))
+	IterateAllHolders(ctx sdk.Context, cb func(denom string, holder types.Holder) (stop bool))
+
+	RebuildHolderIndex(ctx sdk.Context)
+}
+
+var _ HolderKeeper = (*BaseKeeper)(nil)
+
+// GetHolderCount returns the number of addresses holding denom
+func (keeper BaseKeeper) GetHolderCount(ctx sdk.Context, denom string) uint64 {
+	return getHolderCount(ctx.KVStore(keeper.storeKey), denom)
+}
+
+// GetHolderBalance returns the balance of denom held by addr according to the holder index
+func (keeper BaseKeeper) GetHolderBalance(ctx sdk.Context, denom string, addr sdk.AccAddress) sdk.Int {
+	return getHolderBalance(ctx.KVStore(keeper.storeKey), denom, addr)
+}
+
+// GetHolders returns a page of the holders of denom, from the largest balance,
+// pages start at 1
+func (keeper BaseKeeper) GetHolders(ctx sdk.Context, denom string, page, limit int) types.Holders {
+	holders := types.Holders{}
+	if page < 1 || limit < 1 {
+		return holders
+	}
+
+	skip := (page - 1) * limit
+	keeper.IterateHolders(ctx, denom, func(holder types.Holder) bool {
+		if skip > 0 {
+			skip--
+			return false
+		}
+		holders = append(holders, holder)
+		return len(holders) == limit
+	})
+	return holders
+}
+
+// IterateHolders iterates over the holders of denom, from the largest balance
+func (keeper BaseKeeper) IterateHolders(ctx sdk.Context, denom string, cb func(holder types.Holder) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.HoldersByBalanceKey(denom))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr := types.SplitHolderByBalanceKey(denom, iterator.Key())
+		if cb(types.NewHolder(addr, getHolderBalance(store, denom, addr))) {
+			break
+		}
+	}
+}
+
+// IterateAllHolders iterates over the holder index of every denom
+func (keeper BaseKeeper) IterateAllHolders(ctx sdk.Context, cb func(denom string, holder types.Holder) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.HoldersKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &amount)
+
+		denom, addr := types.SplitHolderKey(iterator.Key())
+		if cb(denom, types.NewHolder(addr, amount)) {
+			break
+		}
+	}
+}
+
+// RebuildHolderIndex drops the holder index and builds it again from the
+// balances of all accounts, accounts set without the bank keeper at genesis
+// are only indexed this way
+func (keeper BaseKeeper) RebuildHolderIndex(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	for _, prefix := range [][]byte{types.HoldersKeyPrefix, types.HoldersByBalanceKeyPrefix, types.HolderCountKeyPrefix} {
+		var keys [][]byte
+		iterator := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	keeper.ak.IterateAccounts(ctx, func(acc exported.Account) bool {
+		keeper.updateHolders(ctx, acc.GetAddress(), nil, acc.GetCoins())
+		return false
+	})
+}
+
+// updateHolders moves addr in the holder index of every denom whose balance
+// differs between oldCoins and newCoins
+func (keeper BaseSendKeeper) updateHolders(ctx sdk.Context, addr sdk.AccAddress, oldCoins, newCoins sdk.Coins) {
+	if keeper.storeKey == nil {
+		return
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	for _, coin := range oldCoins {
+		if !newCoins.AmountOf(coin.Denom).IsPositive() {
+			setHolderBalance(store, coin.Denom, addr, sdk.ZeroInt())
+		}
+	}
+	for _, coin := range newCoins {
+		setHolderBalance(store, coin.Denom, addr, coin.Amount)
+	}
+}
+
+func getHolderCount(store sdk.KVStore, denom string) uint64 {
+	bz := store.Get(types.HolderCountKey(denom))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func getHolderBalance(store sdk.KVStore, denom string, addr sdk.AccAddress) sdk.Int {
+	bz := store.Get(types.HolderKey(denom, addr))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.Int
+	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(bz, &amount)
+	return amount
+}
+
+// setHolderBalance sets the balance of denom held by addr in the holder index,
+// a balance that is not positive removes addr from the holders of denom
+func setHolderBalance(store sdk.KVStore, denom string, addr sdk.AccAddress, amount sdk.Int) {
+	oldAmount := getHolderBalance(store, denom, addr)
+	if oldAmount.Equal(amount) {
+		return
+	}
+
+	count := getHolderCount(store, denom)
+
+	if oldAmount.IsPositive() {
+		store.Delete(types.HolderByBalanceKey(denom, oldAmount, addr))
+		count--
+	}
+
+	if amount.IsPositive() {
+		store.Set(types.HolderKey(denom, addr), types.ModuleCdc.MustMarshalBinaryLengthPrefixed(amount))
+		store.Set(types.HolderByBalanceKey(denom, amount, addr), []byte{})
+		count++
+	} else {
+		store.Delete(types.HolderKey(denom, addr))
+	}
+
+	if count == 0 {
+		store.Delete(types.HolderCountKey(denom))
+	} else {
+		store.Set(types.HolderCountKey(denom), sdk.Uint64ToBigEndian(count))
+	}
+}
@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/bank/internal/types"
+	supplyexported "github.com/pocblockchain/pocc/x/supply/exported"
+)
+
+// HTLCKeeper defines a module interface that locks coins in the htlc module account
+// until they are claimed with the preimage of their hash lock or refunded after expiry.
+type HTLCKeeper interface {
+	CreateHTLC(ctx sdk.Context, sender, recipient sdk.AccAddress, amt sdk.Coins, hashLock []byte, expireHeight int64) ([]byte, sdk.Error)
+	ClaimHTLC(ctx sdk.Context, htlc types.HTLC) sdk.Error
+	RefundHTLC(ctx sdk.Context, htlc types.HTLC) sdk.Error
+
+	GetHTLC(ctx sdk.Context, id []byte) (types.HTLC, bool)
+	SetHTLC(ctx sdk.Context, htlc types.HTLC)
+	GetHTLCs(ctx sdk.Context) types.HTLCs
+	IterateHTLCs(ctx sdk.Context, cb func(htlc types.HTLC) (stop bool))
+
+	GetHTLCAddress() sdk.AccAddress
+	GetHTLCAccount(ctx sdk.Context) supplyexported.ModuleAccountI
+	SetHTLCAccount(ctx sdk.Context, macc supplyexported.ModuleAccountI)
+}
+
+var _ HTLCKeeper = (*BaseKeeper)(nil)
+
+// CreateHTLC locks amt of sender in the htlc module account for recipient against hashLock
+// and returns the HTLC ID
+func (keeper BaseKeeper) CreateHTLC(ctx sdk.Context, sender, recipient sdk.AccAddress, amt sdk.Coins,
+	hashLock []byte, expireHeight int64) ([]byte, sdk.Error) {
+
+	htlc := types.NewHTLC(hashLock, sender, recipient, amt, expireHeight)
+	if _, found := keeper.GetHTLC(ctx, htlc.ID); found {
+		return nil, types.ErrDuplicateHTLC(keeper.Codespace(), htlc.ID)
+	}
+
+	if err := keeper.checkFrozen(ctx, sender, amt); err != nil {
+		return nil, err
+	}
+
+	if err := keeper.supplyKeeper().SendCoinsFromAccountToModule(ctx, sender, types.HTLCAccountName, amt); err != nil {
+		return nil, err
+	}
+
+	keeper.SetHTLC(ctx, htlc)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateHTLC,
+			sdk.NewAttribute(types.AttributeKeyHTLCID, htlc.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyHashLock, fmt.Sprintf("%X", hashLock)),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+			sdk.NewAttribute(types.AttributeKeyExpireHeight, fmt.Sprintf("%d", expireHeight)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		),
+	})
+
+	return htlc.ID, nil
+}
+
+// ClaimHTLC pays the locked coins to the recipient and removes the HTLC
+func (keeper BaseKeeper) ClaimHTLC(ctx sdk.Context, htlc types.HTLC) sdk.Error {
+	if err := keeper.supplyKeeper().SendCoinsFromModuleToAccount(ctx, types.HTLCAccountName, htlc.Recipient, htlc.Amount); err != nil {
+		return err
+	}
+
+	keeper.deleteHTLC(ctx, htlc)
+	return nil
+}
+
+// RefundHTLC returns the locked coins to the sender and removes the HTLC
+func (keeper BaseKeeper) RefundHTLC(ctx sdk.Context, htlc types.HTLC) sdk.Error {
+	if err := keeper.supplyKeeper().SendCoinsFromModuleToAccount(ctx, types.HTLCAccountName, htlc.Sender, htlc.Amount); err != nil {
+		return err
+	}
+
+	keeper.deleteHTLC(ctx, htlc)
+	return nil
+}
+
+// GetHTLC returns the HTLC of the given ID
+func (keeper BaseKeeper) GetHTLC(ctx sdk.Context, id []byte) (types.HTLC, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.HTLCKey(id))
+	if bz == nil {
+		return types.HTLC{}, false
+	}
+
+	var htlc types.HTLC
+	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(bz, &htlc)
+	return htlc, true
+}
+
+// SetHTLC stores the HTLC under its ID
+func (keeper BaseKeeper) SetHTLC(ctx sdk.Context, htlc types.HTLC) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.HTLCKey(htlc.ID), types.ModuleCdc.MustMarshalBinaryLengthPrefixed(htlc))
+}
+
+func (keeper BaseKeeper) deleteHTLC(ctx sdk.Context, htlc types.HTLC) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.HTLCKey(htlc.ID))
+}
+
+// IterateHTLCs iterates over all the HTLCs in the order of their IDs
+func (keeper BaseKeeper) IterateHTLCs(ctx sdk.Context, cb func(htlc types.HTLC) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.HTLCsKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var htlc types.HTLC
+		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &htlc)
+		if cb(htlc) {
+			break
+		}
+	}
+}
+
+// GetHTLCs returns all the HTLCs
+func (keeper BaseKeeper) GetHTLCs(ctx sdk.Context) types.HTLCs {
+	htlcs := types.HTLCs{}
+	keeper.IterateHTLCs(ctx, func(htlc types.HTLC) bool {
+		htlcs = append(htlcs, htlc)
+		return false
+	})
+	return htlcs
+}
+
+// GetHTLCAddress returns the address of the htlc module account
+func (keeper BaseKeeper) GetHTLCAddress() sdk.AccAddress {
+	return keeper.supplyKeeper().GetModuleAddress(types.HTLCAccountName)
+}
+
+// GetHTLCAccount returns the htlc module account, it is created if it does not exist
+func (keeper BaseKeeper) GetHTLCAccount(ctx sdk.Context) supplyexported.ModuleAccountI {
+	return keeper.supplyKeeper().GetModuleAccount(ctx, types.HTLCAccountName)
+}
+
+// SetHTLCAccount stores the htlc module account
+func (keeper BaseKeeper) SetHTLCAccount(ctx sdk.Context, macc supplyexported.ModuleAccountI) {
+	keeper.supplyKeeper().SetModuleAccount(ctx, macc)
+}
@@ -4,13 +4,24 @@ import (
 	"fmt"
 
 	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/auth/exported"
 	"github.com/pocblockchain/pocc/x/bank/internal/types"
 )
 
 // RegisterInvariants registers the bank module invariants
-func RegisterInvariants(ir sdk.InvariantRegistry, ak types.AccountKeeper) {
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper, ak types.AccountKeeper) {
 	ir.RegisterRoute(types.ModuleName, "nonnegative-outstanding",
 		NonnegativeBalanceInvariant(ak))
+	ir.RegisterRoute(types.ModuleName, "escrow-balance",
+		EscrowBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrow-index",
+		EscrowIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "htlc-balance",
+		HTLCBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "holder-index",
+		HolderIndexInvariant(k, ak))
+	ir.RegisterRoute(types.ModuleName, "schedule-balance",
+		ScheduleBalanceInvariant(k))
 }
 
 // NonnegativeBalanceInvariant checks that all accounts in the application have non-negative balances
@@ -35,3 +46,149 @@ func NonnegativeBalanceInvariant(ak types.AccountKeeper) sdk.Invariant {
 			fmt.Sprintf("amount of negative accounts found %d\n%s", count, msg)), broken
 	}
 }
+
+// EscrowBalanceInvariant checks that the escrow module account holds exactly the escrowed coins
+func EscrowBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := k.GetEscrows(ctx).Total()
+		balance := k.GetCoins(ctx, k.GetEscrowAddress())
+
+		broken := !balance.IsEqual(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow-balance",
+			fmt.Sprintf("\tescrow module account coins: %s\n"+
+				"\tsum of escrowed amounts:     %s\n", balance, expected)), broken
+	}
+}
+
+// EscrowIndexInvariant checks that every escrow is valid, has an ID less than
+// the next escrow ID and is found through its payer and payee
+func EscrowIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		nextID := k.GetNextEscrowID(ctx)
+		k.IterateEscrows(ctx, func(escrow types.Escrow) bool {
+			if err := escrow.Validate(); err != nil {
+				count++
+				msg += fmt.Sprintf("\t%s\n", err)
+			}
+			if escrow.EscrowID >= nextID {
+				count++
+				msg += fmt.Sprintf("\tescrow %d is not less than the next escrow ID %d\n", escrow.EscrowID, nextID)
+			}
+			if !containsEscrow(k.GetEscrowsByPayer(ctx, escrow.Payer), escrow.EscrowID) {
+				count++
+				msg += fmt.Sprintf("\tescrow %d is missing from the index of payer %s\n", escrow.EscrowID, escrow.Payer)
+			}
+			if !containsEscrow(k.GetEscrowsByPayee(ctx, escrow.Payee), escrow.EscrowID) {
+				count++
+				msg += fmt.Sprintf("\tescrow %d is missing from the index of payee %s\n", escrow.EscrowID, escrow.Payee)
+			}
+			return false
+		})
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "escrow-index",
+			fmt.Sprintf("amount of inconsistent escrows found %d\n%s", count, msg)), broken
+	}
+}
+
+// HTLCBalanceInvariant checks that the htlc module account holds exactly the locked coins
+func HTLCBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := k.GetHTLCs(ctx).Total()
+		balance := k.GetCoins(ctx, k.GetHTLCAddress())
+
+		broken := !balance.IsEqual(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "htlc-balance",
+			fmt.Sprintf("\thtlc module account coins: %s\n"+
+				"\tsum of locked amounts:    %s\n", balance, expected)), broken
+	}
+}
+
+// ScheduleBalanceInvariant checks that the schedule module account holds exactly the coins
+// of the remaining payments of the scheduled transfers
+func ScheduleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := k.GetScheduledTransfers(ctx).Total()
+		balance := k.GetCoins(ctx, k.GetScheduleAddress())
+
+		broken := !balance.IsEqual(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "schedule-balance",
+			fmt.Sprintf("\tschedule module account coins: %s\n"+
+				"\tsum of remaining payments:    %s\n", balance, expected)), broken
+	}
+}
+
+// HolderIndexInvariant checks that the holder index matches the balances of all
+// accounts and that the holder counts match the indexed holders
+func HolderIndexInvariant(k Keeper, ak types.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		holders := make(map[string]uint64)
+		ak.IterateAccounts(ctx, func(acc exported.Account) bool {
+			for _, coin := range acc.GetCoins() {
+				if !coin.Amount.IsPositive() {
+					continue
+				}
+				holders[coin.Denom]++
+
+				if indexed := k.GetHolderBalance(ctx, coin.Denom, acc.GetAddress()); !indexed.Equal(coin.Amount) {
+					count++
+					msg += fmt.Sprintf("\t%s holds %s%s but the index has %s\n",
+						acc.GetAddress(), coin.Amount, coin.Denom, indexed)
+				}
+			}
+			return false
+		})
+
+		indexed := make(map[string]uint64)
+		k.IterateAllHolders(ctx, func(denom string, holder types.Holder) bool {
+			indexed[denom]++
+			if balance := k.GetCoins(ctx, holder.Address).AmountOf(denom); !balance.Equal(holder.Amount) {
+				count++
+				msg += fmt.Sprintf("\tthe index has %s%s for %s which holds %s\n",
+					holder.Amount, denom, holder.Address, balance)
+			}
+			return false
+		})
+
+		// denoms only found in the index are held by no account
+		for denom := range indexed {
+			if _, ok := holders[denom]; !ok {
+				holders[denom] = 0
+			}
+		}
+		for denom, n := range holders {
+			byBalance := uint64(0)
+			k.IterateHolders(ctx, denom, func(types.Holder) bool {
+				byBalance++
+				return false
+			})
+			if holderCount := k.GetHolderCount(ctx, denom); holderCount != n || byBalance != n || indexed[denom] != n {
+				count++
+				msg += fmt.Sprintf("\t%s is held by %d accounts but the index counts %d with %d holders and %d by balance\n",
+					denom, n, holderCount, indexed[denom], byBalance)
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "holder-index",
+			fmt.Sprintf("amount of inconsistent holders found %d\n%s", count, msg)), broken
+	}
+}
+
+func containsEscrow(escrows types.Escrows, escrowID uint64) bool {
+	for _, escrow := range escrows {
+		if escrow.EscrowID == escrowID {
+			return true
+		}
+	}
+	return false
+}
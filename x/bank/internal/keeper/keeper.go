@@ -21,23 +21,32 @@ type Keeper interface {
 
 	DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 	UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
+
+	EscrowKeeper
+	HTLCKeeper
+	VestingKeeper
+	HolderKeeper
+	ScheduleKeeper
 }
 
 // BaseKeeper manages transfers between accounts. It implements the Keeper interface.
 type BaseKeeper struct {
 	BaseSendKeeper
 	ak         types.AccountKeeper
+	sk         types.SupplyKeeper
 	paramSpace params.Subspace
 }
 
 // NewBaseKeeper returns a new BaseKeeper
-func NewBaseKeeper(ak types.AccountKeeper, tk types.TokenKeeper,
+func NewBaseKeeper(ak types.AccountKeeper, tk types.TokenKeeper, storeKey sdk.StoreKey,
 	paramSpace params.Subspace,
 	codespace sdk.CodespaceType, blacklistedAddrs map[string]bool) BaseKeeper {
 
 	ps := paramSpace.WithKeyTable(types.ParamKeyTable())
+	sendKeeper := NewBaseSendKeeper(ak, tk, ps, codespace, blacklistedAddrs)
+	sendKeeper.storeKey = storeKey
 	return BaseKeeper{
-		BaseSendKeeper: NewBaseSendKeeper(ak, tk, ps, codespace, blacklistedAddrs),
+		BaseSendKeeper: sendKeeper,
 		ak:             ak,
 		paramSpace:     ps,
 	}
@@ -64,6 +73,10 @@ func (keeper BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAcc
 		return sdk.ErrInvalidCoins(amt.String())
 	}
 
+	if err := keeper.checkFrozen(ctx, delegatorAddr, amt); err != nil {
+		return err
+	}
+
 	oldCoins := delegatorAcc.GetCoins()
 
 	_, hasNeg := oldCoins.SafeSub(amt)
@@ -78,6 +91,7 @@ func (keeper BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAcc
 	}
 
 	keeper.ak.SetAccount(ctx, delegatorAcc)
+	keeper.updateHolders(ctx, delegatorAddr, oldCoins, delegatorAcc.GetCoins())
 
 	_, err := keeper.AddCoins(ctx, moduleAccAddr, amt)
 	if err != nil {
@@ -122,11 +136,13 @@ func (keeper BaseKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegat
 		return err
 	}
 
+	delegatorCoins := delegatorAcc.GetCoins()
 	if err := trackUndelegation(delegatorAcc, amt); err != nil {
 		return sdk.ErrInternal(fmt.Sprintf("failed to track undelegation: %v", err))
 	}
 
 	keeper.ak.SetAccount(ctx, delegatorAcc)
+	keeper.updateHolders(ctx, delegatorAddr, delegatorCoins, delegatorAcc.GetCoins())
 	return nil
 }
 
@@ -135,6 +151,11 @@ func (keeper *BaseKeeper) SetTokenKeeper(tokenKeeper types.TokenKeeper) {
 	keeper.tk = tokenKeeper
 }
 
+// SetSupplyKeeper set the supplyKeeper, which holds the escrow module account
+func (keeper *BaseKeeper) SetSupplyKeeper(supplyKeeper types.SupplyKeeper) {
+	keeper.sk = supplyKeeper
+}
+
 // SendKeeper defines a module interface that facilitates the transfer of coins
 // between accounts without the possibility of creating coins.
 type SendKeeper interface {
@@ -142,7 +163,6 @@ type SendKeeper interface {
 
 	InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) sdk.Error
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
-	EscrowCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 
 	SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error)
 	AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Error)
@@ -166,6 +186,9 @@ type BaseSendKeeper struct {
 	tk         types.TokenKeeper
 	paramSpace params.Subspace
 
+	// store of the holder index, which is not maintained without it
+	storeKey sdk.StoreKey
+
 	// list of addresses that are restricted from receiving transactions
 	blacklistedAddrs map[string]bool
 }
@@ -191,6 +214,12 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 		return err
 	}
 
+	for _, in := range inputs {
+		if err := keeper.checkFrozen(ctx, in.Address, in.Coins); err != nil {
+			return err
+		}
+	}
+
 	for _, in := range inputs {
 		_, err := keeper.SubtractCoins(ctx, in.Address, in.Coins)
 		if err != nil {
@@ -225,6 +254,10 @@ func (keeper BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.In
 
 // SendCoins moves coins from one account to another
 func (keeper BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if err := keeper.checkFrozen(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+
 	_, err := keeper.SubtractCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
@@ -315,12 +348,14 @@ func (keeper BaseSendKeeper) SetCoins(ctx sdk.Context, addr sdk.AccAddress, amt
 		acc = keeper.ak.NewAccountWithAddress(ctx, addr)
 	}
 
+	oldCoins := acc.GetCoins()
 	err := acc.SetCoins(amt)
 	if err != nil {
 		panic(err)
 	}
 
 	keeper.ak.SetAccount(ctx, acc)
+	keeper.updateHolders(ctx, addr, oldCoins, amt)
 	return nil
 }
 
@@ -357,31 +392,19 @@ func (keeper BaseSendKeeper) IsCoinsSendEnabled(ctx sdk.Context, coins sdk.Coins
 	return true
 }
 
-// EscrowCoins escrow coins from one account to another
-func (keeper BaseSendKeeper) EscrowCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) sdk.Error {
-	_, err := keeper.SubtractCoins(ctx, fromAddr, amt)
-	if err != nil {
-		return err
+// checkFrozen returns an error if addr's balance of any coin in amt is frozen by the token's issuer.
+// Keepers built without a tokenKeeper skip the check, and so do the blacklisted module accounts,
+// which are never frozen so that the refunds of the coins they lock can not fail.
+func (keeper BaseSendKeeper) checkFrozen(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) sdk.Error {
+	if keeper.tk == nil || keeper.BlacklistedAddr(addr) {
+		return nil
 	}
 
-	_, err = keeper.AddCoins(ctx, toAddr, amt)
-	if err != nil {
-		return err
+	for _, coin := range amt {
+		if keeper.tk.IsAccountFrozen(ctx, sdk.Symbol(coin.Denom), addr) {
+			return types.ErrAccountFrozen(keeper.codespace, addr, coin.Denom)
+		}
 	}
-
-	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeEscrow,
-			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
-			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
-			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
-		),
-		sdk.NewEvent(
-			sdk.EventTypeMessage,
-			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
-		),
-	})
-
 	return nil
 }
 
@@ -394,6 +417,7 @@ type ViewKeeper interface {
 	HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) bool
 
 	Codespace() sdk.CodespaceType
+	Logger(ctx sdk.Context) log.Logger
 }
 
 // BaseViewKeeper implements a read only keeper implementation of ViewKeeper.
@@ -331,3 +331,439 @@ func TestUndelegateCoins(t *testing.T) {
 	require.Equal(t, origCoins, vacc.GetCoins())
 	require.True(t, macc.GetCoins().Empty())
 }
+
+func TestFrozenAccountSend(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+
+	tk := mockTokenKeeper{frozen: map[string]bool{"foocoin" + addr.String(): true}}
+	sendKeeper := NewBaseSendKeeper(input.ak, tk, input.pk.Subspace("newspace"), types.DefaultCodespace, make(map[string]bool))
+
+	input.k.SetCoins(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10), sdk.NewInt64Coin("barcoin", 10)))
+
+	// frozen token can not be sent by any path
+	err := sendKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5)))
+	require.Equal(t, types.CodeAccountFrozen, err.Code())
+
+	escrowKeeper := input.k.(BaseKeeper)
+	escrowKeeper.SetTokenKeeper(tk)
+	_, err = escrowKeeper.CreateEscrow(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5)), 0, time.Time{})
+	require.Equal(t, types.CodeAccountFrozen, err.Code())
+
+	inputs := []types.Input{types.NewInput(addr, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5), sdk.NewInt64Coin("foocoin", 5)))}
+	outputs := []types.Output{types.NewOutput(addr2, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5), sdk.NewInt64Coin("foocoin", 5)))}
+	err = sendKeeper.InputOutputCoins(ctx, inputs, outputs)
+	require.Equal(t, types.CodeAccountFrozen, err.Code())
+
+	require.True(t, sendKeeper.GetCoins(ctx, addr).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("barcoin", 10), sdk.NewInt64Coin("foocoin", 10))))
+	require.True(t, sendKeeper.GetCoins(ctx, addr2).Empty())
+
+	// other tokens of the frozen account can still be sent
+	err = sendKeeper.SendCoins(ctx, addr, addr2, sdk.NewCoins(sdk.NewInt64Coin("barcoin", 5)))
+	require.Nil(t, err)
+
+	// frozen account can still receive the token
+	input.k.SetCoins(ctx, addr2, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10)))
+	err = sendKeeper.SendCoins(ctx, addr2, addr, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 5)))
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(15), sendKeeper.GetCoins(ctx, addr).AmountOf("foocoin"))
+}
+
+func TestEscrow(t *testing.T) {
+	input := setupTestInput()
+	now := tmtime.Now()
+	ctx := input.ctx.WithBlockHeader(abci.Header{Height: 10, Time: now})
+
+	payer := sdk.AccAddress([]byte("payer"))
+	payee := sdk.AccAddress([]byte("payee"))
+	other := sdk.AccAddress([]byte("other"))
+	amt := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+
+	input.k.SetCoins(ctx, payer, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100)))
+
+	// insufficient funds
+	_, err := input.k.CreateEscrow(ctx, payer, payee, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 101)), 0, time.Time{})
+	require.NotNil(t, err)
+	require.Equal(t, types.DefaultStartingEscrowID, input.k.GetNextEscrowID(ctx))
+
+	id1, err := input.k.CreateEscrow(ctx, payer, payee, amt, 20, time.Time{})
+	require.Nil(t, err)
+	id2, err := input.k.CreateEscrow(ctx, payer, other, amt, 0, now.Add(time.Hour))
+	require.Nil(t, err)
+	id3, err := input.k.CreateEscrow(ctx, payer, payee, amt, 0, time.Time{})
+	require.Nil(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, []uint64{id1, id2, id3})
+	require.Equal(t, uint64(4), input.k.GetNextEscrowID(ctx))
+
+	require.Equal(t, sdk.NewInt(70), input.k.GetCoins(ctx, payer).AmountOf("foocoin"))
+	require.Equal(t, sdk.NewInt(30), input.k.GetCoins(ctx, input.k.GetEscrowAddress()).AmountOf("foocoin"))
+
+	escrow, found := input.k.GetEscrow(ctx, id1)
+	require.True(t, found)
+	require.Equal(t, types.NewEscrow(id1, payer, payee, amt, 20, time.Time{}), escrow)
+	_, found = input.k.GetEscrow(ctx, 4)
+	require.False(t, found)
+
+	require.Len(t, input.k.GetEscrows(ctx), 3)
+	require.Len(t, input.k.GetEscrowsByPayer(ctx, payer), 3)
+	require.Len(t, input.k.GetEscrowsByPayee(ctx, payee), 2)
+	require.Len(t, input.k.GetEscrowsByPayee(ctx, other), 1)
+	require.Len(t, input.k.GetEscrowsByPayer(ctx, payee), 0)
+
+	// refund after the reclaim window of the expiry by height and by time
+	refundHeight := 20 + types.EscrowReclaimBlocks
+	refundTime := now.Add(time.Hour + types.EscrowReclaimPeriod)
+	require.Len(t, input.k.GetEscrowsDueForRefund(ctx), 0)
+	require.Len(t, input.k.GetEscrowsDueForRefund(ctx.WithBlockHeight(20).WithBlockTime(now.Add(time.Hour))), 0)
+	require.Len(t, input.k.GetEscrowsDueForRefund(ctx.WithBlockHeight(refundHeight-1).WithBlockTime(refundTime.Add(-time.Second))), 0)
+	require.Equal(t, types.Escrows{escrow}, input.k.GetEscrowsDueForRefund(ctx.WithBlockHeight(refundHeight)))
+	require.Len(t, input.k.GetEscrowsDueForRefund(ctx.WithBlockHeight(refundHeight).WithBlockTime(refundTime)), 2)
+
+	_, broken := EscrowBalanceInvariant(input.k)(ctx)
+	require.False(t, broken)
+	_, broken = EscrowIndexInvariant(input.k)(ctx)
+	require.False(t, broken)
+
+	// release pays the payee, refund returns the coins to the payer
+	require.Nil(t, input.k.ReleaseEscrow(ctx, escrow))
+	require.Equal(t, sdk.NewInt(10), input.k.GetCoins(ctx, payee).AmountOf("foocoin"))
+
+	escrow, _ = input.k.GetEscrow(ctx, id2)
+	require.Nil(t, input.k.RefundEscrow(ctx, escrow))
+	require.Equal(t, sdk.NewInt(80), input.k.GetCoins(ctx, payer).AmountOf("foocoin"))
+
+	_, found = input.k.GetEscrow(ctx, id1)
+	require.False(t, found)
+	require.Len(t, input.k.GetEscrowsByPayer(ctx, payer), 1)
+	require.Len(t, input.k.GetEscrowsDueForRefund(ctx.WithBlockHeight(refundHeight).WithBlockTime(refundTime)), 0)
+	require.Equal(t, sdk.NewInt(10), input.k.GetCoins(ctx, input.k.GetEscrowAddress()).AmountOf("foocoin"))
+
+	_, broken = EscrowBalanceInvariant(input.k)(ctx)
+	require.False(t, broken)
+
+	// coins leaving the escrow module account without a refund break the invariant
+	input.k.SetCoins(ctx, input.k.GetEscrowAddress(), sdk.NewCoins())
+	_, broken = EscrowBalanceInvariant(input.k)(ctx)
+	require.True(t, broken)
+}
+
+func TestHTLC(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(10)
+
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	amt := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+	hashLock1 := types.GetHashLock([]byte("secret1"))
+	hashLock2 := types.GetHashLock([]byte("secret2"))
+
+	input.k.SetCoins(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100)))
+
+	// insufficient funds
+	_, err := input.k.CreateHTLC(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 101)), hashLock1, 20)
+	require.NotNil(t, err)
+	_, found := input.k.GetHTLC(ctx, types.GetHTLCID(sender, recipient, hashLock1))
+	require.False(t, found)
+
+	id1, err := input.k.CreateHTLC(ctx, sender, recipient, amt, hashLock1, 20)
+	require.Nil(t, err)
+	require.Equal(t, types.GetHTLCID(sender, recipient, hashLock1), id1)
+	id2, err := input.k.CreateHTLC(ctx, sender, recipient, amt, hashLock2, 30)
+	require.Nil(t, err)
+
+	// a hash lock can only be used once between the same sender and recipient
+	_, err = input.k.CreateHTLC(ctx, sender, recipient, amt, hashLock1, 20)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeDuplicateHTLC, err.Code())
+
+	// but the hash lock of another sender does not block the swap
+	other := sdk.AccAddress([]byte("other"))
+	input.k.SetCoins(ctx, other, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1)))
+	otherID, err := input.k.CreateHTLC(ctx, other, recipient, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 1)), hashLock1, 20)
+	require.Nil(t, err)
+	require.NotEqual(t, id1, otherID)
+	otherHTLC, _ := input.k.GetHTLC(ctx, otherID)
+	require.Nil(t, input.k.RefundHTLC(ctx, otherHTLC))
+
+	require.Equal(t, sdk.NewInt(80), input.k.GetCoins(ctx, sender).AmountOf("foocoin"))
+	require.Equal(t, sdk.NewInt(20), input.k.GetCoins(ctx, input.k.GetHTLCAddress()).AmountOf("foocoin"))
+
+	htlc, found := input.k.GetHTLC(ctx, id1)
+	require.True(t, found)
+	require.Equal(t, types.NewHTLC(hashLock1, sender, recipient, amt, 20), htlc)
+	require.Len(t, input.k.GetHTLCs(ctx), 2)
+
+	_, broken := HTLCBalanceInvariant(input.k)(ctx)
+	require.False(t, broken)
+
+	// claim pays the recipient, refund returns the coins to the sender
+	require.Nil(t, input.k.ClaimHTLC(ctx, htlc))
+	require.Equal(t, sdk.NewInt(10), input.k.GetCoins(ctx, recipient).AmountOf("foocoin"))
+
+	htlc, _ = input.k.GetHTLC(ctx, id2)
+	require.Nil(t, input.k.RefundHTLC(ctx, htlc))
+	require.Equal(t, sdk.NewInt(90), input.k.GetCoins(ctx, sender).AmountOf("foocoin"))
+
+	require.Len(t, input.k.GetHTLCs(ctx), 0)
+	require.True(t, input.k.GetCoins(ctx, input.k.GetHTLCAddress()).IsZero())
+
+	_, broken = HTLCBalanceInvariant(input.k)(ctx)
+	require.False(t, broken)
+
+	// coins sent to the htlc module account without a lock break the invariant
+	input.k.SetCoins(ctx, input.k.GetHTLCAddress(), amt)
+	_, broken = HTLCBalanceInvariant(input.k)(ctx)
+	require.True(t, broken)
+}
+
+func TestScheduledTransfer(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx.WithBlockHeight(10)
+
+	sender := sdk.AccAddress([]byte("sender"))
+	recipient := sdk.AccAddress([]byte("recipient"))
+	amt := sdk.NewCoins(sdk.NewInt64Coin("foocoin", 10))
+
+	k := input.k.(BaseKeeper)
+	k.SetTokenKeeper(mockTokenKeeper{})
+	k.SetCoins(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("foocoin", 100)))
+
+	// the coins of all the payments are locked at once
+	_, err := k.CreateScheduledTransfer(ctx, sender, recipient, amt, 5, 11, 15)
+	require.NotNil(t, err)
+	require.Equal(t, types.DefaultStartingScheduledTransferID, k.GetNextScheduledTransferID(ctx))
+
+	id1, err := k.CreateScheduledTransfer(ctx, sender, recipient, amt, 5, 3, 15)
+	require.Nil(t, err)
+	id2, err := k.CreateScheduledTransfer(ctx, sender, recipient, amt, 10, 2, 20)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{1, 2}, []uint64{id1, id2})
+
+	require.Equal(t, sdk.NewInt(50), k.GetCoins(ctx, sender).AmountOf("foocoin"))
+	require.Equal(t, sdk.NewInt(50), k.GetCoins(ctx, k.GetScheduleAddress()).AmountOf("foocoin"))
+
+	transfer, found := k.GetScheduledTransfer(ctx, id1)
+	require.True(t, found)
+	require.Equal(t, types.NewScheduledTransfer(id1, sender, recipient, amt, 5, 3, 15), transfer)
+	require.Len(t, k.GetScheduledTransfersBySender(ctx, sender), 2)
+	require.Len(t, k.GetScheduledTransfersBySender(ctx, recipient), 0)
+
+	require.Len(t, k.GetDueScheduledTransfers(ctx, 0), 0)
+	require.Equal(t, types.ScheduledTransfers{transfer}, k.GetDueScheduledTransfers(ctx.WithBlockHeight(15), 0))
+	require.Len(t, k.GetDueScheduledTransfers(ctx.WithBlockHeight(20), 0), 2)
+	require.Equal(t, types.ScheduledTransfers{transfer}, k.GetDueScheduledTransfers(ctx.WithBlockHeight(20), 1))
+
+	_, broken := ScheduleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// a payment moves the transfer to its next height
+	require.Nil(t, k.ExecuteScheduledTransfer(ctx.WithBlockHeight(15), transfer))
+	require.Equal(t, sdk.NewInt(10), k.GetCoins(ctx, recipient).AmountOf("foocoin"))
+	transfer, _ = k.GetScheduledTransfer(ctx, id1)
+	require.Equal(t, int64(20), transfer.NextHeight)
+	require.Equal(t, uint64(2), transfer.Remaining)
+	require.Equal(t, uint64(1), transfer.Executed)
+	require.Len(t, k.GetDueScheduledTransfers(ctx.WithBlockHeight(19), 0), 0)
+
+	_, broken = ScheduleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// a failed payment is recorded and retried at the next interval
+	k.SetTokenKeeper(mockTokenKeeper{frozen: map[string]bool{"foocoin" + sender.String(): true}})
+	err = k.ExecuteScheduledTransfer(ctx.WithBlockHeight(20), transfer)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeAccountFrozen, err.Code())
+	require.Equal(t, sdk.NewInt(10), k.GetCoins(ctx, recipient).AmountOf("foocoin"))
+
+	transfer, _ = k.GetScheduledTransfer(ctx, id1)
+	require.Equal(t, int64(25), transfer.NextHeight)
+	require.Equal(t, uint64(2), transfer.Remaining)
+	require.Equal(t, uint64(1), transfer.Failures)
+	require.Equal(t, uint64(1), transfer.ConsecutiveFailures)
+	require.Equal(t, int64(20), transfer.LastFailureHeight)
+	require.NotEmpty(t, transfer.LastFailure)
+
+	// the transfer is removed after its last payment
+	k.SetTokenKeeper(mockTokenKeeper{})
+	require.Nil(t, k.ExecuteScheduledTransfer(ctx.WithBlockHeight(25), transfer))
+	transfer, _ = k.GetScheduledTransfer(ctx, id1)
+	require.Equal(t, uint64(1), transfer.Failures)
+	require.Equal(t, uint64(0), transfer.ConsecutiveFailures)
+	require.Nil(t, k.ExecuteScheduledTransfer(ctx.WithBlockHeight(30), transfer))
+	_, found = k.GetScheduledTransfer(ctx, id1)
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(30), k.GetCoins(ctx, recipient).AmountOf("foocoin"))
+
+	// cancel returns the coins of the remaining payments to the sender
+	transfer, _ = k.GetScheduledTransfer(ctx, id2)
+	require.Nil(t, k.CancelScheduledTransfer(ctx, transfer))
+	require.Equal(t, sdk.NewInt(70), k.GetCoins(ctx, sender).AmountOf("foocoin"))
+	require.Len(t, k.GetScheduledTransfers(ctx), 0)
+	require.Len(t, k.GetDueScheduledTransfers(ctx.WithBlockHeight(100), 0), 0)
+
+	// a transfer failing too many payments in a row is cancelled
+	id3, err := k.CreateScheduledTransfer(ctx, sender, recipient, amt, 5, 2, 15)
+	require.Nil(t, err)
+	k.SetTokenKeeper(mockTokenKeeper{frozen: map[string]bool{"foocoin" + sender.String(): true}})
+	for i := uint64(1); i <= types.MaxScheduleFailures; i++ {
+		transfer, found = k.GetScheduledTransfer(ctx, id3)
+		require.True(t, found)
+		require.NotNil(t, k.ExecuteScheduledTransfer(ctx.WithBlockHeight(transfer.NextHeight), transfer))
+	}
+	_, found = k.GetScheduledTransfer(ctx, id3)
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(70), k.GetCoins(ctx, sender).AmountOf("foocoin"))
+	k.SetTokenKeeper(mockTokenKeeper{})
+	require.True(t, k.GetCoins(ctx, k.GetScheduleAddress()).IsZero())
+
+	_, broken = ScheduleBalanceInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// a cancellation failing to refund the sender is recorded and retried at the next interval
+	id4, err := k.CreateScheduledTransfer(ctx, sender, recipient, amt, 5, 2, 15)
+	require.Nil(t, err)
+	k.SetTokenKeeper(mockTokenKeeper{frozen: map[string]bool{"foocoin" + sender.String(): true}})
+	for i := uint64(1); i < types.MaxScheduleFailures; i++ {
+		transfer, _ = k.GetScheduledTransfer(ctx, id4)
+		require.NotNil(t, k.ExecuteScheduledTransfer(ctx.WithBlockHeight(transfer.NextHeight), transfer))
+	}
+	k.SetCoins(ctx, k.GetScheduleAddress(), sdk.Coins{})
+	transfer, _ = k.GetScheduledTransfer(ctx, id4)
+	require.NotNil(t, k.ExecuteScheduledTransfer(ctx.WithBlockHeight(transfer.NextHeight), transfer))
+	transfer, found = k.GetScheduledTransfer(ctx, id4)
+	require.True(t, found)
+	require.Equal(t, types.MaxScheduleFailures, transfer.ConsecutiveFailures)
+	require.Contains(t, transfer.LastFailure, "insufficient")
+	require.Equal(t, types.ScheduledTransfers{transfer}, k.GetDueScheduledTransfers(ctx.WithBlockHeight(transfer.NextHeight), 10))
+
+	k.SetCoins(ctx, k.GetScheduleAddress(), transfer.Locked())
+	require.NotNil(t, k.ExecuteScheduledTransfer(ctx.WithBlockHeight(transfer.NextHeight), transfer))
+	_, found = k.GetScheduledTransfer(ctx, id4)
+	require.False(t, found)
+	require.Equal(t, sdk.NewInt(70), k.GetCoins(ctx, sender).AmountOf("foocoin"))
+	k.SetTokenKeeper(mockTokenKeeper{})
+
+	// coins sent to the schedule module account without a transfer break the invariant
+	k.SetCoins(ctx, k.GetScheduleAddress(), amt)
+	_, broken = ScheduleBalanceInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestCreateVestingAccount(t *testing.T) {
+	input := setupTestInput()
+	now := tmtime.Now()
+	ctx := input.ctx.WithBlockHeader(abci.Header{Time: now})
+	endTime := now.Add(24 * time.Hour)
+
+	sender := sdk.AccAddress([]byte("sender"))
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	amt := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	input.k.SetCoins(ctx, sender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+
+	// continuous vesting account
+	require.Nil(t, input.k.CreateVestingAccount(ctx, sender, addr1, amt, now.Unix(), endTime.Unix(), nil))
+	cva, ok := input.ak.GetAccount(ctx, addr1).(*auth.ContinuousVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, amt, cva.GetOriginalVesting())
+	require.Equal(t, amt, cva.GetCoins())
+	require.Equal(t, sdk.NewInt(900), input.k.GetCoins(ctx, sender).AmountOf("stake"))
+
+	// the account already exists
+	err := input.k.CreateVestingAccount(ctx, sender, addr1, amt, now.Unix(), endTime.Unix(), nil)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeAccountExists, err.Code())
+
+	// delayed vesting account
+	require.Nil(t, input.k.CreateVestingAccount(ctx, sender, addr2, amt, 0, endTime.Unix(), nil))
+	_, ok = input.ak.GetAccount(ctx, addr2).(*auth.DelayedVestingAccount)
+	require.True(t, ok)
+
+	// periodic vesting account with a 12 hour cliff
+	periods := auth.Periods{
+		{Length: int64(12 * 60 * 60), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))},
+		{Length: int64(12 * 60 * 60), Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 40))},
+	}
+	require.Nil(t, input.k.CreateVestingAccount(ctx, sender, addr3, amt, now.Unix(), 0, periods))
+	pva, ok := input.ak.GetAccount(ctx, addr3).(*auth.PeriodicVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, endTime.Unix(), pva.GetEndTime())
+	require.Equal(t, sdk.NewInt(700), input.k.GetCoins(ctx, sender).AmountOf("stake"))
+
+	// nothing is spendable before the cliff
+	require.NotNil(t, input.k.SendCoins(ctx, addr3, sender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+	ctx = ctx.WithBlockTime(now.Add(12 * time.Hour))
+	require.Nil(t, input.k.SendCoins(ctx, addr3, sender, sdk.NewCoins(sdk.NewInt64Coin("stake", 60))))
+	require.NotNil(t, input.k.SendCoins(ctx, addr3, sender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+
+	// insufficient funds leaves no account behind
+	addr4 := sdk.AccAddress([]byte("addr4"))
+	err = input.k.CreateVestingAccount(ctx, sender, addr4, sdk.NewCoins(sdk.NewInt64Coin("stake", 10000)), 0, endTime.Unix(), nil)
+	require.NotNil(t, err)
+}
+
+func TestHolderIndex(t *testing.T) {
+	input := setupTestInput()
+	ctx := input.ctx
+	invariant := HolderIndexInvariant(input.k, input.ak)
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+
+	input.k.SetCoins(ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("foo", 10), sdk.NewInt64Coin("bar", 5)))
+	input.k.SetCoins(ctx, addr2, sdk.NewCoins(sdk.NewInt64Coin("foo", 30)))
+	input.k.SetCoins(ctx, addr3, sdk.NewCoins(sdk.NewInt64Coin("foo", 20)))
+
+	require.Equal(t, uint64(3), input.k.GetHolderCount(ctx, "foo"))
+	require.Equal(t, uint64(1), input.k.GetHolderCount(ctx, "bar"))
+	require.Equal(t, types.Holders{
+		types.NewHolder(addr2, sdk.NewInt(30)),
+		types.NewHolder(addr3, sdk.NewInt(20)),
+		types.NewHolder(addr1, sdk.NewInt(10)),
+	}, input.k.GetHolders(ctx, "foo", 1, 10))
+	require.Equal(t, types.Holders{types.NewHolder(addr3, sdk.NewInt(20))}, input.k.GetHolders(ctx, "foo", 2, 1))
+	require.Empty(t, input.k.GetHolders(ctx, "foo", 2, 10))
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// a send moves both holders and drops the emptied one
+	require.NoError(t, input.k.SendCoins(ctx, addr1, addr3, sdk.NewCoins(sdk.NewInt64Coin("foo", 10), sdk.NewInt64Coin("bar", 5))))
+	require.Equal(t, uint64(2), input.k.GetHolderCount(ctx, "foo"))
+	require.Equal(t, uint64(1), input.k.GetHolderCount(ctx, "bar"))
+	require.True(t, input.k.GetHolderBalance(ctx, "foo", addr1).IsZero())
+	require.Equal(t, sdk.NewInt(30), input.k.GetHolderBalance(ctx, "foo", addr3))
+	require.Equal(t, sdk.NewInt(5), input.k.GetHolderBalance(ctx, "bar", addr3))
+	require.Len(t, input.k.GetHolders(ctx, "foo", 1, 10), 2)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// delegations move the delegator's balance too
+	addrModule := sdk.AccAddress([]byte("moduleAcc"))
+	input.ak.SetAccount(ctx, input.ak.NewAccountWithAddress(ctx, addrModule))
+	require.NoError(t, input.k.DelegateCoins(ctx, addr2, addrModule, sdk.NewCoins(sdk.NewInt64Coin("foo", 30))))
+	require.True(t, input.k.GetHolderBalance(ctx, "foo", addr2).IsZero())
+	require.Equal(t, sdk.NewInt(30), input.k.GetHolderBalance(ctx, "foo", addrModule))
+	require.NoError(t, input.k.UndelegateCoins(ctx, addrModule, addr2, sdk.NewCoins(sdk.NewInt64Coin("foo", 12))))
+	require.Equal(t, sdk.NewInt(12), input.k.GetHolderBalance(ctx, "foo", addr2))
+	require.Equal(t, sdk.NewInt(18), input.k.GetHolderBalance(ctx, "foo", addrModule))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// coins set without the bank keeper break the index until it is rebuilt
+	acc := input.ak.GetAccount(ctx, addr1)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("baz", 7))))
+	input.ak.SetAccount(ctx, acc)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	input.k.RebuildHolderIndex(ctx)
+	require.Equal(t, uint64(1), input.k.GetHolderCount(ctx, "baz"))
+	require.Equal(t, uint64(3), input.k.GetHolderCount(ctx, "foo"))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
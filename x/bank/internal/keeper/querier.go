@@ -22,6 +22,33 @@ func NewQuerier(k Keeper) sdk.Querier {
 		case QueryBalance:
 			return queryBalance(ctx, req, k)
 
+		case types.QueryEscrow:
+			return queryEscrow(ctx, req, k)
+
+		case types.QueryEscrowsByPayer:
+			return queryEscrowsByPayer(ctx, req, k)
+
+		case types.QueryEscrowsByPayee:
+			return queryEscrowsByPayee(ctx, req, k)
+
+		case types.QueryHTLC:
+			return queryHTLC(ctx, req, k)
+
+		case types.QueryHTLCs:
+			return queryHTLCs(ctx, k)
+
+		case types.QueryHolders:
+			return queryHolders(ctx, req, k)
+
+		case types.QueryHolder:
+			return queryHolder(ctx, req, k)
+
+		case types.QueryScheduledTransfer:
+			return queryScheduledTransfer(ctx, req, k)
+
+		case types.QueryScheduledTransfersBySender:
+			return queryScheduledTransfersBySender(ctx, req, k)
+
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown bank query endpoint")
 		}
@@ -44,3 +71,171 @@ func queryBalance(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk
 
 	return bz, nil
 }
+
+// queryEscrow fetch an escrow by its ID.
+func queryEscrow(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryEscrowParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	escrow, found := k.GetEscrow(ctx, params.EscrowID)
+	if !found {
+		return nil, types.ErrUnknownEscrow(k.Codespace(), params.EscrowID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, escrow)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryEscrowsByPayer fetch the escrows paid by an address.
+func queryEscrowsByPayer(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryEscrowsParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetEscrowsByPayer(ctx, params.Address))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryEscrowsByPayee fetch the escrows payable to an address.
+func queryEscrowsByPayee(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryEscrowsParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetEscrowsByPayee(ctx, params.Address))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryHTLC fetch an HTLC by its ID.
+func queryHTLC(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryHTLCParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	htlc, found := k.GetHTLC(ctx, params.ID)
+	if !found {
+		return nil, types.ErrUnknownHTLC(k.Codespace(), params.ID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, htlc)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryHTLCs fetch all the HTLCs.
+func queryHTLCs(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetHTLCs(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryHolders fetch the holder count and a page of the holders of a denom sorted by balance.
+func queryHolders(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryHoldersParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	if params.Page == 0 {
+		params.Page = 1
+	}
+	if params.Limit == 0 {
+		params.Limit = types.DefaultHoldersLimit
+	}
+
+	page := types.HoldersPage{
+		Denom:   params.Denom,
+		Count:   k.GetHolderCount(ctx, params.Denom),
+		Holders: k.GetHolders(ctx, params.Denom, params.Page, params.Limit),
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, page)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryHolder fetch the balance of a denom held by an address according to the holder index.
+func queryHolder(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryHolderParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	holder := types.NewHolder(params.Address, k.GetHolderBalance(ctx, params.Denom, params.Address))
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, holder)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryScheduledTransfer fetch a scheduled transfer by its ID.
+func queryScheduledTransfer(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryScheduledTransferParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	transfer, found := k.GetScheduledTransfer(ctx, params.TransferID)
+	if !found {
+		return nil, types.ErrUnknownScheduledTransfer(k.Codespace(), params.TransferID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, transfer)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// queryScheduledTransfersBySender fetch the scheduled transfers paid by an address.
+func queryScheduledTransfersBySender(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryScheduledTransfersParams
+
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetScheduledTransfersBySender(ctx, params.Address))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -57,3 +58,153 @@ func TestQuerierRouteNotFound(t *testing.T) {
 	_, err := querier(input.ctx, []string{"notfound"}, req)
 	require.Error(t, err)
 }
+
+func TestQueryEscrows(t *testing.T) {
+	input := setupTestInput()
+	querier := NewQuerier(input.k)
+
+	_, _, payer := authtypes.KeyTestPubAddr()
+	_, _, payee := authtypes.KeyTestPubAddr()
+	escrow := types.NewEscrow(1, payer, payee, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), 0, time.Time{})
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/bank/%s", types.QueryEscrow),
+		Data: input.cdc.MustMarshalJSON(types.NewQueryEscrowParams(escrow.EscrowID)),
+	}
+	_, err := querier(input.ctx, []string{types.QueryEscrow}, req)
+	require.NotNil(t, err)
+
+	input.k.SetEscrow(input.ctx, escrow)
+	res, err := querier(input.ctx, []string{types.QueryEscrow}, req)
+	require.Nil(t, err)
+
+	var got types.Escrow
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &got))
+	require.Equal(t, escrow.String(), got.String())
+
+	var escrows types.Escrows
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryEscrowsParams(payer))
+	res, err = querier(input.ctx, []string{types.QueryEscrowsByPayer}, req)
+	require.Nil(t, err)
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &escrows))
+	require.Len(t, escrows, 1)
+
+	res, err = querier(input.ctx, []string{types.QueryEscrowsByPayee}, req)
+	require.Nil(t, err)
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &escrows))
+	require.Len(t, escrows, 0)
+
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryEscrowsParams(payee))
+	res, err = querier(input.ctx, []string{types.QueryEscrowsByPayee}, req)
+	require.Nil(t, err)
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &escrows))
+	require.Len(t, escrows, 1)
+}
+
+func TestQueryScheduledTransfers(t *testing.T) {
+	input := setupTestInput()
+	querier := NewQuerier(input.k)
+
+	_, _, sender := authtypes.KeyTestPubAddr()
+	_, _, recipient := authtypes.KeyTestPubAddr()
+	transfer := types.NewScheduledTransfer(1, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), 5, 3, 10)
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/bank/%s", types.QueryScheduledTransfer),
+		Data: input.cdc.MustMarshalJSON(types.NewQueryScheduledTransferParams(transfer.TransferID)),
+	}
+	_, err := querier(input.ctx, []string{types.QueryScheduledTransfer}, req)
+	require.NotNil(t, err)
+
+	input.k.SetScheduledTransfer(input.ctx, transfer)
+	res, err := querier(input.ctx, []string{types.QueryScheduledTransfer}, req)
+	require.Nil(t, err)
+
+	var got types.ScheduledTransfer
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &got))
+	require.Equal(t, transfer.String(), got.String())
+
+	var transfers types.ScheduledTransfers
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryScheduledTransfersParams(sender))
+	res, err = querier(input.ctx, []string{types.QueryScheduledTransfersBySender}, req)
+	require.Nil(t, err)
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &transfers))
+	require.Len(t, transfers, 1)
+
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryScheduledTransfersParams(recipient))
+	res, err = querier(input.ctx, []string{types.QueryScheduledTransfersBySender}, req)
+	require.Nil(t, err)
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &transfers))
+	require.Len(t, transfers, 0)
+}
+
+func TestQueryHTLCs(t *testing.T) {
+	input := setupTestInput()
+	querier := NewQuerier(input.k)
+
+	_, _, sender := authtypes.KeyTestPubAddr()
+	_, _, recipient := authtypes.KeyTestPubAddr()
+	htlc := types.NewHTLC(types.GetHashLock([]byte("secret")), sender, recipient, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)), 100)
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/bank/%s", types.QueryHTLC),
+		Data: input.cdc.MustMarshalJSON(types.NewQueryHTLCParams(htlc.ID)),
+	}
+	_, err := querier(input.ctx, []string{types.QueryHTLC}, req)
+	require.NotNil(t, err)
+
+	input.k.SetHTLC(input.ctx, htlc)
+	res, err := querier(input.ctx, []string{types.QueryHTLC}, req)
+	require.Nil(t, err)
+
+	var got types.HTLC
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &got))
+	require.Equal(t, htlc, got)
+
+	var htlcs types.HTLCs
+	res, err = querier(input.ctx, []string{types.QueryHTLCs}, abci.RequestQuery{})
+	require.Nil(t, err)
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &htlcs))
+	require.Equal(t, types.HTLCs{htlc}, htlcs)
+}
+
+func TestQueryHolders(t *testing.T) {
+	input := setupTestInput()
+	querier := NewQuerier(input.k)
+
+	_, _, addr1 := authtypes.KeyTestPubAddr()
+	_, _, addr2 := authtypes.KeyTestPubAddr()
+	input.k.SetCoins(input.ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("foo", 10)))
+	input.k.SetCoins(input.ctx, addr2, sdk.NewCoins(sdk.NewInt64Coin("foo", 20)))
+
+	// the default page holds every holder
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("custom/bank/%s", types.QueryHolders),
+		Data: input.cdc.MustMarshalJSON(types.NewQueryHoldersParams("foo", 0, 0)),
+	}
+	res, err := querier(input.ctx, []string{types.QueryHolders}, req)
+	require.Nil(t, err)
+
+	var page types.HoldersPage
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &page))
+	require.Equal(t, uint64(2), page.Count)
+	require.Equal(t, types.Holders{types.NewHolder(addr2, sdk.NewInt(20)), types.NewHolder(addr1, sdk.NewInt(10))}, page.Holders)
+
+	req.Data = input.cdc.MustMarshalJSON(types.NewQueryHoldersParams("foo", 2, 1))
+	res, err = querier(input.ctx, []string{types.QueryHolders}, req)
+	require.Nil(t, err)
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &page))
+	require.Equal(t, uint64(2), page.Count)
+	require.Equal(t, types.Holders{types.NewHolder(addr1, sdk.NewInt(10))}, page.Holders)
+
+	req = abci.RequestQuery{
+		Path: fmt.Sprintf("custom/bank/%s", types.QueryHolder),
+		Data: input.cdc.MustMarshalJSON(types.NewQueryHolderParams("foo", addr1)),
+	}
+	res, err = querier(input.ctx, []string{types.QueryHolder}, req)
+	require.Nil(t, err)
+
+	var holder types.Holder
+	require.NoError(t, input.cdc.UnmarshalJSON(res, &holder))
+	require.Equal(t, types.NewHolder(addr1, sdk.NewInt(10)), holder)
+}
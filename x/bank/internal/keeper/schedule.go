@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/bank/internal/types"
+	supplyexported "github.com/pocblockchain/pocc/x/supply/exported"
+)
+
+// ScheduleKeeper defines a module interface that locks coins in the schedule module
+// account and pays them to the recipients of standing orders block after block.
+type ScheduleKeeper interface {
+	CreateScheduledTransfer(ctx sdk.Context, sender, recipient sdk.AccAddress, amt sdk.Coins,
+		interval int64, times uint64, startHeight int64) (uint64, sdk.Error)
+	ExecuteScheduledTransfer(ctx sdk.Context, transfer types.ScheduledTransfer) sdk.Error
+	CancelScheduledTransfer(ctx sdk.Context, transfer types.ScheduledTransfer) sdk.Error
+
+	GetScheduledTransfer(ctx sdk.Context, transferID uint64) (types.ScheduledTransfer, bool)
+	SetScheduledTransfer(ctx sdk.Context, transfer types.ScheduledTransfer)
+	GetScheduledTransfers(ctx sdk.Context) types.ScheduledTransfers
+	GetScheduledTransfersBySender(ctx sdk.Context, sender sdk.AccAddress) types.ScheduledTransfers
+	GetDueScheduledTransfers(ctx sdk.Context, limit int) types.ScheduledTransfers
+	IterateScheduledTransfers(ctx sdk.Context, cb func(transfer types.ScheduledTransfer) (stop bool))
+
+	GetNextScheduledTransferID(ctx sdk.Context) uint64
+	SetNextScheduledTransferID(ctx sdk.Context, transferID uint64)
+
+	GetScheduleAddress() sdk.AccAddress
+	GetScheduleAccount(ctx sdk.Context) supplyexported.ModuleAccountI
+	SetScheduleAccount(ctx sdk.Context, macc supplyexported.ModuleAccountI)
+}
+
+var _ ScheduleKeeper = (*BaseKeeper)(nil)
+
+// CreateScheduledTransfer locks the coins of all the payments of sender in the schedule
+// module account and returns the ID of the scheduled transfer
+func (keeper BaseKeeper) CreateScheduledTransfer(ctx sdk.Context, sender, recipient sdk.AccAddress, amt sdk.Coins,
+	interval int64, times uint64, startHeight int64) (uint64, sdk.Error) {
+
+	if err := keeper.checkFrozen(ctx, sender, amt); err != nil {
+		return 0, err
+	}
+
+	transferID := keeper.GetNextScheduledTransferID(ctx)
+	transfer := types.NewScheduledTransfer(transferID, sender, recipient, amt, interval, times, startHeight)
+	if err := keeper.supplyKeeper().SendCoinsFromAccountToModule(ctx, sender, types.ScheduleAccountName, transfer.Locked()); err != nil {
+		return 0, err
+	}
+
+	keeper.SetScheduledTransfer(ctx, transfer)
+	keeper.SetNextScheduledTransferID(ctx, transferID+1)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeScheduleTransfer,
+			sdk.NewAttribute(types.AttributeKeyTransferID, sdk.NewUint(transferID).String()),
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+			sdk.NewAttribute(types.AttributeKeyInterval, fmt.Sprintf("%d", interval)),
+			sdk.NewAttribute(types.AttributeKeyRemaining, fmt.Sprintf("%d", times)),
+			sdk.NewAttribute(types.AttributeKeyNextHeight, fmt.Sprintf("%d", startHeight)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, sender.String()),
+		),
+	})
+
+	return transferID, nil
+}
+
+// ExecuteScheduledTransfer makes the next payment of the scheduled transfer and removes it
+// after the last one. A payment failing the checks of a send is not made, the failure is
+// recorded and returned, and the payment is retried at the next interval. After
+// MaxScheduleFailures failures in a row the scheduled transfer is cancelled instead, a
+// cancellation failing to refund the sender is recorded and retried at the next interval.
+func (keeper BaseKeeper) ExecuteScheduledTransfer(ctx sdk.Context, transfer types.ScheduledTransfer) sdk.Error {
+	// the transfer is queued again at its next height
+	keeper.deleteScheduledTransfer(ctx, transfer)
+	transfer.NextHeight += transfer.Interval
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := keeper.payScheduledTransfer(cacheCtx, transfer)
+	if err != nil {
+		transfer.Failures++
+		transfer.ConsecutiveFailures++
+		transfer.LastFailureHeight = ctx.BlockHeight()
+		transfer.LastFailure = err.Result().Log
+		if transfer.ConsecutiveFailures < types.MaxScheduleFailures {
+			keeper.SetScheduledTransfer(ctx, transfer)
+			return err
+		}
+
+		// the schedule module account holds the coins of the remaining payments, a failed
+		// refund is recorded and the cancellation retried at the next interval
+		cancelCtx, writeCancel := ctx.CacheContext()
+		if cancelErr := keeper.CancelScheduledTransfer(cancelCtx, transfer); cancelErr != nil {
+			transfer.LastFailure = cancelErr.Result().Log
+			keeper.SetScheduledTransfer(ctx, transfer)
+			return cancelErr
+		}
+		writeCancel()
+		ctx.EventManager().EmitEvents(cancelCtx.EventManager().Events())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCancelScheduledTransfer,
+				sdk.NewAttribute(types.AttributeKeyTransferID, sdk.NewUint(transfer.TransferID).String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, transfer.Sender.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, transfer.Locked().String()),
+				sdk.NewAttribute(types.AttributeKeyReason, fmt.Sprintf("%d consecutive failures", transfer.ConsecutiveFailures)),
+			),
+		)
+		return err
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	transfer.ConsecutiveFailures = 0
+	transfer.Executed++
+	transfer.Remaining--
+	if transfer.Remaining > 0 {
+		keeper.SetScheduledTransfer(ctx, transfer)
+	}
+	return nil
+}
+
+// payScheduledTransfer pays a payment of the scheduled transfer from the schedule module account
+// after the checks of a send by the sender
+func (keeper BaseKeeper) payScheduledTransfer(ctx sdk.Context, transfer types.ScheduledTransfer) sdk.Error {
+	if !keeper.GetSendEnabled(ctx) || !keeper.IsCoinsSendEnabled(ctx, transfer.Amount) {
+		return types.ErrSendDisabled(keeper.Codespace())
+	}
+
+	if keeper.BlacklistedAddr(transfer.Recipient) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", transfer.Recipient))
+	}
+
+	if err := keeper.checkFrozen(ctx, transfer.Sender, transfer.Amount); err != nil {
+		return err
+	}
+
+	return keeper.SendCoins(ctx, keeper.GetScheduleAddress(), transfer.Recipient, transfer.Amount)
+}
+
+// CancelScheduledTransfer returns the coins of the remaining payments to the sender and removes the scheduled transfer
+func (keeper BaseKeeper) CancelScheduledTransfer(ctx sdk.Context, transfer types.ScheduledTransfer) sdk.Error {
+	if err := keeper.supplyKeeper().SendCoinsFromModuleToAccount(ctx, types.ScheduleAccountName, transfer.Sender, transfer.Locked()); err != nil {
+		return err
+	}
+
+	keeper.deleteScheduledTransfer(ctx, transfer)
+	return nil
+}
+
+// GetScheduledTransfer returns the scheduled transfer of transferID
+func (keeper BaseKeeper) GetScheduledTransfer(ctx sdk.Context, transferID uint64) (types.ScheduledTransfer, bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ScheduledTransferKey(transferID))
+	if bz == nil {
+		return types.ScheduledTransfer{}, false
+	}
+
+	var transfer types.ScheduledTransfer
+	types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(bz, &transfer)
+	return transfer, true
+}
+
+// SetScheduledTransfer stores the scheduled transfer together with its sender index and queues it at its next height
+func (keeper BaseKeeper) SetScheduledTransfer(ctx sdk.Context, transfer types.ScheduledTransfer) {
+	store := ctx.KVStore(keeper.storeKey)
+	idBz := sdk.Uint64ToBigEndian(transfer.TransferID)
+
+	store.Set(types.ScheduledTransferKey(transfer.TransferID), types.ModuleCdc.MustMarshalBinaryLengthPrefixed(transfer))
+	store.Set(types.ScheduledTransferBySenderKey(transfer.Sender, transfer.TransferID), idBz)
+	store.Set(types.ScheduledTransferQueueKey(transfer.TransferID, transfer.NextHeight), idBz)
+}
+
+func (keeper BaseKeeper) deleteScheduledTransfer(ctx sdk.Context, transfer types.ScheduledTransfer) {
+	store := ctx.KVStore(keeper.storeKey)
+
+	store.Delete(types.ScheduledTransferKey(transfer.TransferID))
+	store.Delete(types.ScheduledTransferBySenderKey(transfer.Sender, transfer.TransferID))
+	store.Delete(types.ScheduledTransferQueueKey(transfer.TransferID, transfer.NextHeight))
+}
+
+// IterateScheduledTransfers iterates over all the scheduled transfers in the order of their IDs
+func (keeper BaseKeeper) IterateScheduledTransfers(ctx sdk.Context, cb func(transfer types.ScheduledTransfer) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ScheduledTransfersKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var transfer types.ScheduledTransfer
+		types.ModuleCdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &transfer)
+		if cb(transfer) {
+			break
+		}
+	}
+}
+
+// GetScheduledTransfers returns all the scheduled transfers
+func (keeper BaseKeeper) GetScheduledTransfers(ctx sdk.Context) types.ScheduledTransfers {
+	transfers := types.ScheduledTransfers{}
+	keeper.IterateScheduledTransfers(ctx, func(transfer types.ScheduledTransfer) bool {
+		transfers = append(transfers, transfer)
+		return false
+	})
+	return transfers
+}
+
+// GetScheduledTransfersBySender returns the scheduled transfers paid by sender
+func (keeper BaseKeeper) GetScheduledTransfersBySender(ctx sdk.Context, sender sdk.AccAddress) types.ScheduledTransfers {
+	return keeper.getScheduledTransfersByIndex(ctx, types.ScheduledTransfersBySenderKey(sender), nil, 0)
+}
+
+// GetDueScheduledTransfers returns at most limit scheduled transfers whose next payment is due
+// at the current block height, the most overdue first. A limit of 0 returns all of them.
+func (keeper BaseKeeper) GetDueScheduledTransfers(ctx sdk.Context, limit int) types.ScheduledTransfers {
+	return keeper.getScheduledTransfersByIndex(ctx, types.ScheduledTransferHeightQueueKeyPrefix,
+		sdk.PrefixEndBytes(types.ScheduledTransferQueueByHeightKey(ctx.BlockHeight())), limit)
+}
+
+// getScheduledTransfersByIndex returns the scheduled transfers whose IDs are stored under prefix, up to end
+// if it is not nil and at most limit of them if it is not 0
+func (keeper BaseKeeper) getScheduledTransfersByIndex(ctx sdk.Context, prefix, end []byte, limit int) types.ScheduledTransfers {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var iter sdk.Iterator
+	if end == nil {
+		iter = sdk.KVStorePrefixIterator(store, prefix)
+	} else {
+		iter = store.Iterator(prefix, end)
+	}
+	defer iter.Close()
+
+	transfers := types.ScheduledTransfers{}
+	for ; iter.Valid() && (limit == 0 || len(transfers) < limit); iter.Next() {
+		transferID := types.SplitScheduledTransferIDFromKey(iter.Key())
+		transfer, found := keeper.GetScheduledTransfer(ctx, transferID)
+		if !found {
+			panic(fmt.Sprintf("scheduled transfer %d indexed but not found", transferID))
+		}
+		transfers = append(transfers, transfer)
+	}
+	return transfers
+}
+
+// GetNextScheduledTransferID returns the ID of the next scheduled transfer
+func (keeper BaseKeeper) GetNextScheduledTransferID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(types.ScheduledTransferIDKey)
+	if bz == nil {
+		return types.DefaultStartingScheduledTransferID
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextScheduledTransferID sets the ID of the next scheduled transfer
+func (keeper BaseKeeper) SetNextScheduledTransferID(ctx sdk.Context, transferID uint64) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(types.ScheduledTransferIDKey, sdk.Uint64ToBigEndian(transferID))
+}
+
+// GetScheduleAddress returns the address of the schedule module account
+func (keeper BaseKeeper) GetScheduleAddress() sdk.AccAddress {
+	return keeper.supplyKeeper().GetModuleAddress(types.ScheduleAccountName)
+}
+
+// GetScheduleAccount returns the schedule module account, it is created if it does not exist
+func (keeper BaseKeeper) GetScheduleAccount(ctx sdk.Context) supplyexported.ModuleAccountI {
+	return keeper.supplyKeeper().GetModuleAccount(ctx, types.ScheduleAccountName)
+}
+
+// SetScheduleAccount stores the schedule module account
+func (keeper BaseKeeper) SetScheduleAccount(ctx sdk.Context, macc supplyexported.ModuleAccountI) {
+	keeper.supplyKeeper().SetModuleAccount(ctx, macc)
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/auth/exported"
+	authtypes "github.com/pocblockchain/pocc/x/auth/types"
+	"github.com/pocblockchain/pocc/x/bank/internal/types"
+)
+
+// VestingKeeper defines a module interface that funds new vesting accounts from
+// existing accounts after genesis.
+type VestingKeeper interface {
+	CreateVestingAccount(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+		startTime, endTime int64, periods authtypes.Periods) sdk.Error
+}
+
+var _ VestingKeeper = (*BaseKeeper)(nil)
+
+// CreateVestingAccount sends amt from fromAddr to the new account toAddr and
+// turns it into a vesting account whose original vesting is amt. Periods make a
+// periodic vesting account, otherwise a zero startTime makes a delayed vesting
+// account and a non-zero one a continuous vesting account.
+func (keeper BaseKeeper) CreateVestingAccount(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins,
+	startTime, endTime int64, periods authtypes.Periods) sdk.Error {
+
+	if keeper.ak.GetAccount(ctx, toAddr) != nil {
+		return types.ErrAccountExists(keeper.Codespace(), toAddr)
+	}
+
+	if err := keeper.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	bacc, ok := keeper.ak.GetAccount(ctx, toAddr).(*authtypes.BaseAccount)
+	if !ok {
+		return sdk.ErrInternal(fmt.Sprintf("account %s is not a base account", toAddr))
+	}
+
+	var vacc exported.Account
+	switch {
+	case len(periods) > 0:
+		pva := authtypes.NewPeriodicVestingAccount(bacc, startTime, periods)
+		endTime = pva.GetEndTime()
+		vacc = pva
+	case startTime == 0:
+		vacc = authtypes.NewDelayedVestingAccount(bacc, endTime)
+	default:
+		vacc = authtypes.NewContinuousVestingAccount(bacc, startTime, endTime)
+	}
+	keeper.ak.SetAccount(ctx, vacc)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateVesting,
+			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+			sdk.NewAttribute(types.AttributeKeyStartTime, fmt.Sprintf("%d", startTime)),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", endTime)),
+		),
+	)
+
+	return nil
+}
@@ -10,8 +10,15 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgMultiSend{}, "poc/MsgMultiSend", nil)
 	cdc.RegisterConcrete(MsgEscrow{}, "poc/MsgEscrow", nil)
 	cdc.RegisterConcrete(MsgReclaim{}, "poc/MsgReclaim", nil)
+	cdc.RegisterConcrete(MsgReleaseEscrow{}, "poc/MsgReleaseEscrow", nil)
+	cdc.RegisterConcrete(MsgCreateHTLC{}, "poc/MsgCreateHTLC", nil)
+	cdc.RegisterConcrete(MsgClaimHTLC{}, "poc/MsgClaimHTLC", nil)
+	cdc.RegisterConcrete(MsgRefundHTLC{}, "poc/MsgRefundHTLC", nil)
 	cdc.RegisterConcrete(MsgBonusSend{}, "poc/MsgBonusSend", nil)
 	cdc.RegisterConcrete(MsgReclaimSend{}, "poc/MsgReclaimSend", nil)
+	cdc.RegisterConcrete(MsgCreateVestingAccount{}, "poc/MsgCreateVestingAccount", nil)
+	cdc.RegisterConcrete(MsgScheduleTransfer{}, "poc/MsgScheduleTransfer", nil)
+	cdc.RegisterConcrete(MsgCancelScheduledTransfer{}, "poc/MsgCancelScheduledTransfer", nil)
 }
 
 // module codec
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/pocblockchain/pocc/types"
 )
 
@@ -10,6 +12,23 @@ const (
 
 	CodeSendDisabled         sdk.CodeType = 101
 	CodeInvalidInputsOutputs sdk.CodeType = 102
+	CodeAccountFrozen        sdk.CodeType = 103
+	CodeUnknownEscrow        sdk.CodeType = 104
+	CodeEscrowExpired        sdk.CodeType = 105
+	CodeEscrowNotExpired     sdk.CodeType = 106
+	CodeInvalidEscrowExpiry  sdk.CodeType = 107
+	CodeUnknownHTLC          sdk.CodeType = 108
+	CodeDuplicateHTLC        sdk.CodeType = 109
+	CodeInvalidHashLock      sdk.CodeType = 110
+	CodeInvalidPreimage      sdk.CodeType = 111
+	CodeHTLCExpired          sdk.CodeType = 112
+	CodeHTLCNotExpired       sdk.CodeType = 113
+	CodeInvalidHTLCExpiry    sdk.CodeType = 114
+	CodeAccountExists        sdk.CodeType = 115
+	CodeInvalidVesting       sdk.CodeType = 116
+	CodeUnknownSchedule      sdk.CodeType = 117
+	CodeInvalidSchedule      sdk.CodeType = 118
+	CodeInvalidHTLCID        sdk.CodeType = 119
 )
 
 // ErrNoInputs is an error
@@ -31,3 +50,88 @@ func ErrInputOutputMismatch(codespace sdk.CodespaceType) sdk.Error {
 func ErrSendDisabled(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeSendDisabled, "send transactions are currently disabled")
 }
+
+// ErrAccountFrozen is an error
+func ErrAccountFrozen(codespace sdk.CodespaceType, addr sdk.AccAddress, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeAccountFrozen, fmt.Sprintf("%s of %s is frozen", denom, addr))
+}
+
+// ErrUnknownEscrow is an error
+func ErrUnknownEscrow(codespace sdk.CodespaceType, escrowID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownEscrow, fmt.Sprintf("unknown escrow %d", escrowID))
+}
+
+// ErrEscrowExpired is an error
+func ErrEscrowExpired(codespace sdk.CodespaceType, escrowID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeEscrowExpired, fmt.Sprintf("escrow %d is expired", escrowID))
+}
+
+// ErrEscrowNotExpired is an error
+func ErrEscrowNotExpired(codespace sdk.CodespaceType, escrowID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeEscrowNotExpired, fmt.Sprintf("escrow %d is not expired", escrowID))
+}
+
+// ErrInvalidEscrowExpiry is an error
+func ErrInvalidEscrowExpiry(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEscrowExpiry, msg)
+}
+
+// ErrUnknownHTLC is an error
+func ErrUnknownHTLC(codespace sdk.CodespaceType, id []byte) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownHTLC, fmt.Sprintf("unknown htlc %X", id))
+}
+
+// ErrDuplicateHTLC is an error
+func ErrDuplicateHTLC(codespace sdk.CodespaceType, id []byte) sdk.Error {
+	return sdk.NewError(codespace, CodeDuplicateHTLC, fmt.Sprintf("htlc %X already exists", id))
+}
+
+// ErrInvalidHashLock is an error
+func ErrInvalidHashLock(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidHashLock, msg)
+}
+
+// ErrInvalidPreimage is an error
+func ErrInvalidPreimage(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPreimage, msg)
+}
+
+// ErrInvalidHTLCID is an error
+func ErrInvalidHTLCID(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidHTLCID, msg)
+}
+
+// ErrHTLCExpired is an error
+func ErrHTLCExpired(codespace sdk.CodespaceType, id []byte) sdk.Error {
+	return sdk.NewError(codespace, CodeHTLCExpired, fmt.Sprintf("htlc %X is expired", id))
+}
+
+// ErrHTLCNotExpired is an error
+func ErrHTLCNotExpired(codespace sdk.CodespaceType, id []byte) sdk.Error {
+	return sdk.NewError(codespace, CodeHTLCNotExpired, fmt.Sprintf("htlc %X is not expired", id))
+}
+
+// ErrInvalidHTLCExpiry is an error
+func ErrInvalidHTLCExpiry(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidHTLCExpiry, msg)
+}
+
+// ErrAccountExists is an error when creating a vesting account at an existing address
+func ErrAccountExists(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeAccountExists, fmt.Sprintf("account %s already exists", addr))
+}
+
+// ErrInvalidVesting is an error when a vesting schedule is invalid
+func ErrInvalidVesting(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVesting, msg)
+}
+
+// ErrUnknownScheduledTransfer is an error
+func ErrUnknownScheduledTransfer(codespace sdk.CodespaceType, transferID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownSchedule, fmt.Sprintf("unknown scheduled transfer %d", transferID))
+}
+
+// ErrInvalidSchedule is an error when the schedule of a transfer is invalid
+func ErrInvalidSchedule(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSchedule, msg)
+}
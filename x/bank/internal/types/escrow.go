@@ -0,0 +1,109 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/pocblockchain/pocc/types"
+)
+
+// DefaultStartingEscrowID is the ID of the first escrow
+const DefaultStartingEscrowID uint64 = 1
+
+const (
+	// EscrowReclaimBlocks is the number of blocks after its expire height during which the payer
+	// can reclaim an expired escrow, it is refunded in the EndBlock afterwards
+	EscrowReclaimBlocks int64 = 100
+
+	// EscrowReclaimPeriod is the time after its expire time during which the payer can reclaim
+	// an expired escrow, it is refunded in the EndBlock afterwards
+	EscrowReclaimPeriod = 24 * time.Hour
+)
+
+// Escrow defines coins locked in the escrow module account by a payer for a payee
+type Escrow struct {
+	EscrowID     uint64         `json:"escrow_id" yaml:"escrow_id"`
+	Payer        sdk.AccAddress `json:"payer" yaml:"payer"`
+	Payee        sdk.AccAddress `json:"payee" yaml:"payee"`
+	Amount       sdk.Coins      `json:"amount" yaml:"amount"`
+	ExpireHeight int64          `json:"expire_height" yaml:"expire_height"` // 0 if the escrow never expires by height
+	ExpireTime   time.Time      `json:"expire_time" yaml:"expire_time"`     // zero if the escrow never expires by time
+}
+
+// NewEscrow creates a new Escrow instance
+func NewEscrow(escrowID uint64, payer, payee sdk.AccAddress, amount sdk.Coins, expireHeight int64, expireTime time.Time) Escrow {
+	return Escrow{
+		EscrowID:     escrowID,
+		Payer:        payer,
+		Payee:        payee,
+		Amount:       amount,
+		ExpireHeight: expireHeight,
+		ExpireTime:   expireTime,
+	}
+}
+
+// HasExpiry returns whether the escrow expires at all
+func (e Escrow) HasExpiry() bool {
+	return e.ExpireHeight > 0 || !e.ExpireTime.IsZero()
+}
+
+// IsExpired returns whether the escrow is expired at the given block height and time
+func (e Escrow) IsExpired(height int64, blockTime time.Time) bool {
+	if e.ExpireHeight > 0 && height >= e.ExpireHeight {
+		return true
+	}
+	return !e.ExpireTime.IsZero() && !blockTime.Before(e.ExpireTime)
+}
+
+// Validate performs a stateless validation of the escrow
+func (e Escrow) Validate() error {
+	if e.Payer.Empty() {
+		return fmt.Errorf("escrow %d: missing payer", e.EscrowID)
+	}
+	if e.Payee.Empty() {
+		return fmt.Errorf("escrow %d: missing payee", e.EscrowID)
+	}
+	if !e.Amount.IsValid() || !e.Amount.IsAllPositive() {
+		return fmt.Errorf("escrow %d: invalid amount %s", e.EscrowID, e.Amount)
+	}
+	if e.ExpireHeight < 0 {
+		return fmt.Errorf("escrow %d: negative expire height %d", e.EscrowID, e.ExpireHeight)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (e Escrow) String() string {
+	return fmt.Sprintf(`Escrow %d:
+  Payer:         %s
+  Payee:         %s
+  Amount:        %s
+  Expire Height: %d
+  Expire Time:   %s`, e.EscrowID, e.Payer, e.Payee, e.Amount, e.ExpireHeight, e.ExpireTime)
+}
+
+// Escrows is an array of escrows
+type Escrows []Escrow
+
+// String implements the Stringer interface
+func (es Escrows) String() string {
+	if len(es) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(es))
+	for i, e := range es {
+		out[i] = e.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// Total returns the sum of the escrowed amounts
+func (es Escrows) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, e := range es {
+		total = total.Add(e.Amount)
+	}
+	return total
+}
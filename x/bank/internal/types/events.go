@@ -5,14 +5,38 @@ const (
 	EventTypeTransfer      = "transfer"
 	EventTypeEscrow        = "escrow"
 	EventTypeReclaim       = "reclaim"
+	EventTypeReleaseEscrow = "release_escrow"
+	EventTypeRefundEscrow  = "refund_escrow"
+	EventTypeCreateHTLC    = "create_htlc"
+	EventTypeClaimHTLC     = "claim_htlc"
+	EventTypeRefundHTLC    = "refund_htlc"
 	EventTypeMultiTransfer = "multi_transfer"
 	EventTypeBonusSend     = "bonus_send"
 	EventTypeReclaimSend   = "relcaim_send"
+	EventTypeCreateVesting = "create_vesting_account"
 
-	AttributeKeyRecipient   = "recipient"
-	AttributeKeySender      = "sender"
-	AttributeKeyReclaimTo   = "reclaim_to"
-	AttributeKeyReclaimFrom = "reclaim_from"
+	EventTypeScheduleTransfer        = "schedule_transfer"
+	EventTypeCancelScheduledTransfer = "cancel_scheduled_transfer"
+	EventTypeScheduledTransfer       = "scheduled_transfer"
+	EventTypeScheduledTransferFailed = "scheduled_transfer_failed"
+	EventTypeRefundEscrowFailed      = "refund_escrow_failed"
+
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeySender       = "sender"
+	AttributeKeyReclaimTo    = "reclaim_to"
+	AttributeKeyReclaimFrom  = "reclaim_from"
+	AttributeKeyEscrowID     = "escrow_id"
+	AttributeKeyHTLCID       = "htlc_id"
+	AttributeKeyHashLock     = "hash_lock"
+	AttributeKeyPreimage     = "preimage"
+	AttributeKeyExpireHeight = "expire_height"
+	AttributeKeyStartTime    = "start_time"
+	AttributeKeyEndTime      = "end_time"
+	AttributeKeyTransferID   = "transfer_id"
+	AttributeKeyInterval     = "interval"
+	AttributeKeyRemaining    = "remaining"
+	AttributeKeyNextHeight   = "next_height"
+	AttributeKeyReason       = "reason"
 
 	AttributeValueCategory = ModuleName
 )
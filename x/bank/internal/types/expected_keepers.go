@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/pocblockchain/pocc/types"
 	"github.com/pocblockchain/pocc/x/auth/exported"
+	supplyexported "github.com/pocblockchain/pocc/x/supply/exported"
 )
 
 // AccountKeeper defines the account contract that must be fulfilled when
@@ -19,4 +20,16 @@ type AccountKeeper interface {
 
 type TokenKeeper interface {
 	IsSendEnabled(ctx sdk.Context, symbol sdk.Symbol) bool
+	IsAccountFrozen(ctx sdk.Context, symbol sdk.Symbol, addr sdk.AccAddress) bool
+}
+
+// SupplyKeeper defines the supply contract that must be fulfilled to lock
+// escrowed coins in the escrow module account
+type SupplyKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+	GetModuleAccount(ctx sdk.Context, name string) supplyexported.ModuleAccountI
+	SetModuleAccount(ctx sdk.Context, macc supplyexported.ModuleAccountI)
+
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) sdk.Error
 }
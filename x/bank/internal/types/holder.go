@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/pocblockchain/pocc/types"
+)
+
+const (
+	// DefaultHoldersLimit is the page size of the holders query when no limit is given
+	DefaultHoldersLimit = 100
+
+	// balanceLength is the length of a balance in the holders by balance index,
+	// sdk.Int is limited to 255 bits so that every balance fits
+	balanceLength = 32
+)
+
+// Holder defines the balance of a denom held by an address
+type Holder struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Amount  sdk.Int        `json:"amount" yaml:"amount"`
+}
+
+// NewHolder creates a new Holder instance
+func NewHolder(addr sdk.AccAddress, amount sdk.Int) Holder {
+	return Holder{
+		Address: addr,
+		Amount:  amount,
+	}
+}
+
+// String implements the Stringer interface
+func (h Holder) String() string {
+	return fmt.Sprintf("%s: %s", h.Address, h.Amount)
+}
+
+// Holders is an array of Holders
+type Holders []Holder
+
+// String implements the Stringer interface
+func (hs Holders) String() string {
+	if len(hs) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(hs))
+	for i, h := range hs {
+		out[i] = h.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// HoldersPage defines a page of the holders of a denom, sorted by balance
+// from the largest, together with the total number of holders
+type HoldersPage struct {
+	Denom   string  `json:"denom" yaml:"denom"`
+	Count   uint64  `json:"count" yaml:"count"`
+	Holders Holders `json:"holders" yaml:"holders"`
+}
+
+// String implements the Stringer interface
+func (p HoldersPage) String() string {
+	return fmt.Sprintf(`Holders of %s:
+  Count:   %d
+  Holders:
+%s`, p.Denom, p.Count, p.Holders)
+}
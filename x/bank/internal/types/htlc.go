@@ -0,0 +1,133 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/pocblockchain/pocc/types"
+)
+
+const (
+	// HashLockLength is the length of the SHA-256 hash lock of an HTLC
+	HashLockLength = sha256.Size
+
+	// HTLCIDLength is the length of the ID of an HTLC
+	HTLCIDLength = sha256.Size
+
+	// MaxPreimageLength is the maximum length of the preimage revealed to claim an HTLC
+	MaxPreimageLength = 64
+)
+
+// HTLC defines coins locked in the htlc module account by a sender for a recipient,
+// claimable with the preimage of the hash lock until the expire height. HTLCs are
+// identified by their sender, recipient and hash lock so that the hash lock of a
+// swap seen in the mempool cannot be taken first by anyone else.
+type HTLC struct {
+	ID           cmn.HexBytes   `json:"id" yaml:"id"`
+	HashLock     cmn.HexBytes   `json:"hash_lock" yaml:"hash_lock"`
+	Sender       sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient    sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount       sdk.Coins      `json:"amount" yaml:"amount"`
+	ExpireHeight int64          `json:"expire_height" yaml:"expire_height"`
+}
+
+// NewHTLC creates a new HTLC instance
+func NewHTLC(hashLock []byte, sender, recipient sdk.AccAddress, amount sdk.Coins, expireHeight int64) HTLC {
+	return HTLC{
+		ID:           GetHTLCID(sender, recipient, hashLock),
+		HashLock:     hashLock,
+		Sender:       sender,
+		Recipient:    recipient,
+		Amount:       amount,
+		ExpireHeight: expireHeight,
+	}
+}
+
+// IsExpired returns whether the HTLC is expired at the given block height
+func (h HTLC) IsExpired(height int64) bool {
+	return height >= h.ExpireHeight
+}
+
+// Validate performs a stateless validation of the HTLC
+func (h HTLC) Validate() error {
+	if len(h.HashLock) != HashLockLength {
+		return fmt.Errorf("htlc %s: hash lock length %d != %d", h.ID, len(h.HashLock), HashLockLength)
+	}
+	if h.Sender.Empty() {
+		return fmt.Errorf("htlc %s: missing sender", h.ID)
+	}
+	if h.Recipient.Empty() {
+		return fmt.Errorf("htlc %s: missing recipient", h.ID)
+	}
+	if !h.Amount.IsValid() || !h.Amount.IsAllPositive() {
+		return fmt.Errorf("htlc %s: invalid amount %s", h.ID, h.Amount)
+	}
+	if h.ExpireHeight <= 0 {
+		return fmt.Errorf("htlc %s: non positive expire height %d", h.ID, h.ExpireHeight)
+	}
+	if !bytes.Equal(h.ID, GetHTLCID(h.Sender, h.Recipient, h.HashLock)) {
+		return fmt.Errorf("htlc %s: ID does not match the sender, recipient and hash lock", h.ID)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (h HTLC) String() string {
+	return fmt.Sprintf(`HTLC %s:
+  Hash Lock:     %s
+  Sender:        %s
+  Recipient:     %s
+  Amount:        %s
+  Expire Height: %d`, h.ID, h.HashLock, h.Sender, h.Recipient, h.Amount, h.ExpireHeight)
+}
+
+// HTLCs is an array of HTLCs
+type HTLCs []HTLC
+
+// String implements the Stringer interface
+func (hs HTLCs) String() string {
+	if len(hs) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(hs))
+	for i, h := range hs {
+		out[i] = h.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// Total returns the sum of the locked amounts
+func (hs HTLCs) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, h := range hs {
+		total = total.Add(h.Amount)
+	}
+	return total
+}
+
+// GetHashLock returns the SHA-256 hash lock of preimage
+func GetHashLock(preimage []byte) []byte {
+	hash := sha256.Sum256(preimage)
+	return hash[:]
+}
+
+// MatchHashLock returns whether preimage unlocks hashLock
+func MatchHashLock(hashLock, preimage []byte) bool {
+	return bytes.Equal(hashLock, GetHashLock(preimage))
+}
+
+// GetHTLCID returns the ID of the HTLC of sender for recipient locked by hashLock,
+// every part is length prefixed so that different parts never give the same ID
+func GetHTLCID(sender, recipient sdk.AccAddress, hashLock []byte) []byte {
+	h := sha256.New()
+	for _, bz := range [][]byte{sender, recipient, hashLock} {
+		h.Write([]byte{byte(len(bz))})
+		h.Write(bz)
+	}
+	return h.Sum(nil)
+}
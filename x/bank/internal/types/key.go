@@ -1,7 +1,221 @@
 package types
 
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/pocblockchain/pocc/types"
+)
+
 const (
 	// module name
 	ModuleName   = "bank"
 	QuerierRoute = ModuleName
+
+	// StoreKey is the store key string for bank
+	StoreKey = ModuleName
+
+	// EscrowAccountName is the name of the module account holding escrowed coins
+	EscrowAccountName = "escrow"
+
+	// HTLCAccountName is the name of the module account holding hash/time-locked coins
+	HTLCAccountName = "htlc"
+
+	// ScheduleAccountName is the name of the module account holding the coins of scheduled transfers
+	ScheduleAccountName = "schedule"
+)
+
+// Keys for bank store
+// Items are stored with the following key: values
+//
+// - 0x00: nextEscrowID
+//
+// - 0x01<escrowID_Bytes>: Escrow
+//
+// - 0x02<payerAddr_Bytes><escrowID_Bytes>: escrowID
+//
+// - 0x03<payeeAddr_Bytes><escrowID_Bytes>: escrowID
+//
+// - 0x04<expireTime_Bytes><escrowID_Bytes>: escrowID
+//
+// - 0x05<expireHeight_Bytes><escrowID_Bytes>: escrowID
+//
+// - 0x06<htlcID_Bytes>: HTLC
+//
+// - 0x07<denom_Bytes>:<addr_Bytes>: balance
+//
+// - 0x08<denom_Bytes>:<balance_Bytes><addr_Bytes>: []byte{}
+//
+// - 0x09<denom_Bytes>: holderCount
+//
+// - 0x0a: nextScheduledTransferID
+//
+// - 0x0b<transferID_Bytes>: ScheduledTransfer
+//
+// - 0x0c<senderAddr_Bytes><transferID_Bytes>: transferID
+//
+// - 0x0d<nextHeight_Bytes><transferID_Bytes>: transferID
+var (
+	EscrowIDKey                = []byte{0x00}
+	EscrowsKeyPrefix           = []byte{0x01}
+	EscrowsByPayerKeyPrefix    = []byte{0x02}
+	EscrowsByPayeeKeyPrefix    = []byte{0x03}
+	EscrowTimeQueueKeyPrefix   = []byte{0x04}
+	EscrowHeightQueueKeyPrefix = []byte{0x05}
+	HTLCsKeyPrefix             = []byte{0x06}
+	HoldersKeyPrefix           = []byte{0x07}
+	HoldersByBalanceKeyPrefix  = []byte{0x08}
+	HolderCountKeyPrefix       = []byte{0x09}
+
+	ScheduledTransferIDKey                = []byte{0x0a}
+	ScheduledTransfersKeyPrefix           = []byte{0x0b}
+	ScheduledTransfersBySenderKeyPrefix   = []byte{0x0c}
+	ScheduledTransferHeightQueueKeyPrefix = []byte{0x0d}
 )
+
+// EscrowKey gets a specific escrow from the store
+func EscrowKey(escrowID uint64) []byte {
+	return append(EscrowsKeyPrefix, sdk.Uint64ToBigEndian(escrowID)...)
+}
+
+// EscrowsByPayerKey gets the first part of the payer index key based on the payer address
+func EscrowsByPayerKey(payer sdk.AccAddress) []byte {
+	return append(EscrowsByPayerKeyPrefix, payer.Bytes()...)
+}
+
+// EscrowByPayerKey returns the key for an escrowID in the payer index
+func EscrowByPayerKey(payer sdk.AccAddress, escrowID uint64) []byte {
+	return append(EscrowsByPayerKey(payer), sdk.Uint64ToBigEndian(escrowID)...)
+}
+
+// EscrowsByPayeeKey gets the first part of the payee index key based on the payee address
+func EscrowsByPayeeKey(payee sdk.AccAddress) []byte {
+	return append(EscrowsByPayeeKeyPrefix, payee.Bytes()...)
+}
+
+// EscrowByPayeeKey returns the key for an escrowID in the payee index
+func EscrowByPayeeKey(payee sdk.AccAddress, escrowID uint64) []byte {
+	return append(EscrowsByPayeeKey(payee), sdk.Uint64ToBigEndian(escrowID)...)
+}
+
+// EscrowTimeQueueByTimeKey gets the escrow time queue key by expireTime
+func EscrowTimeQueueByTimeKey(expireTime time.Time) []byte {
+	return append(EscrowTimeQueueKeyPrefix, sdk.FormatTimeBytes(expireTime)...)
+}
+
+// EscrowTimeQueueKey returns the key for an escrowID in the escrow time queue
+func EscrowTimeQueueKey(escrowID uint64, expireTime time.Time) []byte {
+	return append(EscrowTimeQueueByTimeKey(expireTime), sdk.Uint64ToBigEndian(escrowID)...)
+}
+
+// EscrowHeightQueueByHeightKey gets the escrow height queue key by expireHeight
+func EscrowHeightQueueByHeightKey(expireHeight int64) []byte {
+	return append(EscrowHeightQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(expireHeight))...)
+}
+
+// EscrowHeightQueueKey returns the key for an escrowID in the escrow height queue
+func EscrowHeightQueueKey(escrowID uint64, expireHeight int64) []byte {
+	return append(EscrowHeightQueueByHeightKey(expireHeight), sdk.Uint64ToBigEndian(escrowID)...)
+}
+
+// HTLCKey gets a specific HTLC from the store
+func HTLCKey(id []byte) []byte {
+	return append(HTLCsKeyPrefix, id...)
+}
+
+// HoldersKey gets the first part of the holder index key based on the denom,
+// the separator keeps a denom from matching the holders of a longer one
+func HoldersKey(denom string) []byte {
+	return append(append(HoldersKeyPrefix, []byte(denom)...), ':')
+}
+
+// HolderKey returns the key for the balance of a denom held by addr
+func HolderKey(denom string, addr sdk.AccAddress) []byte {
+	return append(HoldersKey(denom), addr.Bytes()...)
+}
+
+// SplitHolderKey returns the denom and the address of a holder index key,
+// denoms never contain the separator so the first one ends the denom
+func SplitHolderKey(key []byte) (string, sdk.AccAddress) {
+	key = key[len(HoldersKeyPrefix):]
+	i := bytes.IndexByte(key, ':')
+	if i < 0 {
+		panic(fmt.Sprintf("unexpected holder key %X", key))
+	}
+
+	return string(key[:i]), sdk.AccAddress(key[i+1:])
+}
+
+// HoldersByBalanceKey gets the first part of the holders by balance index key based on the denom
+func HoldersByBalanceKey(denom string) []byte {
+	return append(append(HoldersByBalanceKeyPrefix, []byte(denom)...), ':')
+}
+
+// HolderByBalanceKey returns the key for addr in the holders by balance index,
+// the fixed length big endian balance sorts the holders of a denom by balance
+func HolderByBalanceKey(denom string, amount sdk.Int, addr sdk.AccAddress) []byte {
+	bz := make([]byte, balanceLength)
+	amountBz := amount.BigInt().Bytes()
+	copy(bz[balanceLength-len(amountBz):], amountBz)
+	return append(append(HoldersByBalanceKey(denom), bz...), addr.Bytes()...)
+}
+
+// SplitHolderByBalanceKey returns the address at the end of a holders by balance index key
+func SplitHolderByBalanceKey(denom string, key []byte) sdk.AccAddress {
+	prefixLength := len(HoldersByBalanceKey(denom)) + balanceLength
+	if len(key) <= prefixLength {
+		panic(fmt.Sprintf("unexpected key length (%d <= %d)", len(key), prefixLength))
+	}
+
+	return sdk.AccAddress(key[prefixLength:])
+}
+
+// HolderCountKey returns the key for the number of holders of a denom
+func HolderCountKey(denom string) []byte {
+	return append(HolderCountKeyPrefix, []byte(denom)...)
+}
+
+// ScheduledTransferKey gets a specific scheduled transfer from the store
+func ScheduledTransferKey(transferID uint64) []byte {
+	return append(ScheduledTransfersKeyPrefix, sdk.Uint64ToBigEndian(transferID)...)
+}
+
+// ScheduledTransfersBySenderKey gets the first part of the sender index key based on the sender address
+func ScheduledTransfersBySenderKey(sender sdk.AccAddress) []byte {
+	return append(ScheduledTransfersBySenderKeyPrefix, sender.Bytes()...)
+}
+
+// ScheduledTransferBySenderKey returns the key for a transferID in the sender index
+func ScheduledTransferBySenderKey(sender sdk.AccAddress, transferID uint64) []byte {
+	return append(ScheduledTransfersBySenderKey(sender), sdk.Uint64ToBigEndian(transferID)...)
+}
+
+// ScheduledTransferQueueByHeightKey gets the scheduled transfer queue key by the height of the next payment
+func ScheduledTransferQueueByHeightKey(height int64) []byte {
+	return append(ScheduledTransferHeightQueueKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// ScheduledTransferQueueKey returns the key for a transferID in the scheduled transfer queue
+func ScheduledTransferQueueKey(transferID uint64, height int64) []byte {
+	return append(ScheduledTransferQueueByHeightKey(height), sdk.Uint64ToBigEndian(transferID)...)
+}
+
+// SplitEscrowIDFromKey returns the escrowID at the end of an index or queue key
+func SplitEscrowIDFromKey(key []byte) uint64 {
+	if len(key) < 9 {
+		panic(fmt.Sprintf("unexpected key length (%d < 9)", len(key)))
+	}
+
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// SplitScheduledTransferIDFromKey returns the transferID at the end of an index or queue key
+func SplitScheduledTransferIDFromKey(key []byte) uint64 {
+	if len(key) < 9 {
+		panic(fmt.Sprintf("unexpected key length (%d < 9)", len(key)))
+	}
+
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
@@ -1,7 +1,13 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	cmn "github.com/tendermint/tendermint/libs/common"
+
 	sdk "github.com/pocblockchain/pocc/types"
+	authtypes "github.com/pocblockchain/pocc/x/auth/types"
 )
 
 // RouterKey is they name of the bank module
@@ -184,18 +190,21 @@ func ValidateInputsOutputs(inputs []Input, outputs []Output) sdk.Error {
 	return nil
 }
 
-// MsgEscrow - high level transaction of the coin module
+// MsgEscrow - high level transaction of the coin module, locks coins for the recipient
+// until they are released or reclaimed after expiry
 type MsgEscrow struct {
-	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
-	ToAddress   sdk.AccAddress `json:"to_address" yaml:"to_address"`
-	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	FromAddress  sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress    sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	Amount       sdk.Coins      `json:"amount" yaml:"amount"`
+	ExpireHeight int64          `json:"expire_height" yaml:"expire_height"`
+	ExpireTime   time.Time      `json:"expire_time" yaml:"expire_time"`
 }
 
 var _ sdk.Msg = MsgEscrow{}
 
-// NewMsgEscrow - construct arbitrary escrow msg.
-func NewMsgEscrow(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins) MsgEscrow {
-	return MsgEscrow{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount}
+// NewMsgEscrow - construct arbitrary escrow msg, a zero expireHeight or expireTime means no expiry.
+func NewMsgEscrow(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, expireHeight int64, expireTime time.Time) MsgEscrow {
+	return MsgEscrow{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount, ExpireHeight: expireHeight, ExpireTime: expireTime}
 }
 
 // Route Implements Msg.
@@ -218,6 +227,9 @@ func (msg MsgEscrow) ValidateBasic() sdk.Error {
 	if !msg.Amount.IsAllPositive() {
 		return sdk.ErrInsufficientCoins("escrow amount must be positive")
 	}
+	if msg.ExpireHeight < 0 {
+		return ErrInvalidEscrowExpiry(DefaultCodespace, "escrow expire height must not be negative")
+	}
 	return nil
 }
 
@@ -231,18 +243,56 @@ func (msg MsgEscrow) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
 
-// MsgReclaim - high level transaction of the coin module
+// MsgReleaseEscrow - high level transaction of the coin module, the payer releases
+// the escrowed coins to the payee or the payee releases them back to the payer
+type MsgReleaseEscrow struct {
+	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	EscrowID    uint64         `json:"escrow_id" yaml:"escrow_id"`
+}
+
+var _ sdk.Msg = MsgReleaseEscrow{}
+
+// NewMsgReleaseEscrow - construct release escrow msg.
+func NewMsgReleaseEscrow(fromAddr sdk.AccAddress, escrowID uint64) MsgReleaseEscrow {
+	return MsgReleaseEscrow{FromAddress: fromAddr, EscrowID: escrowID}
+}
+
+// Route Implements Msg.
+func (msg MsgReleaseEscrow) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgReleaseEscrow) Type() string { return "release_escrow" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgReleaseEscrow) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing release from address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgReleaseEscrow) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgReleaseEscrow) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgReclaim - high level transaction of the coin module, the payer takes the
+// escrowed coins back after the escrow expires
 type MsgReclaim struct {
 	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
-	ToAddress   sdk.AccAddress `json:"to_address" yaml:"to_address"`
-	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	EscrowID    uint64         `json:"escrow_id" yaml:"escrow_id"`
 }
 
 var _ sdk.Msg = MsgReclaim{}
 
 // NewMsgReclaim - construct reclaim msg.
-func NewMsgReclaim(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins) MsgReclaim {
-	return MsgReclaim{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount}
+func NewMsgReclaim(fromAddr sdk.AccAddress, escrowID uint64) MsgReclaim {
+	return MsgReclaim{FromAddress: fromAddr, EscrowID: escrowID}
 }
 
 // Route Implements Msg.
@@ -253,28 +303,161 @@ func (msg MsgReclaim) Type() string { return "reclaim" }
 
 // ValidateBasic Implements Msg.
 func (msg MsgReclaim) ValidateBasic() sdk.Error {
-	if msg.ToAddress.Empty() {
-		return sdk.ErrInvalidAddress("missing reclaim to address")
-	}
 	if msg.FromAddress.Empty() {
 		return sdk.ErrInvalidAddress("missing reclaim from address")
 	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgReclaim) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgReclaim) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgCreateHTLC - high level transaction of the coin module, locks coins for the
+// recipient against a SHA-256 hash lock until the expire height
+type MsgCreateHTLC struct {
+	FromAddress  sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress    sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	Amount       sdk.Coins      `json:"amount" yaml:"amount"`
+	HashLock     cmn.HexBytes   `json:"hash_lock" yaml:"hash_lock"`
+	ExpireHeight int64          `json:"expire_height" yaml:"expire_height"`
+}
+
+var _ sdk.Msg = MsgCreateHTLC{}
+
+// NewMsgCreateHTLC - construct create htlc msg.
+func NewMsgCreateHTLC(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, hashLock []byte, expireHeight int64) MsgCreateHTLC {
+	return MsgCreateHTLC{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount, HashLock: hashLock, ExpireHeight: expireHeight}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateHTLC) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateHTLC) Type() string { return "create_htlc" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateHTLC) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing htlc from address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
 	if !msg.Amount.IsValid() {
-		return sdk.ErrInvalidCoins("escrow amount is invalid: " + msg.Amount.String())
+		return sdk.ErrInvalidCoins("htlc amount is invalid: " + msg.Amount.String())
 	}
 	if !msg.Amount.IsAllPositive() {
-		return sdk.ErrInsufficientCoins("escrow amount must be positive")
+		return sdk.ErrInsufficientCoins("htlc amount must be positive")
+	}
+	if len(msg.HashLock) != HashLockLength {
+		return ErrInvalidHashLock(DefaultCodespace, fmt.Sprintf("hash lock must be %d bytes", HashLockLength))
+	}
+	if msg.ExpireHeight <= 0 {
+		return ErrInvalidHTLCExpiry(DefaultCodespace, "htlc expire height must be positive")
 	}
 	return nil
 }
 
 // GetSignBytes Implements Msg.
-func (msg MsgReclaim) GetSignBytes() []byte {
+func (msg MsgCreateHTLC) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
 
 // GetSigners Implements Msg.
-func (msg MsgReclaim) GetSigners() []sdk.AccAddress {
+func (msg MsgCreateHTLC) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgClaimHTLC - high level transaction of the coin module, reveals the preimage
+// of the hash lock of an htlc to pay the locked coins to the recipient
+type MsgClaimHTLC struct {
+	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ID          cmn.HexBytes   `json:"id" yaml:"id"`
+	Preimage    cmn.HexBytes   `json:"preimage" yaml:"preimage"`
+}
+
+var _ sdk.Msg = MsgClaimHTLC{}
+
+// NewMsgClaimHTLC - construct claim htlc msg.
+func NewMsgClaimHTLC(fromAddr sdk.AccAddress, id, preimage []byte) MsgClaimHTLC {
+	return MsgClaimHTLC{FromAddress: fromAddr, ID: id, Preimage: preimage}
+}
+
+// Route Implements Msg.
+func (msg MsgClaimHTLC) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgClaimHTLC) Type() string { return "claim_htlc" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClaimHTLC) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing claim from address")
+	}
+	if len(msg.ID) != HTLCIDLength {
+		return ErrInvalidHTLCID(DefaultCodespace, fmt.Sprintf("htlc id must be %d bytes", HTLCIDLength))
+	}
+	if len(msg.Preimage) == 0 || len(msg.Preimage) > MaxPreimageLength {
+		return ErrInvalidPreimage(DefaultCodespace, fmt.Sprintf("preimage must be 1 to %d bytes", MaxPreimageLength))
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClaimHTLC) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClaimHTLC) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgRefundHTLC - high level transaction of the coin module, returns the locked
+// coins to the sender after the htlc expires
+type MsgRefundHTLC struct {
+	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ID          cmn.HexBytes   `json:"id" yaml:"id"`
+}
+
+var _ sdk.Msg = MsgRefundHTLC{}
+
+// NewMsgRefundHTLC - construct refund htlc msg.
+func NewMsgRefundHTLC(fromAddr sdk.AccAddress, id []byte) MsgRefundHTLC {
+	return MsgRefundHTLC{FromAddress: fromAddr, ID: id}
+}
+
+// Route Implements Msg.
+func (msg MsgRefundHTLC) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRefundHTLC) Type() string { return "refund_htlc" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRefundHTLC) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing refund from address")
+	}
+	if len(msg.ID) != HTLCIDLength {
+		return ErrInvalidHTLCID(DefaultCodespace, fmt.Sprintf("htlc id must be %d bytes", HTLCIDLength))
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRefundHTLC) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRefundHTLC) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.FromAddress}
 }
 
@@ -371,3 +554,184 @@ func (msg MsgReclaimSend) GetSigners() []sdk.AccAddress {
 	}
 	return addrs
 }
+
+// MsgCreateVestingAccount - high level transaction of the coin module, funds a
+// new vesting account from an existing account. A non-empty list of vesting
+// periods creates a periodic vesting account, otherwise a zero start time
+// creates a delayed vesting account and a non-zero one a continuous vesting
+// account.
+type MsgCreateVestingAccount struct {
+	FromAddress    sdk.AccAddress    `json:"from_address" yaml:"from_address"`
+	ToAddress      sdk.AccAddress    `json:"to_address" yaml:"to_address"`
+	Amount         sdk.Coins         `json:"amount" yaml:"amount"`
+	StartTime      int64             `json:"start_time" yaml:"start_time"`
+	EndTime        int64             `json:"end_time" yaml:"end_time"`
+	VestingPeriods authtypes.Periods `json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+var _ sdk.Msg = MsgCreateVestingAccount{}
+
+// NewMsgCreateVestingAccount - construct create continuous or delayed vesting account msg.
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, startTime, endTime int64) MsgCreateVestingAccount {
+	return MsgCreateVestingAccount{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount, StartTime: startTime, EndTime: endTime}
+}
+
+// NewMsgCreatePeriodicVestingAccount - construct create periodic vesting account msg.
+func NewMsgCreatePeriodicVestingAccount(fromAddr, toAddr sdk.AccAddress, startTime int64, periods authtypes.Periods) MsgCreateVestingAccount {
+	return MsgCreateVestingAccount{FromAddress: fromAddr, ToAddress: toAddr, Amount: periods.TotalAmount(),
+		StartTime: startTime, VestingPeriods: periods}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateVestingAccount) Type() string { return "create_vesting_account" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing sender address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing vesting account address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("vesting amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("vesting amount must be positive")
+	}
+	if msg.StartTime < 0 {
+		return ErrInvalidVesting(DefaultCodespace, "vesting start time cannot be negative")
+	}
+
+	if len(msg.VestingPeriods) > 0 {
+		if err := msg.VestingPeriods.Validate(); err != nil {
+			return ErrInvalidVesting(DefaultCodespace, err.Error())
+		}
+		if !msg.VestingPeriods.TotalAmount().IsEqual(msg.Amount) {
+			return ErrInvalidVesting(DefaultCodespace, "vesting amount must equal the total amount of vesting periods")
+		}
+		if msg.EndTime != 0 {
+			return ErrInvalidVesting(DefaultCodespace, "end time of periodic vesting is derived from vesting periods")
+		}
+		return nil
+	}
+
+	if msg.EndTime <= msg.StartTime {
+		return ErrInvalidVesting(DefaultCodespace, "vesting end time must be after start time")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgScheduleTransfer - high level transaction of the coin module, registers a
+// standing order paying amount to the recipient every interval blocks, times
+// times. The coins of all the payments are locked when the order is registered,
+// the first payment is made at the start height, or after an interval if it is 0.
+type MsgScheduleTransfer struct {
+	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	ToAddress   sdk.AccAddress `json:"to_address" yaml:"to_address"`
+	Amount      sdk.Coins      `json:"amount" yaml:"amount"`
+	Interval    int64          `json:"interval" yaml:"interval"`
+	Times       uint64         `json:"times" yaml:"times"`
+	StartHeight int64          `json:"start_height" yaml:"start_height"`
+}
+
+var _ sdk.Msg = MsgScheduleTransfer{}
+
+// NewMsgScheduleTransfer - construct schedule transfer msg.
+func NewMsgScheduleTransfer(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins, interval int64, times uint64, startHeight int64) MsgScheduleTransfer {
+	return MsgScheduleTransfer{FromAddress: fromAddr, ToAddress: toAddr, Amount: amount, Interval: interval, Times: times, StartHeight: startHeight}
+}
+
+// Route Implements Msg.
+func (msg MsgScheduleTransfer) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgScheduleTransfer) Type() string { return "schedule_transfer" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgScheduleTransfer) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing schedule from address")
+	}
+	if msg.ToAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing recipient address")
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins("scheduled amount is invalid: " + msg.Amount.String())
+	}
+	if !msg.Amount.IsAllPositive() {
+		return sdk.ErrInsufficientCoins("scheduled amount must be positive")
+	}
+	if msg.Interval < MinScheduleInterval {
+		return ErrInvalidSchedule(DefaultCodespace, fmt.Sprintf("schedule interval must be at least %d blocks", MinScheduleInterval))
+	}
+	if msg.Times == 0 || msg.Times > MaxScheduleTimes {
+		return ErrInvalidSchedule(DefaultCodespace, fmt.Sprintf("schedule times must be 1 to %d", MaxScheduleTimes))
+	}
+	if msg.StartHeight < 0 {
+		return ErrInvalidSchedule(DefaultCodespace, "schedule start height cannot be negative")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgScheduleTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgScheduleTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// MsgCancelScheduledTransfer - high level transaction of the coin module, the
+// sender cancels a scheduled transfer and takes the coins of the remaining
+// payments back
+type MsgCancelScheduledTransfer struct {
+	FromAddress sdk.AccAddress `json:"from_address" yaml:"from_address"`
+	TransferID  uint64         `json:"transfer_id" yaml:"transfer_id"`
+}
+
+var _ sdk.Msg = MsgCancelScheduledTransfer{}
+
+// NewMsgCancelScheduledTransfer - construct cancel scheduled transfer msg.
+func NewMsgCancelScheduledTransfer(fromAddr sdk.AccAddress, transferID uint64) MsgCancelScheduledTransfer {
+	return MsgCancelScheduledTransfer{FromAddress: fromAddr, TransferID: transferID}
+}
+
+// Route Implements Msg.
+func (msg MsgCancelScheduledTransfer) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCancelScheduledTransfer) Type() string { return "cancel_scheduled_transfer" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCancelScheduledTransfer) ValidateBasic() sdk.Error {
+	if msg.FromAddress.Empty() {
+		return sdk.ErrInvalidAddress("missing cancel from address")
+	}
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCancelScheduledTransfer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCancelScheduledTransfer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
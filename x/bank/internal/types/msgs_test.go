@@ -3,10 +3,12 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/pocblockchain/pocc/types"
+	authtypes "github.com/pocblockchain/pocc/x/auth/types"
 )
 
 func TestMsgSendRoute(t *testing.T) {
@@ -265,7 +267,7 @@ func TestMsgEscrowRoute(t *testing.T) {
 	addr1 := sdk.AccAddress([]byte("from"))
 	addr2 := sdk.AccAddress([]byte("to"))
 	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
-	var msg = NewMsgEscrow(addr1, addr2, coins)
+	var msg = NewMsgEscrow(addr1, addr2, coins, 0, time.Time{})
 
 	require.Equal(t, msg.Route(), RouterKey)
 	require.Equal(t, msg.Type(), "escrow")
@@ -278,6 +280,7 @@ func TestMsgEscrowValidation(t *testing.T) {
 	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
 	atom123eth123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123), sdk.NewInt64Coin("eth", 123))
 	atom123eth0 := sdk.Coins{sdk.NewInt64Coin("atom", 123), sdk.NewInt64Coin("eth", 0)}
+	expireTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
 
 	var emptyAddr sdk.AccAddress
 
@@ -285,12 +288,14 @@ func TestMsgEscrowValidation(t *testing.T) {
 		valid bool
 		tx    MsgEscrow
 	}{
-		{true, NewMsgEscrow(addr1, addr2, atom123)},       // valid send
-		{true, NewMsgEscrow(addr1, addr2, atom123eth123)}, // valid send with multiple coins
-		{false, NewMsgEscrow(addr1, addr2, atom0)},        // non positive coin
-		{false, NewMsgEscrow(addr1, addr2, atom123eth0)},  // non positive coin in multicoins
-		{false, NewMsgEscrow(emptyAddr, addr2, atom123)},  // empty from addr
-		{false, NewMsgEscrow(addr1, emptyAddr, atom123)},  // empty to addr
+		{true, NewMsgEscrow(addr1, addr2, atom123, 0, time.Time{})},       // valid escrow
+		{true, NewMsgEscrow(addr1, addr2, atom123eth123, 0, time.Time{})}, // valid escrow with multiple coins
+		{true, NewMsgEscrow(addr1, addr2, atom123, 100, expireTime)},      // valid escrow with expiry
+		{false, NewMsgEscrow(addr1, addr2, atom0, 0, time.Time{})},        // non positive coin
+		{false, NewMsgEscrow(addr1, addr2, atom123eth0, 0, time.Time{})},  // non positive coin in multicoins
+		{false, NewMsgEscrow(emptyAddr, addr2, atom123, 0, time.Time{})},  // empty from addr
+		{false, NewMsgEscrow(addr1, emptyAddr, atom123, 0, time.Time{})},  // empty to addr
+		{false, NewMsgEscrow(addr1, addr2, atom123, -1, time.Time{})},     // negative expire height
 	}
 
 	for _, tc := range cases {
@@ -307,10 +312,10 @@ func TestMsgEscrowGetSignBytes(t *testing.T) {
 	addr1 := sdk.AccAddress([]byte("input"))
 	addr2 := sdk.AccAddress([]byte("output"))
 	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
-	var msg = NewMsgEscrow(addr1, addr2, coins)
+	var msg = NewMsgEscrow(addr1, addr2, coins, 100, time.Time{})
 	res := msg.GetSignBytes()
 
-	expected := `{"type":"poc/MsgEscrow","value":{"amount":[{"amount":"10","denom":"atom"}],"from_address":"poc1d9h8qat520tl9h","to_address":"poc1da6hgur4wsyj4tqc"}}`
+	expected := `{"type":"poc/MsgEscrow","value":{"amount":[{"amount":"10","denom":"atom"}],"expire_height":"100","expire_time":"0001-01-01T00:00:00Z","from_address":"poc1d9h8qat520tl9h","to_address":"poc1da6hgur4wsyj4tqc"}}`
 	require.Equal(t, expected, string(res))
 }
 
@@ -318,17 +323,29 @@ func TestMsgEscrowGetSigners(t *testing.T) {
 	addr1 := sdk.AccAddress([]byte("input"))
 	addr2 := sdk.AccAddress([]byte("output"))
 	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
-	var msg = NewMsgEscrow(addr1, addr2, coins)
+	var msg = NewMsgEscrow(addr1, addr2, coins, 0, time.Time{})
 	res := msg.GetSigners()
 
 	require.Equal(t, []sdk.AccAddress{addr1}, res)
 }
 
+func TestMsgReleaseEscrow(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input"))
+	var msg = NewMsgReleaseEscrow(addr1, 1)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "release_escrow")
+	require.Nil(t, msg.ValidateBasic())
+	require.NotNil(t, NewMsgReleaseEscrow(sdk.AccAddress{}, 1).ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	expected := `{"type":"poc/MsgReleaseEscrow","value":{"escrow_id":"1","from_address":"poc1d9h8qat520tl9h"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
 func TestMsgReclaimRoute(t *testing.T) {
 	addr1 := sdk.AccAddress([]byte("from"))
-	addr2 := sdk.AccAddress([]byte("to"))
-	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
-	var msg = NewMsgReclaim(addr1, addr2, coins)
+	var msg = NewMsgReclaim(addr1, 1)
 
 	require.Equal(t, msg.Route(), RouterKey)
 	require.Equal(t, msg.Type(), "reclaim")
@@ -336,11 +353,6 @@ func TestMsgReclaimRoute(t *testing.T) {
 
 func TestMsgReclaimValidation(t *testing.T) {
 	addr1 := sdk.AccAddress([]byte("from"))
-	addr2 := sdk.AccAddress([]byte("to"))
-	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
-	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
-	atom123eth123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123), sdk.NewInt64Coin("eth", 123))
-	atom123eth0 := sdk.Coins{sdk.NewInt64Coin("atom", 123), sdk.NewInt64Coin("eth", 0)}
 
 	var emptyAddr sdk.AccAddress
 
@@ -348,12 +360,8 @@ func TestMsgReclaimValidation(t *testing.T) {
 		valid bool
 		tx    MsgReclaim
 	}{
-		{true, NewMsgReclaim(addr1, addr2, atom123)},       // valid send
-		{true, NewMsgReclaim(addr1, addr2, atom123eth123)}, // valid send with multiple coins
-		{false, NewMsgReclaim(addr1, addr2, atom0)},        // non positive coin
-		{false, NewMsgReclaim(addr1, addr2, atom123eth0)},  // non positive coin in multicoins
-		{false, NewMsgReclaim(emptyAddr, addr2, atom123)},  // empty from addr
-		{false, NewMsgReclaim(addr1, emptyAddr, atom123)},  // empty to addr
+		{true, NewMsgReclaim(addr1, 1)},      // valid reclaim
+		{false, NewMsgReclaim(emptyAddr, 1)}, // empty from addr
 	}
 
 	for _, tc := range cases {
@@ -368,25 +376,222 @@ func TestMsgReclaimValidation(t *testing.T) {
 
 func TestMsgReclaimGetSignBytes(t *testing.T) {
 	addr1 := sdk.AccAddress([]byte("input"))
-	addr2 := sdk.AccAddress([]byte("output"))
-	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
-	var msg = NewMsgReclaim(addr1, addr2, coins)
+	var msg = NewMsgReclaim(addr1, 1)
 	res := msg.GetSignBytes()
 
-	expected := `{"type":"poc/MsgReclaim","value":{"amount":[{"amount":"10","denom":"atom"}],"from_address":"poc1d9h8qat520tl9h","to_address":"poc1da6hgur4wsyj4tqc"}}`
+	expected := `{"type":"poc/MsgReclaim","value":{"escrow_id":"1","from_address":"poc1d9h8qat520tl9h"}}`
 	require.Equal(t, expected, string(res))
 }
 
 func TestMsgReclaimGetSigners(t *testing.T) {
 	addr1 := sdk.AccAddress([]byte("input"))
-	addr2 := sdk.AccAddress([]byte("output"))
-	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
-	var msg = NewMsgReclaim(addr1, addr2, coins)
+	var msg = NewMsgReclaim(addr1, 1)
 	res := msg.GetSigners()
 
 	require.Equal(t, []sdk.AccAddress{addr1}, res)
 }
 
+func TestMsgCreateHTLC(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input"))
+	addr2 := sdk.AccAddress([]byte("output"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+	hashLock := GetHashLock([]byte("secret"))
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		tx    MsgCreateHTLC
+	}{
+		{true, NewMsgCreateHTLC(addr1, addr2, atom123, hashLock, 100)},       // valid htlc
+		{false, NewMsgCreateHTLC(addr1, addr2, atom0, hashLock, 100)},        // non positive coin
+		{false, NewMsgCreateHTLC(emptyAddr, addr2, atom123, hashLock, 100)},  // empty from addr
+		{false, NewMsgCreateHTLC(addr1, emptyAddr, atom123, hashLock, 100)},  // empty to addr
+		{false, NewMsgCreateHTLC(addr1, addr2, atom123, hashLock[:16], 100)}, // short hash lock
+		{false, NewMsgCreateHTLC(addr1, addr2, atom123, hashLock, 0)},        // no expire height
+	}
+
+	for _, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+
+	msg := NewMsgCreateHTLC(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), hashLock, 100)
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "create_htlc")
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	expected := `{"type":"poc/MsgCreateHTLC","value":{"amount":[{"amount":"10","denom":"atom"}],"expire_height":"100","from_address":"poc1d9h8qat520tl9h","hash_lock":"2BB80D537B1DA3E38BD30361AA855686BDE0EACD7162FEF6A25FE97BF527A25B","to_address":"poc1da6hgur4wsyj4tqc"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
+func TestMsgClaimHTLC(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input"))
+	addr2 := sdk.AccAddress([]byte("output"))
+	preimage := []byte("secret")
+	htlcID := GetHTLCID(addr1, addr2, GetHashLock(preimage))
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		tx    MsgClaimHTLC
+	}{
+		{true, NewMsgClaimHTLC(addr1, htlcID, preimage)},          // valid claim
+		{false, NewMsgClaimHTLC(emptyAddr, htlcID, preimage)},     // empty from addr
+		{false, NewMsgClaimHTLC(addr1, htlcID[:16], preimage)},    // short htlc id
+		{false, NewMsgClaimHTLC(addr1, htlcID, nil)},              // empty preimage
+		{false, NewMsgClaimHTLC(addr1, htlcID, make([]byte, 65))}, // too long preimage
+	}
+
+	for _, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+
+	msg := NewMsgClaimHTLC(addr1, htlcID, preimage)
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "claim_htlc")
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	expected := `{"type":"poc/MsgClaimHTLC","value":{"from_address":"poc1d9h8qat520tl9h","id":"FA0C24A2ED725E8032C16FB2B57D3891DF1FC3C104B88736B54B06898DFB0466","preimage":"736563726574"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
+func TestMsgRefundHTLC(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input"))
+	addr2 := sdk.AccAddress([]byte("output"))
+	htlcID := GetHTLCID(addr1, addr2, GetHashLock([]byte("secret")))
+
+	msg := NewMsgRefundHTLC(addr1, htlcID)
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "refund_htlc")
+	require.Nil(t, msg.ValidateBasic())
+	require.NotNil(t, NewMsgRefundHTLC(sdk.AccAddress{}, htlcID).ValidateBasic())
+	require.NotNil(t, NewMsgRefundHTLC(addr1, htlcID[:16]).ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	expected := `{"type":"poc/MsgRefundHTLC","value":{"from_address":"poc1d9h8qat520tl9h","id":"FA0C24A2ED725E8032C16FB2B57D3891DF1FC3C104B88736B54B06898DFB0466"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
+func TestMsgCreateVestingAccount(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input"))
+	addr2 := sdk.AccAddress([]byte("output"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+	periods := authtypes.Periods{
+		{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))},
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 23))},
+	}
+
+	var emptyAddr sdk.AccAddress
+
+	mismatched := NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1000, periods)
+	mismatched.Amount = sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	withEndTime := NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1000, periods)
+	withEndTime.EndTime = 2000
+
+	cases := []struct {
+		valid bool
+		tx    MsgCreateVestingAccount
+	}{
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom123, 1000, 2000)},                // valid continuous vesting
+		{true, NewMsgCreateVestingAccount(addr1, addr2, atom123, 0, 2000)},                   // valid delayed vesting
+		{true, NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1000, periods)},              // valid periodic vesting
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom0, 1000, 2000)},                 // non positive coin
+		{false, NewMsgCreateVestingAccount(emptyAddr, addr2, atom123, 1000, 2000)},           // empty from addr
+		{false, NewMsgCreateVestingAccount(addr1, emptyAddr, atom123, 1000, 2000)},           // empty to addr
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom123, 2000, 1000)},               // end before start
+		{false, NewMsgCreateVestingAccount(addr1, addr2, atom123, 0, 0)},                     // no end time
+		{false, NewMsgCreatePeriodicVestingAccount(addr1, addr2, 1000, authtypes.Periods{})}, // no periods
+		{false, mismatched},  // amount does not match periods
+		{false, withEndTime}, // end time with periods
+	}
+
+	for _, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+
+	msg := NewMsgCreateVestingAccount(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), 1000, 2000)
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "create_vesting_account")
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	expected := `{"type":"poc/MsgCreateVestingAccount","value":{"amount":[{"amount":"10","denom":"atom"}],"end_time":"2000","from_address":"poc1d9h8qat520tl9h","start_time":"1000","to_address":"poc1da6hgur4wsyj4tqc","vesting_periods":null}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
+func TestMsgScheduleTransfer(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input"))
+	addr2 := sdk.AccAddress([]byte("output"))
+	atom123 := sdk.NewCoins(sdk.NewInt64Coin("atom", 123))
+	atom0 := sdk.NewCoins(sdk.NewInt64Coin("atom", 0))
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		tx    MsgScheduleTransfer
+	}{
+		{true, NewMsgScheduleTransfer(addr1, addr2, atom123, 10, 3, 0)},                     // valid schedule
+		{true, NewMsgScheduleTransfer(addr1, addr2, atom123, 10, 3, 100)},                   // valid schedule with start height
+		{false, NewMsgScheduleTransfer(addr1, addr2, atom0, 10, 3, 0)},                      // non positive coin
+		{false, NewMsgScheduleTransfer(emptyAddr, addr2, atom123, 10, 3, 0)},                // empty from addr
+		{false, NewMsgScheduleTransfer(addr1, emptyAddr, atom123, 10, 3, 0)},                // empty to addr
+		{false, NewMsgScheduleTransfer(addr1, addr2, atom123, 0, 3, 0)},                     // no interval
+		{false, NewMsgScheduleTransfer(addr1, addr2, atom123, MinScheduleInterval-1, 3, 0)}, // interval too short
+		{false, NewMsgScheduleTransfer(addr1, addr2, atom123, 10, MaxScheduleTimes+1, 0)},   // too many payments
+		{false, NewMsgScheduleTransfer(addr1, addr2, atom123, 10, 0, 0)},                    // no payments
+		{false, NewMsgScheduleTransfer(addr1, addr2, atom123, 10, 3, -1)},                   // negative start height
+	}
+
+	for _, tc := range cases {
+		err := tc.tx.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+
+	msg := NewMsgScheduleTransfer(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("atom", 10)), 10, 3, 100)
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "schedule_transfer")
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	expected := `{"type":"poc/MsgScheduleTransfer","value":{"amount":[{"amount":"10","denom":"atom"}],"from_address":"poc1d9h8qat520tl9h","interval":"10","start_height":"100","times":"3","to_address":"poc1da6hgur4wsyj4tqc"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
+func TestMsgCancelScheduledTransfer(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("input"))
+
+	msg := NewMsgCancelScheduledTransfer(addr1, 1)
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "cancel_scheduled_transfer")
+	require.Nil(t, msg.ValidateBasic())
+	require.NotNil(t, NewMsgCancelScheduledTransfer(sdk.AccAddress{}, 1).ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	expected := `{"type":"poc/MsgCancelScheduledTransfer","value":{"from_address":"poc1d9h8qat520tl9h","transfer_id":"1"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
 func TestMsgBonusSendRoute(t *testing.T) {
 	// Construct a MsgSend
 	addr1 := sdk.AccAddress([]byte("input"))
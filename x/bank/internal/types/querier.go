@@ -4,6 +4,31 @@ import (
 	sdk "github.com/pocblockchain/pocc/types"
 )
 
+// query endpoints supported by the bank querier for escrows
+const (
+	QueryEscrow         = "escrow"
+	QueryEscrowsByPayer = "escrows_by_payer"
+	QueryEscrowsByPayee = "escrows_by_payee"
+)
+
+// query endpoints supported by the bank querier for HTLCs
+const (
+	QueryHTLC  = "htlc"
+	QueryHTLCs = "htlcs"
+)
+
+// query endpoints supported by the bank querier for the holders of a denom
+const (
+	QueryHolders = "holders"
+	QueryHolder  = "holder"
+)
+
+// query endpoints supported by the bank querier for scheduled transfers
+const (
+	QueryScheduledTransfer          = "scheduled_transfer"
+	QueryScheduledTransfersBySender = "scheduled_transfers_by_sender"
+)
+
 // QueryBalanceParams defines the params for querying an account balance.
 type QueryBalanceParams struct {
 	Address sdk.AccAddress
@@ -13,3 +38,75 @@ type QueryBalanceParams struct {
 func NewQueryBalanceParams(addr sdk.AccAddress) QueryBalanceParams {
 	return QueryBalanceParams{Address: addr}
 }
+
+// QueryEscrowParams defines the params for querying an escrow.
+type QueryEscrowParams struct {
+	EscrowID uint64
+}
+
+// NewQueryEscrowParams creates a new instance of QueryEscrowParams.
+func NewQueryEscrowParams(escrowID uint64) QueryEscrowParams {
+	return QueryEscrowParams{EscrowID: escrowID}
+}
+
+// QueryEscrowsParams defines the params for querying the escrows of a payer or payee.
+type QueryEscrowsParams struct {
+	Address sdk.AccAddress
+}
+
+// NewQueryEscrowsParams creates a new instance of QueryEscrowsParams.
+func NewQueryEscrowsParams(addr sdk.AccAddress) QueryEscrowsParams {
+	return QueryEscrowsParams{Address: addr}
+}
+
+// QueryHTLCParams defines the params for querying an HTLC.
+type QueryHTLCParams struct {
+	ID []byte
+}
+
+// NewQueryHTLCParams creates a new instance of QueryHTLCParams.
+func NewQueryHTLCParams(id []byte) QueryHTLCParams {
+	return QueryHTLCParams{ID: id}
+}
+
+// QueryHoldersParams defines the params for querying a page of the holders of a denom.
+type QueryHoldersParams struct {
+	Denom       string
+	Page, Limit int
+}
+
+// NewQueryHoldersParams creates a new instance of QueryHoldersParams.
+func NewQueryHoldersParams(denom string, page, limit int) QueryHoldersParams {
+	return QueryHoldersParams{Denom: denom, Page: page, Limit: limit}
+}
+
+// QueryHolderParams defines the params for querying the balance of a denom held by an address.
+type QueryHolderParams struct {
+	Denom   string
+	Address sdk.AccAddress
+}
+
+// NewQueryHolderParams creates a new instance of QueryHolderParams.
+func NewQueryHolderParams(denom string, addr sdk.AccAddress) QueryHolderParams {
+	return QueryHolderParams{Denom: denom, Address: addr}
+}
+
+// QueryScheduledTransferParams defines the params for querying a scheduled transfer.
+type QueryScheduledTransferParams struct {
+	TransferID uint64
+}
+
+// NewQueryScheduledTransferParams creates a new instance of QueryScheduledTransferParams.
+func NewQueryScheduledTransferParams(transferID uint64) QueryScheduledTransferParams {
+	return QueryScheduledTransferParams{TransferID: transferID}
+}
+
+// QueryScheduledTransfersParams defines the params for querying the scheduled transfers of a sender.
+type QueryScheduledTransfersParams struct {
+	Address sdk.AccAddress
+}
+
+// NewQueryScheduledTransfersParams creates a new instance of QueryScheduledTransfersParams.
+func NewQueryScheduledTransfersParams(addr sdk.AccAddress) QueryScheduledTransfersParams {
+	return QueryScheduledTransfersParams{Address: addr}
+}
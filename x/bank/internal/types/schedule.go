@@ -0,0 +1,150 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/pocblockchain/pocc/types"
+)
+
+// DefaultStartingScheduledTransferID is the ID of the first scheduled transfer
+const DefaultStartingScheduledTransferID uint64 = 1
+
+const (
+	// MinScheduleInterval is the minimum number of blocks between two payments of a scheduled transfer
+	MinScheduleInterval int64 = 10
+
+	// MaxScheduleTimes is the maximum number of payments of a scheduled transfer
+	MaxScheduleTimes uint64 = 1000
+
+	// ScheduleExecutionGas is the gas charged when a transfer is scheduled for each of its
+	// payments, which are made in the EndBlock without gas
+	ScheduleExecutionGas uint64 = 10000
+
+	// MaxScheduledTransfersPerBlock is the maximum number of payments made in a block, the
+	// payments due beyond it are made in the next blocks
+	MaxScheduledTransfersPerBlock = 100
+
+	// MaxScheduleFailures is the number of consecutive failed payments after which a
+	// scheduled transfer is cancelled and the coins of the remaining payments refunded
+	MaxScheduleFailures uint64 = 3
+)
+
+// ScheduledTransfer defines a standing order paying amount from a sender to a recipient
+// every interval blocks, the coins of the remaining payments are locked in the schedule
+// module account. A failed payment is recorded and retried at the next interval, until
+// MaxScheduleFailures payments in a row have failed.
+type ScheduledTransfer struct {
+	TransferID          uint64         `json:"transfer_id" yaml:"transfer_id"`
+	Sender              sdk.AccAddress `json:"sender" yaml:"sender"`
+	Recipient           sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount              sdk.Coins      `json:"amount" yaml:"amount"`
+	Interval            int64          `json:"interval" yaml:"interval"`
+	Remaining           uint64         `json:"remaining" yaml:"remaining"`
+	NextHeight          int64          `json:"next_height" yaml:"next_height"`
+	Executed            uint64         `json:"executed" yaml:"executed"`
+	Failures            uint64         `json:"failures" yaml:"failures"`
+	ConsecutiveFailures uint64         `json:"consecutive_failures" yaml:"consecutive_failures"`
+	LastFailureHeight   int64          `json:"last_failure_height" yaml:"last_failure_height"` // 0 if no payment has failed
+	LastFailure         string         `json:"last_failure" yaml:"last_failure"`
+}
+
+// NewScheduledTransfer creates a new ScheduledTransfer instance, none of whose payments is made yet
+func NewScheduledTransfer(transferID uint64, sender, recipient sdk.AccAddress, amount sdk.Coins,
+	interval int64, times uint64, startHeight int64) ScheduledTransfer {
+
+	return ScheduledTransfer{
+		TransferID: transferID,
+		Sender:     sender,
+		Recipient:  recipient,
+		Amount:     amount,
+		Interval:   interval,
+		Remaining:  times,
+		NextHeight: startHeight,
+	}
+}
+
+// Locked returns the coins of the remaining payments
+func (st ScheduledTransfer) Locked() sdk.Coins {
+	return MulCoins(st.Amount, st.Remaining)
+}
+
+// Validate performs a stateless validation of the scheduled transfer
+func (st ScheduledTransfer) Validate() error {
+	if st.Sender.Empty() {
+		return fmt.Errorf("scheduled transfer %d: missing sender", st.TransferID)
+	}
+	if st.Recipient.Empty() {
+		return fmt.Errorf("scheduled transfer %d: missing recipient", st.TransferID)
+	}
+	if !st.Amount.IsValid() || !st.Amount.IsAllPositive() {
+		return fmt.Errorf("scheduled transfer %d: invalid amount %s", st.TransferID, st.Amount)
+	}
+	if st.Interval < MinScheduleInterval {
+		return fmt.Errorf("scheduled transfer %d: interval %d is less than %d", st.TransferID, st.Interval, MinScheduleInterval)
+	}
+	if st.Remaining == 0 || st.Remaining > MaxScheduleTimes {
+		return fmt.Errorf("scheduled transfer %d: remaining payments %d not in [1, %d]", st.TransferID, st.Remaining, MaxScheduleTimes)
+	}
+	if st.ConsecutiveFailures >= MaxScheduleFailures {
+		return fmt.Errorf("scheduled transfer %d: %d consecutive failures", st.TransferID, st.ConsecutiveFailures)
+	}
+	if st.NextHeight <= 0 {
+		return fmt.Errorf("scheduled transfer %d: non positive next height %d", st.TransferID, st.NextHeight)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (st ScheduledTransfer) String() string {
+	return fmt.Sprintf(`Scheduled Transfer %d:
+  Sender:               %s
+  Recipient:            %s
+  Amount:               %s
+  Interval:             %d
+  Remaining:            %d
+  Next Height:          %d
+  Executed:             %d
+  Failures:             %d
+  Consecutive Failures: %d
+  Last Failure Height:  %d
+  Last Failure:         %s`, st.TransferID, st.Sender, st.Recipient, st.Amount, st.Interval, st.Remaining,
+		st.NextHeight, st.Executed, st.Failures, st.ConsecutiveFailures, st.LastFailureHeight, st.LastFailure)
+}
+
+// ScheduledTransfers is an array of scheduled transfers
+type ScheduledTransfers []ScheduledTransfer
+
+// String implements the Stringer interface
+func (sts ScheduledTransfers) String() string {
+	if len(sts) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(sts))
+	for i, st := range sts {
+		out[i] = st.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// Total returns the sum of the locked coins
+func (sts ScheduledTransfers) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, st := range sts {
+		total = total.Add(st.Locked())
+	}
+	return total
+}
+
+// MulCoins returns coins multiplied by times
+func MulCoins(coins sdk.Coins, times uint64) sdk.Coins {
+	n := sdk.NewIntFromBigInt(new(big.Int).SetUint64(times))
+
+	product := sdk.NewCoins()
+	for _, coin := range coins {
+		product = product.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, coin.Amount.Mul(n))))
+	}
+	return product
+}
@@ -3,6 +3,7 @@ package bank
 import (
 	"fmt"
 	"math/rand"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 
@@ -216,3 +217,154 @@ func sendAndVerifyMsgMultiSend(app *baseapp.BaseApp, mapper types.AccountKeeper,
 	}
 	return nil
 }
+
+// SimulateMsgEscrow tests and runs a single msg escrow where both accounts
+// already exist. The payer reclaims the coins when the escrow expires.
+func SimulateMsgEscrow(mapper types.AccountKeeper, bk keeper.Keeper) simulation.Operation {
+	handler := NewHandler(bk)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		_, comment, sendMsg, ok := createMsgSend(r, ctx, accs, mapper)
+		if !ok {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		expireHeight := ctx.BlockHeight() + int64(simulation.RandIntBetween(r, 1, 50))
+		msg := types.NewMsgEscrow(sendMsg.FromAddress, sendMsg.ToAddress, sendMsg.Amount, expireHeight, time.Time{})
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		escrowID := bk.GetNextEscrowID(ctx)
+		initialFromAddrCoins := mapper.GetAccount(ctx, msg.FromAddress).GetCoins()
+
+		ctx, write := ctx.CacheContext()
+		ok = handler(ctx, msg).IsOK()
+		if !ok {
+			return simulation.NewOperationMsg(msg, ok, comment), nil, nil
+		}
+		write()
+
+		if !initialFromAddrCoins.Sub(msg.Amount).IsEqual(mapper.GetAccount(ctx, msg.FromAddress).GetCoins()) {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("fromAddress %s had an incorrect amount of coins", msg.FromAddress)
+		}
+
+		fOps = []simulation.FutureOperation{{BlockHeight: int(expireHeight), Op: operationSimulateMsgReclaim(bk, msg.FromAddress, escrowID)}}
+		return simulation.NewOperationMsg(msg, ok, comment), fOps, nil
+	}
+}
+
+// SimulateMsgReclaim tests and runs a msg reclaim of a random escrow of a random payer.
+func SimulateMsgReclaim(mapper types.AccountKeeper, bk keeper.Keeper) simulation.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		payer := simulation.RandomAcc(r, accs)
+		escrows := bk.GetEscrowsByPayer(ctx, payer.Address)
+		if len(escrows) == 0 {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		escrow := escrows[r.Intn(len(escrows))]
+		return operationSimulateMsgReclaim(bk, payer.Address, escrow.EscrowID)(r, app, ctx, accs)
+	}
+}
+
+func operationSimulateMsgReclaim(bk keeper.Keeper, payer sdk.AccAddress, escrowID uint64) simulation.Operation {
+	handler := NewHandler(bk)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		msg := types.NewMsgReclaim(payer, escrowID)
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		return simulation.NewOperationMsg(msg, ok, ""), nil, nil
+	}
+}
+
+// SimulateMsgBonusSend tests and runs a single input msg bonus send where both
+// accounts already exist.
+func SimulateMsgBonusSend(mapper types.AccountKeeper, bk keeper.Keeper) simulation.Operation {
+	handler := NewHandler(bk)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		_, comment, multiSendMsg, ok := createSingleInputMsgMultiSend(r, ctx, accs, mapper)
+		msg := types.NewMsgBonusSend(multiSendMsg.Inputs, multiSendMsg.Outputs)
+		opMsg = simulation.NewOperationMsg(msg, ok, comment)
+		if !ok {
+			return opMsg, nil, nil
+		}
+		err = handleAndVerifyInputsOutputs(mapper, msg, msg.Inputs, msg.Outputs, ctx, handler)
+		if err != nil {
+			return opMsg, nil, err
+		}
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgReclaimSend tests and runs a single input msg reclaim send where both
+// accounts already exist.
+func SimulateMsgReclaimSend(mapper types.AccountKeeper, bk keeper.Keeper) simulation.Operation {
+	handler := NewHandler(bk)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) (
+		opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		_, comment, multiSendMsg, ok := createSingleInputMsgMultiSend(r, ctx, accs, mapper)
+		msg := types.NewMsgReclaimSend(multiSendMsg.Inputs, multiSendMsg.Outputs)
+		opMsg = simulation.NewOperationMsg(msg, ok, comment)
+		if !ok {
+			return opMsg, nil, nil
+		}
+		err = handleAndVerifyInputsOutputs(mapper, msg, msg.Inputs, msg.Outputs, ctx, handler)
+		if err != nil {
+			return opMsg, nil, err
+		}
+		return opMsg, nil, nil
+	}
+}
+
+// Handles and verifies the transition of a msg moving coins from inputs to outputs.
+func handleAndVerifyInputsOutputs(mapper types.AccountKeeper, msg sdk.Msg, inputs []types.Input, outputs []types.Output,
+	ctx sdk.Context, handler sdk.Handler) error {
+
+	initialInputAddrCoins := make([]sdk.Coins, len(inputs))
+	initialOutputAddrCoins := make([]sdk.Coins, len(outputs))
+	for i, in := range inputs {
+		initialInputAddrCoins[i] = mapper.GetAccount(ctx, in.Address).GetCoins()
+	}
+	for i, out := range outputs {
+		initialOutputAddrCoins[i] = mapper.GetAccount(ctx, out.Address).GetCoins()
+	}
+
+	res := handler(ctx, msg)
+	if !res.IsOK() {
+		if res.Code == types.CodeSendDisabled {
+			return nil
+		}
+		return fmt.Errorf("handling msg failed %v", res)
+	}
+
+	for i, in := range inputs {
+		terminalInputCoins := mapper.GetAccount(ctx, in.Address).GetCoins()
+		if !initialInputAddrCoins[i].Sub(in.Coins).IsEqual(terminalInputCoins) {
+			return fmt.Errorf("input #%d had an incorrect amount of coins", i)
+		}
+	}
+	for i, out := range outputs {
+		terminalOutputCoins := mapper.GetAccount(ctx, out.Address).GetCoins()
+		if !terminalOutputCoins.IsEqual(initialOutputAddrCoins[i].Add(out.Coins)) {
+			return fmt.Errorf("output #%d had an incorrect amount of coins", i)
+		}
+	}
+	return nil
+}
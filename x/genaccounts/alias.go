@@ -14,12 +14,13 @@ const (
 
 var (
 	// functions aliases
-	NewGenesisAccountRaw        = types.NewGenesisAccountRaw
-	NewGenesisAccount           = types.NewGenesisAccount
-	NewGenesisAccountI          = types.NewGenesisAccountI
-	GetGenesisStateFromAppState = types.GetGenesisStateFromAppState
-	SetGenesisStateInAppState   = types.SetGenesisStateInAppState
-	ValidateGenesis             = types.ValidateGenesis
+	NewGenesisAccountRaw         = types.NewGenesisAccountRaw
+	NewPeriodicGenesisAccountRaw = types.NewPeriodicGenesisAccountRaw
+	NewGenesisAccount            = types.NewGenesisAccount
+	NewGenesisAccountI           = types.NewGenesisAccountI
+	GetGenesisStateFromAppState  = types.GetGenesisStateFromAppState
+	SetGenesisStateInAppState    = types.SetGenesisStateInAppState
+	ValidateGenesis              = types.ValidateGenesis
 
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
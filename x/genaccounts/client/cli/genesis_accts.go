@@ -11,6 +11,7 @@ import (
 	"github.com/pocblockchain/pocc/codec"
 	"github.com/pocblockchain/pocc/server"
 	sdk "github.com/pocblockchain/pocc/types"
+	authutils "github.com/pocblockchain/pocc/x/auth/client/utils"
 	"github.com/pocblockchain/pocc/x/genaccounts"
 	"github.com/pocblockchain/pocc/x/genutil"
 )
@@ -20,6 +21,7 @@ const (
 	flagVestingStart = "vesting-start-time"
 	flagVestingEnd   = "vesting-end-time"
 	flagVestingAmt   = "vesting-amount"
+	flagVestingPrds  = "vesting-periods"
 )
 
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
@@ -61,6 +63,21 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec,
 			}
 
 			genAcc := genaccounts.NewGenesisAccountRaw(addr, coins, vestingAmt, vestingStart, vestingEnd, "", "")
+
+			// periodic vesting accounts derive the vesting amount and end time from the periods
+			if periodsFile := viper.GetString(flagVestingPrds); periodsFile != "" {
+				if !vestingAmt.IsZero() || vestingEnd != 0 {
+					return fmt.Errorf("--%s cannot be combined with --%s or --%s", flagVestingPrds, flagVestingAmt, flagVestingEnd)
+				}
+
+				periods, err := authutils.ReadVestingPeriodsFromFile(periodsFile)
+				if err != nil {
+					return err
+				}
+
+				genAcc = genaccounts.NewPeriodicGenesisAccountRaw(addr, coins, vestingStart, periods)
+			}
+
 			if err := genAcc.Validate(); err != nil {
 				return err
 			}
@@ -103,5 +120,6 @@ func AddGenesisAccountCmd(ctx *server.Context, cdc *codec.Codec,
 	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
 	cmd.Flags().Uint64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
 	cmd.Flags().Uint64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingPrds, "", "path to a JSON file of vesting periods for periodic vesting accounts, e.g. [{\"length\":2592000,\"amount\":\"100poc\"}]")
 	return cmd
 }
@@ -26,6 +26,9 @@ type GenesisAccount struct {
 	StartTime        int64     `json:"start_time" yaml:"start_time"`               // vesting start time (UNIX Epoch time)
 	EndTime          int64     `json:"end_time" yaml:"end_time"`                   // vesting end time (UNIX Epoch time)
 
+	// periodic vesting account fields
+	VestingPeriods auth.Periods `json:"vesting_periods,omitempty" yaml:"vesting_periods"` // vesting schedule of periodic vesting accounts
+
 	// module account fields
 	ModuleName        string   `json:"module_name" yaml:"module_name"`               // name of the module account
 	ModulePermissions []string `json:"module_permissions" yaml:"module_permissions"` // permissions of module account
@@ -42,6 +45,18 @@ func (ga GenesisAccount) Validate() error {
 		}
 	}
 
+	if len(ga.VestingPeriods) > 0 {
+		if err := ga.VestingPeriods.Validate(); err != nil {
+			return err
+		}
+		if !ga.VestingPeriods.TotalAmount().IsEqual(ga.OriginalVesting) {
+			return errors.New("vesting amount must equal the total amount of vesting periods")
+		}
+		if ga.StartTime+ga.VestingPeriods.TotalLength() != ga.EndTime {
+			return errors.New("vesting end-time must equal start-time plus the total length of vesting periods")
+		}
+	}
+
 	// don't allow blank (i.e just whitespaces) on the module name
 	if ga.ModuleName != "" && strings.TrimSpace(ga.ModuleName) == "" {
 		return errors.New("module account name cannot be blank")
@@ -70,6 +85,17 @@ func NewGenesisAccountRaw(address sdk.AccAddress, coins,
 	}
 }
 
+// NewPeriodicGenesisAccountRaw creates a new GenesisAccount object of a periodic
+// vesting account, the vesting amount and end time are derived from the periods.
+func NewPeriodicGenesisAccountRaw(address sdk.AccAddress, coins sdk.Coins,
+	vestingStartTime int64, periods auth.Periods) GenesisAccount {
+
+	ga := NewGenesisAccountRaw(address, coins, periods.TotalAmount(), vestingStartTime,
+		vestingStartTime+periods.TotalLength(), "")
+	ga.VestingPeriods = periods
+	return ga
+}
+
 // NewGenesisAccount creates a GenesisAccount instance from a BaseAccount.
 func NewGenesisAccount(acc *auth.BaseAccount) GenesisAccount {
 	return GenesisAccount{
@@ -100,6 +126,9 @@ func NewGenesisAccountI(acc authexported.Account) (GenesisAccount, error) {
 		gacc.DelegatedVesting = acc.GetDelegatedVesting()
 		gacc.StartTime = acc.GetStartTime()
 		gacc.EndTime = acc.GetEndTime()
+		if pva, ok := acc.(*auth.PeriodicVestingAccount); ok {
+			gacc.VestingPeriods = pva.GetVestingPeriods()
+		}
 	case supplyexported.ModuleAccountI:
 		gacc.ModuleName = acc.GetName()
 		gacc.ModulePermissions = acc.GetPermissions()
@@ -120,6 +149,8 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 		)
 
 		switch {
+		case len(ga.VestingPeriods) > 0:
+			return auth.NewPeriodicVestingAccountRaw(baseVestingAcc, ga.StartTime, ga.VestingPeriods)
 		case ga.StartTime != 0 && ga.EndTime != 0:
 			return auth.NewContinuousVestingAccountRaw(baseVestingAcc, ga.StartTime)
 		case ga.EndTime != 0:
@@ -137,7 +168,7 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 	return bacc
 }
 
-//___________________________________
+// ___________________________________
 type GenesisAccounts []GenesisAccount
 
 // genesis accounts contain an address
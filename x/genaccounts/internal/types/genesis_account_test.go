@@ -51,6 +51,27 @@ func TestGenesisAccountValidate(t *testing.T) {
 				sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 1654668078, 1554668078, "", ""),
 			errors.New("vesting start-time cannot be before end-time"),
 		},
+		{
+			"valid periodic vesting account",
+			NewPeriodicGenesisAccountRaw(addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), 1554668078,
+				auth.Periods{{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))},
+					{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 30))}}),
+			nil,
+		},
+		{
+			"invalid periodic vesting end time",
+			GenesisAccount{Address: addr, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				OriginalVesting: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), StartTime: 1554668078, EndTime: 1654668078,
+				VestingPeriods: auth.Periods{{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 50))}}},
+			errors.New("vesting end-time must equal start-time plus the total length of vesting periods"),
+		},
+		{
+			"invalid periodic vesting amount",
+			GenesisAccount{Address: addr, Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)),
+				OriginalVesting: sdk.NewCoins(sdk.NewInt64Coin("stake", 50)), StartTime: 1554668078, EndTime: 1554668178,
+				VestingPeriods: auth.Periods{{Length: 100, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 40))}}},
+			errors.New("vesting amount must equal the total amount of vesting periods"),
+		},
 		{
 			"invalid module account name",
 			NewGenesisAccountRaw(addr, sdk.NewCoins(), sdk.NewCoins(), 0, 0, " ", ""),
@@ -87,6 +108,19 @@ func TestToAccount(t *testing.T) {
 	require.IsType(t, &auth.ContinuousVestingAccount{}, acc)
 	require.Equal(t, vacc, acc.(*auth.ContinuousVestingAccount))
 
+	// periodic vesting account
+	periods := auth.Periods{
+		{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))},
+		{Length: 3600, Amount: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))},
+	}
+	pacc := auth.NewPeriodicVestingAccount(&authAcc, time.Now().Unix(), periods)
+	genAcc, err = NewGenesisAccountI(pacc)
+	require.NoError(t, err)
+	require.NoError(t, genAcc.Validate())
+	acc = genAcc.ToAccount()
+	require.IsType(t, &auth.PeriodicVestingAccount{}, acc)
+	require.Equal(t, pacc, acc.(*auth.PeriodicVestingAccount))
+
 	// module account
 	macc := supply.NewEmptyModuleAccount("mint", supply.Minter)
 	genAcc, err = NewGenesisAccountI(macc)
@@ -62,6 +62,8 @@ var (
 	RegisterCodec                 = types.RegisterCodec
 	RegisterProposalTypeCodec     = types.RegisterProposalTypeCodec
 	ValidateAbstract              = types.ValidateAbstract
+	WithProposalID                = types.WithProposalID
+	ProposalIDFromContext         = types.ProposalIDFromContext
 	NewDeposit                    = types.NewDeposit
 	ErrUnknownProposal            = types.ErrUnknownProposal
 	ErrInactiveProposal           = types.ErrInactiveProposal
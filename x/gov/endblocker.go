@@ -54,7 +54,7 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 			// The proposal handler may execute state mutating logic depending
 			// on the proposal content. If the handler fails, no state mutation
 			// is written and the error message is logged.
-			res := handler(cacheCtx, proposal.Content)
+			res := handler(types.WithProposalID(cacheCtx, proposal.ProposalID), proposal.Content)
 			if res.Code == sdk.CodeOK {
 				proposal.Status = StatusPassed
 				tagValue = types.AttributeValueProposalPassed
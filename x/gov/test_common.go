@@ -67,7 +67,7 @@ func getMockApp(t *testing.T, numGenAccs int, genState GenesisState, genAccs []a
 	rtr := NewRouter().
 		AddRoute(RouterKey, ProposalHandler)
 
-	bk := bank.NewBaseKeeper(mApp.AccountKeeper, nil, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+	bk := bank.NewBaseKeeper(mApp.AccountKeeper, nil, nil, mApp.ParamsKeeper.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
 
 	maccPerms := map[string][]string{
 		types.ModuleName:          []string{supply.Burner},
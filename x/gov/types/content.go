@@ -30,6 +30,21 @@ type Content interface {
 // governance process.
 type Handler func(ctx sdk.Context, content Content) sdk.Result
 
+type proposalIDKey struct{}
+
+// WithProposalID returns a context carrying the ID of the proposal whose
+// content is being handled.
+func WithProposalID(ctx sdk.Context, proposalID uint64) sdk.Context {
+	return ctx.WithValue(proposalIDKey{}, proposalID)
+}
+
+// ProposalIDFromContext returns the ID of the proposal being handled, it is
+// not set while a proposal is validated at submission.
+func ProposalIDFromContext(ctx sdk.Context) (uint64, bool) {
+	proposalID, ok := ctx.Value(proposalIDKey{}).(uint64)
+	return proposalID, ok
+}
+
 // ValidateAbstract validates a proposal's abstract contents returning an error
 // if invalid.
 func ValidateAbstract(codespace sdk.CodespaceType, c Content) sdk.Error {
@@ -36,9 +36,9 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 		return ErrInvalidProposalContent(DefaultCodespace, "missing content")
 	}
 	if msg.Content.ProposalType() == ProposalTypeSoftwareUpgrade {
-		// Disable software upgrade proposals as they are currently equivalent
-		// to text proposals. Re-enable once a valid software upgrade proposal
-		// handler is implemented.
+		// Disable gov software upgrade proposals as they are equivalent to
+		// text proposals. Upgrades are scheduled with the proposals of the
+		// upgrade module instead.
 		return ErrInvalidProposalType(DefaultCodespace, msg.Content.ProposalType())
 	}
 	if msg.Proposer.Empty() {
@@ -1,6 +1,7 @@
 package params_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -128,3 +129,50 @@ func TestProposalHandlerUpdateOmitempty(t *testing.T) {
 	ss.Get(input.ctx, []byte(keySlashingRate), &param)
 	require.Equal(t, testParamsSlashingRate{10, 7}, param)
 }
+
+type testValidatedParams struct {
+	testParams
+}
+
+func (tp *testValidatedParams) ParamSetPairs() subspace.ParamSetPairs {
+	return tp.testParams.ParamSetPairs()
+}
+
+func (tp testValidatedParams) Validate() error {
+	if tp.MaxValidators == 0 {
+		return fmt.Errorf("MaxValidators must be positive")
+	}
+	if tp.SlashingRate.Downtime > tp.MaxValidators {
+		return fmt.Errorf("Downtime %d exceeds MaxValidators %d", tp.SlashingRate.Downtime, tp.MaxValidators)
+	}
+	return nil
+}
+
+func TestProposalHandlerValidatedParamSet(t *testing.T) {
+	input := newTestInput(t)
+	ss := input.keeper.Subspace(testSubspace).WithKeyTable(
+		params.NewKeyTable().RegisterParamSet(&testValidatedParams{}),
+	)
+	ss.SetParamSet(input.ctx, &testValidatedParams{testParams{MaxValidators: 5}})
+
+	hdlr := params.NewParamChangeProposalHandler(input.keeper)
+
+	// the update is validated against the stored parameters
+	tp := testProposal(params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`))
+	require.NotEqual(t, sdk.CodeOK, hdlr(input.ctx, tp).Code)
+	tp = testProposal(params.NewParamChange(testSubspace, keyMaxValidators, "0"))
+	require.NotEqual(t, sdk.CodeOK, hdlr(input.ctx, tp).Code)
+
+	var stored testValidatedParams
+	ss.GetParamSet(input.ctx, &stored)
+	require.Equal(t, testValidatedParams{testParams{MaxValidators: 5}}, stored)
+
+	// and with the previous changes of the proposal
+	tp = testProposal(
+		params.NewParamChange(testSubspace, keyMaxValidators, "10"),
+		params.NewParamChange(testSubspace, keySlashingRate, `{"downtime": 7}`),
+	)
+	require.Equal(t, sdk.CodeOK, hdlr(input.ctx, tp).Code)
+	ss.GetParamSet(input.ctx, &stored)
+	require.Equal(t, testValidatedParams{testParams{10, testParamsSlashingRate{0, 7}}}, stored)
+}
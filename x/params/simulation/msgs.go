@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/pocblockchain/pocc/baseapp"
@@ -73,7 +74,7 @@ var paramChangePool = []simParamChange{
 	// minting parameters
 	{
 		"mint",
-		"init_inflation_amount",
+		"InitalInflationAmount",
 		"",
 		func(r *rand.Rand) string {
 			return fmt.Sprintf("\"%s\"", simulation.ModuleParamSimulator[simulation.InitInflationAmount](r).(sdk.Dec))
@@ -82,10 +83,14 @@ var paramChangePool = []simParamChange{
 
 	{
 		"mint",
-		"inflation_factors",
+		"InflationFactorPerYear",
 		"",
 		func(r *rand.Rand) string {
-			return fmt.Sprintf("\"%s\"", simulation.ModuleParamSimulator[simulation.InitInflationAmount](r).(sdk.Dec))
+			factors := make([]string, 5)
+			for i := range factors {
+				factors[i] = fmt.Sprintf("\"%s\"", simulation.ModuleParamSimulator[simulation.InflactionFactors](r).(sdk.Dec))
+			}
+			return fmt.Sprintf("[%s]", strings.Join(factors, ","))
 		},
 	},
 
@@ -13,3 +13,10 @@ type ParamSetPairs []ParamSetPair
 type ParamSet interface {
 	ParamSetPairs() ParamSetPairs
 }
+
+// ValidatedParamSet is a ParamSet validating its parameters as a whole. An update
+// of one of its parameters by Subspace.Update is rejected if it makes it invalid.
+type ValidatedParamSet interface {
+	ParamSet
+	Validate() error
+}
@@ -1,6 +1,7 @@
 package subspace
 
 import (
+	"bytes"
 	"errors"
 	"reflect"
 
@@ -194,6 +195,12 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 		return err
 	}
 
+	if attr.paramSet != nil {
+		if err := s.validateParamSet(ctx, attr.paramSet, key, dest); err != nil {
+			return err
+		}
+	}
+
 	s.Set(ctx, key, dest)
 	tStore := s.transientStore(ctx)
 	tStore.Set(key, []byte{})
@@ -201,6 +208,20 @@ func (s Subspace) Update(ctx sdk.Context, key []byte, param []byte) error {
 	return nil
 }
 
+// validateParamSet validates the ValidatedParamSet of type ty with the stored
+// parameters and the new value of key.
+func (s Subspace) validateParamSet(ctx sdk.Context, ty reflect.Type, key []byte, value interface{}) error {
+	ps := reflect.New(ty).Interface().(ValidatedParamSet)
+	for _, pair := range ps.ParamSetPairs() {
+		if bytes.Equal(pair.Key, key) {
+			reflect.ValueOf(pair.Value).Elem().Set(reflect.ValueOf(value).Elem())
+			continue
+		}
+		s.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+	return ps.Validate()
+}
+
 // SetWithSubkey set a parameter with a key and subkey
 // Checks parameter type only over the key
 func (s Subspace) SetWithSubkey(ctx sdk.Context, key []byte, subkey []byte, param interface{}) {
@@ -6,6 +6,9 @@ import (
 
 type attribute struct {
 	ty reflect.Type
+
+	// paramSet is the type of the ValidatedParamSet the parameter belongs to, if any
+	paramSet reflect.Type
 }
 
 // KeyTable subspaces appropriate type for each parameter key
@@ -73,6 +76,19 @@ func (t KeyTable) RegisterParamSet(ps ParamSet) KeyTable {
 	for _, kvp := range ps.ParamSetPairs() {
 		t = t.RegisterType(kvp.Key, kvp.Value)
 	}
+
+	// the updates of the parameters of a ValidatedParamSet are validated against the whole set
+	if _, ok := ps.(ValidatedParamSet); ok {
+		psty := reflect.TypeOf(ps)
+		if psty.Kind() == reflect.Ptr {
+			psty = psty.Elem()
+		}
+		for _, kvp := range ps.ParamSetPairs() {
+			attr := t.m[string(kvp.Key)]
+			attr.paramSet = psty
+			t.m[string(kvp.Key)] = attr
+		}
+	}
 	return t
 }
 
@@ -128,7 +128,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initPower int64) (sdk.Context
 	)
 
 	bk := bank.NewBaseKeeper(
-		accountKeeper, nil,
+		accountKeeper, nil, nil,
 		pk.Subspace(bank.DefaultParamspace),
 		bank.DefaultCodespace,
 		blacklistedAddrs,
@@ -0,0 +1,50 @@
+package token
+
+import (
+	"fmt"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/token/types"
+)
+
+// BeginBlocker picks up TokenCacheSize changed by a param change proposal in the previous block
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	k.ResizeCache(ctx)
+}
+
+// EndBlocker returns the unclaimed amounts of the airdrops whose deadline has passed to their issuers,
+// a failed refund does not halt the chain and is retried in the next blocks
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+
+	for _, airdrop := range k.GetExpiredAirdrops(ctx) {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.RefundAirdrop(cacheCtx, airdrop); err != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeRefundAirdropFailed,
+					sdk.NewAttribute(types.AttributeKeyAirdropID, fmt.Sprintf("%d", airdrop.AirdropID)),
+					sdk.NewAttribute(types.AttributeKeyRecipient, airdrop.Issuer.String()),
+					sdk.NewAttribute(types.AttributeKeyAmount, airdrop.RemainingCoins().String()),
+					sdk.NewAttribute(types.AttributeKeyReason, err.Result().Log),
+				),
+			)
+
+			logger.Info("expired airdrop refund failed", "airdrop_id", airdrop.AirdropID, "issuer", airdrop.Issuer, "err", err.Result().Log)
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRefundAirdrop,
+				sdk.NewAttribute(types.AttributeKeyAirdropID, fmt.Sprintf("%d", airdrop.AirdropID)),
+				sdk.NewAttribute(types.AttributeKeyRecipient, airdrop.Issuer.String()),
+				sdk.NewAttribute(types.AttributeKeyAmount, airdrop.RemainingCoins().String()),
+			),
+		)
+
+		logger.Info("expired airdrop refunded", "airdrop_id", airdrop.AirdropID, "issuer", airdrop.Issuer, "amount", airdrop.RemainingCoins())
+	}
+}
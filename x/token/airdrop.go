@@ -0,0 +1,201 @@
+package token
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	supplyexported "github.com/pocblockchain/pocc/x/supply/exported"
+	"github.com/pocblockchain/pocc/x/token/types"
+)
+
+func airdropKey(airdropID uint64) []byte {
+	return append(AirdropKeyPrefix, sdk.Uint64ToBigEndian(airdropID)...)
+}
+
+// airdropQueueByTimeKey returns the prefix of the airdrops whose deadline is deadline
+func airdropQueueByTimeKey(deadline time.Time) []byte {
+	return append(AirdropQueueKeyPrefix, sdk.FormatTimeBytes(deadline)...)
+}
+
+func airdropQueueKey(airdropID uint64, deadline time.Time) []byte {
+	return append(airdropQueueByTimeKey(deadline), sdk.Uint64ToBigEndian(airdropID)...)
+}
+
+// airdropClaimsPrefix returns the prefix of all claimed leaves of an airdrop
+func airdropClaimsPrefix(airdropID uint64) []byte {
+	return append(AirdropClaimKeyPrefix, sdk.Uint64ToBigEndian(airdropID)...)
+}
+
+func airdropClaimKey(airdropID, index uint64) []byte {
+	return append(airdropClaimsPrefix(airdropID), sdk.Uint64ToBigEndian(index)...)
+}
+
+//GetNextAirdropID returns the ID of the next airdrop
+func (k *Keeper) GetNextAirdropID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(AirdropIDKey)
+	if bz == nil {
+		return types.DefaultStartingAirdropID
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+//SetNextAirdropID ...
+func (k *Keeper) SetNextAirdropID(ctx sdk.Context, airdropID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(AirdropIDKey, sdk.Uint64ToBigEndian(airdropID))
+}
+
+//CreateAirdrop locks amount of issuer in the airdrop module account for the recipients committed to by merkleRoot
+func (k *Keeper) CreateAirdrop(ctx sdk.Context, issuer sdk.AccAddress, amount sdk.Coin, merkleRoot []byte,
+	recipients uint64, deadline time.Time) (uint64, sdk.Error) {
+
+	if err := k.sk.SendCoinsFromAccountToModule(ctx, issuer, types.AirdropAccountName, sdk.NewCoins(amount)); err != nil {
+		return 0, err
+	}
+
+	airdropID := k.GetNextAirdropID(ctx)
+	k.SetAirdrop(ctx, types.NewAirdrop(airdropID, issuer, amount, merkleRoot, recipients, deadline))
+	k.SetNextAirdropID(ctx, airdropID+1)
+	return airdropID, nil
+}
+
+//ClaimAirdrop pays amount of the airdrop to recipient and marks the leaf at index claimed
+func (k *Keeper) ClaimAirdrop(ctx sdk.Context, airdrop types.Airdrop, index uint64, recipient sdk.AccAddress, amount sdk.Int) sdk.Error {
+	if amount.GT(airdrop.Remaining) {
+		return types.ErrInvalidAirdrop(fmt.Sprintf("claim of %s exceeds the remaining %s of airdrop %d", amount, airdrop.Remaining, airdrop.AirdropID))
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(airdrop.Amount.Denom, amount))
+	if err := k.sk.SendCoinsFromModuleToAccount(ctx, types.AirdropAccountName, recipient, coins); err != nil {
+		return err
+	}
+
+	airdrop.Remaining = airdrop.Remaining.Sub(amount)
+	k.SetAirdrop(ctx, airdrop)
+	k.SetAirdropClaimed(ctx, airdrop.AirdropID, index)
+	return nil
+}
+
+//RefundAirdrop returns the unclaimed amount of the airdrop to its issuer and removes the airdrop
+func (k *Keeper) RefundAirdrop(ctx sdk.Context, airdrop types.Airdrop) sdk.Error {
+	if airdrop.Remaining.IsPositive() {
+		if err := k.sk.SendCoinsFromModuleToAccount(ctx, types.AirdropAccountName, airdrop.Issuer, airdrop.RemainingCoins()); err != nil {
+			return err
+		}
+	}
+
+	k.deleteAirdrop(ctx, airdrop)
+	return nil
+}
+
+//GetAirdrop ...
+func (k *Keeper) GetAirdrop(ctx sdk.Context, airdropID uint64) (types.Airdrop, bool) {
+	var airdrop types.Airdrop
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(airdropKey(airdropID))
+	if bz == nil {
+		return airdrop, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &airdrop)
+	return airdrop, true
+}
+
+//SetAirdrop stores the airdrop and queues it by its deadline
+func (k *Keeper) SetAirdrop(ctx sdk.Context, airdrop types.Airdrop) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(airdropKey(airdrop.AirdropID), k.cdc.MustMarshalBinaryLengthPrefixed(airdrop))
+	store.Set(airdropQueueKey(airdrop.AirdropID, airdrop.Deadline), sdk.Uint64ToBigEndian(airdrop.AirdropID))
+}
+
+//deleteAirdrop removes the airdrop with its queue entry and claimed leaves
+func (k *Keeper) deleteAirdrop(ctx sdk.Context, airdrop types.Airdrop) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(airdropKey(airdrop.AirdropID))
+	store.Delete(airdropQueueKey(airdrop.AirdropID, airdrop.Deadline))
+
+	var keys [][]byte
+	iter := sdk.KVStorePrefixIterator(store, airdropClaimsPrefix(airdrop.AirdropID))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+//IterateAirdrops iterates over all the airdrops in the order of their IDs
+func (k *Keeper) IterateAirdrops(ctx sdk.Context, cb func(airdrop types.Airdrop) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, AirdropKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var airdrop types.Airdrop
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &airdrop)
+		if cb(airdrop) {
+			break
+		}
+	}
+}
+
+//GetAirdrops returns all the airdrops
+func (k *Keeper) GetAirdrops(ctx sdk.Context) types.Airdrops {
+	var airdrops types.Airdrops
+	k.IterateAirdrops(ctx, func(airdrop types.Airdrop) bool {
+		airdrops = append(airdrops, airdrop)
+		return false
+	})
+	return airdrops
+}
+
+//GetExpiredAirdrops returns the airdrops whose deadline has passed at the current block time
+func (k *Keeper) GetExpiredAirdrops(ctx sdk.Context) types.Airdrops {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(AirdropQueueKeyPrefix, sdk.PrefixEndBytes(airdropQueueByTimeKey(ctx.BlockHeader().Time)))
+	defer iter.Close()
+
+	var airdrops types.Airdrops
+	for ; iter.Valid(); iter.Next() {
+		if airdrop, found := k.GetAirdrop(ctx, binary.BigEndian.Uint64(iter.Value())); found {
+			airdrops = append(airdrops, airdrop)
+		}
+	}
+	return airdrops
+}
+
+//IsAirdropClaimed returns whether the leaf at index of the airdrop has been claimed
+func (k *Keeper) IsAirdropClaimed(ctx sdk.Context, airdropID, index uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(airdropClaimKey(airdropID, index))
+}
+
+//SetAirdropClaimed marks the leaf at index of the airdrop claimed
+func (k *Keeper) SetAirdropClaimed(ctx sdk.Context, airdropID, index uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(airdropClaimKey(airdropID, index), []byte{})
+}
+
+//GetAirdropClaims returns the claimed leaves of all airdrops
+func (k *Keeper) GetAirdropClaims(ctx sdk.Context) []types.AirdropClaim {
+	var claims []types.AirdropClaim
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, AirdropClaimKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(AirdropClaimKeyPrefix):]
+		claims = append(claims, types.NewAirdropClaim(binary.BigEndian.Uint64(key[:8]), binary.BigEndian.Uint64(key[8:])))
+	}
+	return claims
+}
+
+//GetAirdropAccount returns the airdrop module account, it is created if it does not exist
+func (k *Keeper) GetAirdropAccount(ctx sdk.Context) supplyexported.ModuleAccountI {
+	return k.sk.GetModuleAccount(ctx, types.AirdropAccountName)
+}
+
@@ -6,20 +6,46 @@ import (
 )
 
 const (
-	ModuleName        = types.ModuleName
-	RouterKey         = types.RouterKey
-	StoreKey          = types.StoreKey
-	QueryTokens       = types.QueryTokens
-	QueryToken        = types.QueryToken
-	QuerySymbols      = types.QuerySymbols
-	QueryParameters   = types.QueryParameters
-	DefaultParamspace = types.DefaultParamspace
-	DefaultCodespace  = types.DefaultCodespace
+	ModuleName          = types.ModuleName
+	RouterKey           = types.RouterKey
+	StoreKey            = types.StoreKey
+	QueryTokens         = types.QueryTokens
+	QueryToken          = types.QueryToken
+	QuerySymbols        = types.QuerySymbols
+	QueryParameters     = types.QueryParameters
+	QueryMetadata       = types.QueryMetadata
+	QueryFrozenAccounts = types.QueryFrozenAccounts
+	QueryFeeTokens      = types.QueryFeeTokens
+	QueryTokensByStatus = types.QueryTokensByStatus
+	QueryTransitions    = types.QueryTransitions
+	QueryReservations   = types.QueryReservations
+	DefaultParamspace   = types.DefaultParamspace
+	DefaultCodespace    = types.DefaultCodespace
+	QueryAirdrop        = types.QueryAirdrop
+	QueryAirdrops       = types.QueryAirdrops
+	QueryAirdropClaim   = types.QueryAirdropClaim
+	AirdropAccountName  = types.AirdropAccountName
+
+	DefaultStartingAirdropID = types.DefaultStartingAirdropID
+
+	FeeDestinationCommunityPool = types.FeeDestinationCommunityPool
+	FeeDestinationBurn          = types.FeeDestinationBurn
+	FeeDestinationFeeCollector  = types.FeeDestinationFeeCollector
 )
 
 type (
-	QueryTokenInfo = types.QueryTokenInfo
-	Params         = types.Params
+	QueryTokenInfo  = types.QueryTokenInfo
+	Params          = types.Params
+	FeeToken        = types.FeeToken
+	SymbolLengthFee = types.SymbolLengthFee
+	Reservation     = types.Reservation
+	Airdrop         = types.Airdrop
+	Airdrops        = types.Airdrops
+	AirdropClaim    = types.AirdropClaim
+	AirdropEntry    = types.AirdropEntry
+
+	TokenStatusTransition = types.TokenStatusTransition
+	QueryTokensParams     = types.QueryTokensParams
 )
 
 var (
@@ -31,4 +57,30 @@ var (
 	TokenParamsChangeProposalHandler = client.TokenParamsChangeProposalHandler
 	NewTokenParamsChangeProposal     = types.NewTokenParamsChangeProposal
 	NewDisableTokenProposal          = types.NewDisableTokenProposal
+	FeeTokenProposalHandler          = client.FeeTokenProposalHandler
+	NewFeeTokenProposal              = types.NewFeeTokenProposal
+	NewFeeToken                      = types.NewFeeToken
+	KeyFeeTokens                     = types.KeyFeeTokens
+	EnableTokenProposalHandler       = client.EnableTokenProposalHandler
+	NewEnableTokenProposal           = types.NewEnableTokenProposal
+	DelistTokenProposalHandler       = client.DelistTokenProposalHandler
+	NewDelistTokenProposal           = types.NewDelistTokenProposal
+	NewTokenStatusTransition         = types.NewTokenStatusTransition
+	NewQueryTokensParams             = types.NewQueryTokensParams
+	NewSymbolLengthFee               = types.NewSymbolLengthFee
+	KeyNewTokenFeeSchedule           = types.KeyNewTokenFeeSchedule
+	KeyInflateTokenFee               = types.KeyInflateTokenFee
+	KeyFeeDestination                = types.KeyFeeDestination
+	NewReservation                   = types.NewReservation
+	DefaultReservations              = types.DefaultReservations
+	ReserveSymbolProposalHandler     = client.ReserveSymbolProposalHandler
+	UnreserveSymbolProposalHandler   = client.UnreserveSymbolProposalHandler
+	NewReserveSymbolProposal         = types.NewReserveSymbolProposal
+	NewUnreserveSymbolProposal       = types.NewUnreserveSymbolProposal
+	NewAirdrop                       = types.NewAirdrop
+	NewAirdropClaim                  = types.NewAirdropClaim
+	NewAirdropEntry                  = types.NewAirdropEntry
+	BuildAirdropTree                 = types.BuildAirdropTree
+	NewMsgCreateAirdrop              = types.NewMsgCreateAirdrop
+	NewMsgClaimAirdrop               = types.NewMsgClaimAirdrop
 )
@@ -0,0 +1,138 @@
+package token
+
+import (
+	"bytes"
+	"container/list"
+	"sync"
+
+	sdk "github.com/pocblockchain/pocc/types"
+)
+
+/*
+ Note:
+	tokenCache is a LRU cache of decoded TokenInfoWithoutSupply shared by all copies of a Keeper.
+	An entry keeps the raw bytes it was decoded from and is only served when the store still holds
+	the same bytes. So writes discarded by a failed tx, the reset of CheckTx state on commit or a
+	rollback never leak through the cache, and the store is read exactly as before, which keeps the
+	gas consumed independent of the cache state.
+*/
+
+type tokenCacheEntry struct {
+	symbol string
+	raw    []byte
+	info   sdk.TokenInfoWithoutSupply
+}
+
+type tokenCache struct {
+	mtx   sync.Mutex
+	size  uint64
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+//newTokenCache creates a cache holding up to size tokens, 0 disables the cache
+func newTokenCache(size uint64) *tokenCache {
+	return &tokenCache{
+		size:  size,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+//get returns the cached token info if it was decoded from raw
+func (c *tokenCache) get(symbol string, raw []byte) (sdk.TokenInfoWithoutSupply, bool) {
+	if c == nil {
+		return sdk.TokenInfoWithoutSupply{}, false
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	elem, ok := c.items[symbol]
+	if !ok {
+		return sdk.TokenInfoWithoutSupply{}, false
+	}
+
+	entry := elem.Value.(*tokenCacheEntry)
+	if !bytes.Equal(entry.raw, raw) {
+		c.removeElement(elem)
+		return sdk.TokenInfoWithoutSupply{}, false
+	}
+
+	c.ll.MoveToFront(elem)
+	return entry.info, true
+}
+
+//add caches info decoded from raw, evicting the least recently used token if the cache is full
+func (c *tokenCache) add(symbol string, raw []byte, info sdk.TokenInfoWithoutSupply) {
+	if c == nil || c.size == 0 {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	rawCopy := make([]byte, len(raw))
+	copy(rawCopy, raw)
+
+	if elem, ok := c.items[symbol]; ok {
+		entry := elem.Value.(*tokenCacheEntry)
+		entry.raw = rawCopy
+		entry.info = info
+		c.ll.MoveToFront(elem)
+		return
+	}
+
+	c.items[symbol] = c.ll.PushFront(&tokenCacheEntry{symbol: symbol, raw: rawCopy, info: info})
+	for uint64(c.ll.Len()) > c.size {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+//remove drops symbol from the cache
+func (c *tokenCache) remove(symbol string) {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if elem, ok := c.items[symbol]; ok {
+		c.removeElement(elem)
+	}
+}
+
+//resize purges the cache if size changes
+func (c *tokenCache) resize(size uint64) {
+	if c == nil {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.size == size {
+		return
+	}
+
+	c.size = size
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+}
+
+func (c *tokenCache) len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.ll.Len()
+}
+
+func (c *tokenCache) removeElement(elem *list.Element) {
+	c.ll.Remove(elem)
+	delete(c.items, elem.Value.(*tokenCacheEntry).symbol)
+}
@@ -0,0 +1,115 @@
+package token
+
+import (
+	"testing"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenCacheLRU(t *testing.T) {
+	c := newTokenCache(2)
+
+	c.add("btc", []byte("btc"), sdk.TokenInfoWithoutSupply{Symbol: "btc"})
+	c.add("eth", []byte("eth"), sdk.TokenInfoWithoutSupply{Symbol: "eth"})
+	assert.Equal(t, 2, c.len())
+
+	//touch btc so that eth becomes the least recently used
+	_, ok := c.get("btc", []byte("btc"))
+	assert.True(t, ok)
+
+	c.add("eos", []byte("eos"), sdk.TokenInfoWithoutSupply{Symbol: "eos"})
+	assert.Equal(t, 2, c.len())
+	_, ok = c.get("eth", []byte("eth"))
+	assert.False(t, ok)
+	info, ok := c.get("btc", []byte("btc"))
+	assert.True(t, ok)
+	assert.Equal(t, sdk.Symbol("btc"), info.Symbol)
+
+	//entry decoded from other bytes is dropped
+	_, ok = c.get("btc", []byte("btc2"))
+	assert.False(t, ok)
+	assert.Equal(t, 1, c.len())
+
+	c.remove("eos")
+	assert.Equal(t, 0, c.len())
+
+	//resize purges the cache, size 0 disables it
+	c.add("btc", []byte("btc"), sdk.TokenInfoWithoutSupply{Symbol: "btc"})
+	c.resize(2)
+	assert.Equal(t, 1, c.len())
+	c.resize(0)
+	assert.Equal(t, 0, c.len())
+	c.add("btc", []byte("btc"), sdk.TokenInfoWithoutSupply{Symbol: "btc"})
+	assert.Equal(t, 0, c.len())
+
+	//nil cache is a no-op
+	var nilCache *tokenCache
+	nilCache.add("btc", []byte("btc"), sdk.TokenInfoWithoutSupply{Symbol: "btc"})
+	_, ok = nilCache.get("btc", []byte("btc"))
+	assert.False(t, ok)
+}
+
+func TestTokenCacheNotStale(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	ti := TestTokenData[0]
+	keeper.SetTokenInfo(ctx, &ti)
+	assert.True(t, keeper.IsSendEnabled(ctx, ti.Symbol))
+	assert.Equal(t, 1, keeper.cache.len())
+
+	//a write in a branch which is never committed, e.g. a failed tx, is not seen by the parent
+	cacheCtx, _ := ctx.CacheContext()
+	keeper.DisableSend(cacheCtx, ti.Symbol)
+	assert.False(t, keeper.IsSendEnabled(cacheCtx, ti.Symbol))
+	assert.True(t, keeper.IsSendEnabled(ctx, ti.Symbol))
+	assert.False(t, keeper.IsSendEnabled(cacheCtx, ti.Symbol))
+
+	//a committed write is seen
+	cacheCtx, write := ctx.CacheContext()
+	keeper.DisableSend(cacheCtx, ti.Symbol)
+	assert.True(t, keeper.IsSendEnabled(ctx, ti.Symbol))
+	write()
+	assert.False(t, keeper.IsSendEnabled(ctx, ti.Symbol))
+
+	//mutating the returned token info does not touch the cache
+	got := keeper.GetTokenInfoWithoutSupply(ctx, ti.Symbol)
+	got.IsSendEnabled = true
+	assert.False(t, keeper.IsSendEnabled(ctx, ti.Symbol))
+
+	keeper.DeleteTokenInfoWithoutSupply(ctx, ti.Symbol.String())
+	assert.Nil(t, keeper.GetTokenInfoWithoutSupply(ctx, ti.Symbol))
+	assert.Equal(t, 0, keeper.cache.len())
+}
+
+func TestTokenCacheResize(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	for _, ti := range TestTokenData {
+		keeper.SetTokenInfo(ctx, &ti)
+		keeper.GetTokenInfoWithoutSupply(ctx, ti.Symbol)
+	}
+	assert.Equal(t, len(TestTokenData), keeper.cache.len())
+
+	//TokenCacheSize changed by a param change proposal is picked up in BeginBlocker
+	params := keeper.GetParams(ctx)
+	params.TokenCacheSize = 1
+	keeper.paramSubSpace.SetParamSet(ctx, &params)
+	assert.Equal(t, len(TestTokenData), keeper.cache.len())
+
+	BeginBlocker(ctx, keeper)
+	assert.Equal(t, 0, keeper.cache.len())
+	for _, ti := range TestTokenData {
+		keeper.GetTokenInfoWithoutSupply(ctx, ti.Symbol)
+	}
+	assert.Equal(t, 1, keeper.cache.len())
+
+	params.TokenCacheSize = 0
+	keeper.SetParams(ctx, params)
+	keeper.GetTokenInfoWithoutSupply(ctx, TestTokenData[0].Symbol)
+	assert.Equal(t, 0, keeper.cache.len())
+}
@@ -2,12 +2,15 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/pocblockchain/pocc/client/context"
 	"github.com/pocblockchain/pocc/client/flags"
 	"github.com/pocblockchain/pocc/codec"
+	sdk "github.com/pocblockchain/pocc/types"
 	"github.com/pocblockchain/pocc/x/token/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 //GetQueryCmd ...
@@ -24,6 +27,15 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQuerySymbols(cdc),
 		GetCmdQueryTokens(cdc),
 		GetCmdQueryParams(cdc),
+		GetCmdQueryMetadata(cdc),
+		GetCmdQueryFrozenAccounts(cdc),
+		GetCmdQueryFeeTokens(cdc),
+		GetCmdQueryTokensByStatus(cdc),
+		GetCmdQueryStatusTransitions(cdc),
+		GetCmdQueryReservations(cdc),
+		GetCmdQueryAirdrop(cdc),
+		GetCmdQueryAirdrops(cdc),
+		GetCmdQueryAirdropClaim(cdc),
 	)...)
 	return tokenQueryCmd
 }
@@ -56,17 +68,79 @@ func GetCmdQueryToken(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+//GetCmdQueryMetadata ...
+func GetCmdQueryMetadata(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "metadata [symbol]",
+		Short: "token metadata",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol := args[0]
+
+			bz, err := cdc.MarshalJSON(types.QueryTokenInfo{symbol})
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QueryMetadata)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResMetadata
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+//GetCmdQueryFrozenAccounts ...
+func GetCmdQueryFrozenAccounts(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "frozen-accounts [symbol]",
+		Short: "accounts whose balance of the token is frozen",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			symbol := args[0]
+
+			bz, err := cdc.MarshalJSON(types.QueryTokenInfo{symbol})
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QueryFrozenAccounts)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResFrozenAccounts
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 //GetCmdQueryTokens ...
 func GetCmdQueryTokens(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "tokens",
 		Short: "tokens",
+		Long:  ` Example: tokens --issuer=poc1xxx --send-enabled=true --prefix=bh --limit=50 --after=bhc`,
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
+			bz, err := tokensParamsFromFlags(cdc)
+			if err != nil {
+				return err
+			}
+
 			route := fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QueryTokens)
-			res, _, err := cliCtx.QueryWithData(route, nil)
+			res, _, err := cliCtx.QueryWithData(route, bz)
 			if err != nil {
 				return err
 			}
@@ -76,19 +150,29 @@ func GetCmdQueryTokens(cdc *codec.Codec) *cobra.Command {
 			return cliCtx.PrintOutput(out)
 		},
 	}
+
+	addTokensQueryFlags(cmd)
+
+	return cmd
 }
 
 //GetCmdQuerySymbols return symbols regiserted in
 func GetCmdQuerySymbols(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "symbols",
 		Short: "symbols",
+		Long:  ` Example: symbols --issuer=poc1xxx --send-enabled=true --prefix=bh --limit=50 --after=bhc`,
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
+			bz, err := tokensParamsFromFlags(cdc)
+			if err != nil {
+				return err
+			}
+
 			route := fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QuerySymbols)
-			res, _, err := cliCtx.QueryWithData(route, nil)
+			res, _, err := cliCtx.QueryWithData(route, bz)
 			if err != nil {
 				return err
 			}
@@ -98,6 +182,46 @@ func GetCmdQuerySymbols(cdc *codec.Codec) *cobra.Command {
 			return cliCtx.PrintOutput(out)
 		},
 	}
+
+	addTokensQueryFlags(cmd)
+
+	return cmd
+}
+
+func addTokensQueryFlags(cmd *cobra.Command) {
+	addPaginationFlags(cmd)
+	cmd.Flags().String(FlagIssuer, "", "only tokens issued by this address")
+	cmd.Flags().String(FlagSendEnabled, "", "only tokens whose send is enabled (true) or disabled (false)")
+	cmd.Flags().String(FlagPrefix, "", "only tokens whose symbol starts with the prefix")
+}
+
+func addPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(FlagPage, 1, "page of the result to query, used with --limit")
+	cmd.Flags().Int(FlagLimit, 0, "number of tokens per page, 0 for all tokens")
+	cmd.Flags().String(FlagAfter, "", "only tokens whose symbol sorts after this one, e.g. the last symbol of the previous page")
+}
+
+// tokensParamsFromFlags builds the JSON encoded params of the tokens and symbols queries
+func tokensParamsFromFlags(cdc *codec.Codec) ([]byte, error) {
+	issuer := viper.GetString(FlagIssuer)
+	if issuer != "" {
+		if _, err := sdk.AccAddressFromBech32(issuer); err != nil {
+			return nil, err
+		}
+	}
+
+	var sendEnabled *bool
+	if s := viper.GetString(FlagSendEnabled); s != "" {
+		enabled, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s:%v", FlagSendEnabled, s)
+		}
+		sendEnabled = &enabled
+	}
+
+	params := types.NewQueryTokensParams(viper.GetInt(FlagPage), viper.GetInt(FlagLimit), issuer, sendEnabled,
+		viper.GetString(FlagPrefix), viper.GetString(FlagAfter))
+	return cdc.MarshalJSON(params)
 }
 
 // GetCmdQueryParams implements a command to return the current token
@@ -125,3 +249,229 @@ func GetCmdQueryParams(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryFeeTokens implements a command to return the tokens accepted
+// as transaction fee.
+func GetCmdQueryFeeTokens(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fee-tokens",
+		Short: "Query the tokens accepted as transaction fee and their rates to native token",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QueryFeeTokens)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResFeeTokens
+			if err := cdc.UnmarshalJSON(res, &out); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryTokensByStatus implements a command to return the tokens in a
+// lifecycle status.
+func GetCmdQueryTokensByStatus(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokens-by-status [status]",
+		Short: "Query the tokens in a status (Active, Paused, Delisted or Migrating)",
+		Long:  ` Example: tokens-by-status Paused --limit=50 --after=bhc`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			status, err := sdk.TokenStatusFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryTokensByStatusParams(status, viper.GetInt(FlagPage), viper.GetInt(FlagLimit), viper.GetString(FlagAfter))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QueryTokensByStatus)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResTokens
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	addPaginationFlags(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryStatusTransitions implements a command to return the status
+// transitions of a token.
+func GetCmdQueryStatusTransitions(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status-transitions [symbol]",
+		Short: "Query when and by which proposal the status of a token changed",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryTokenInfo(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QueryTransitions)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResTransitions
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryReservations implements a command to return the reserved symbols
+// with their claimants and expire times.
+func GetCmdQueryReservations(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reservations",
+		Short: "Query the reserved symbols, their claimants and expire times",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QueryReservations)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.Reservations
+			if err := cdc.UnmarshalJSON(res, &out); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryAirdrop implements a command to return an airdrop by its ID.
+func GetCmdQueryAirdrop(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "airdrop [airdrop-id]",
+		Short: "Query an airdrop and its unclaimed amount",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			airdropID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid airdrop id %v: %v", args[0], err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAirdropParams(airdropID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QueryAirdrop)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Airdrop
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryAirdrops implements a command to return all airdrops, or the
+// airdrops of an issuer.
+func GetCmdQueryAirdrops(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "airdrops [issuer]",
+		Short: "Query the airdrops not refunded yet, optionally of an issuer",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			var issuer sdk.AccAddress
+			if len(args) == 1 {
+				addr, err := sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				issuer = addr
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAirdropsParams(issuer))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QueryAirdrops)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.Airdrops
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryAirdropClaim implements a command to return whether a leaf of
+// an airdrop has been claimed.
+func GetCmdQueryAirdropClaim(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "airdrop-claim [airdrop-id] [index]",
+		Short: "Query whether the leaf at index of an airdrop has been claimed",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			airdropID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid airdrop id %v: %v", args[0], err)
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid index %v: %v", args[1], err)
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAirdropClaimParams(airdropID, index))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.RouterKey, types.QueryAirdropClaim)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var out types.QueryResAirdropClaim
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
@@ -1,11 +1,15 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 	"github.com/pocblockchain/pocc/client"
 	"github.com/pocblockchain/pocc/x/auth"
 	"github.com/pocblockchain/pocc/x/auth/client/utils"
+	"io/ioutil"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pocblockchain/pocc/client/context"
 	"github.com/pocblockchain/pocc/codec"
@@ -14,6 +18,26 @@ import (
 	govtype "github.com/pocblockchain/pocc/x/gov/types"
 	"github.com/pocblockchain/pocc/x/token/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	FlagRequireAcceptance = "require-acceptance"
+	FlagMaxSupply         = "max-supply"
+	FlagMintable          = "mintable"
+	FlagBurnable          = "burnable"
+	FlagFreezable         = "freezable"
+	FlagPausable          = "pausable"
+	FlagName              = "name"
+	FlagDescription       = "description"
+	FlagLogoURI           = "logo-uri"
+	FlagWebsite           = "website"
+	FlagPage              = "page"
+	FlagLimit             = "limit"
+	FlagIssuer            = "issuer"
+	FlagSendEnabled       = "send-enabled"
+	FlagPrefix            = "prefix"
+	FlagAfter             = "after"
 )
 
 func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
@@ -29,7 +53,18 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdNewToken(cdc),
 		GetCmdInflateToken(cdc),
 		GetCmdBurnToken(cdc),
+		GetCmdTransferTokenOwnership(cdc),
+		GetCmdAcceptTokenOwnership(cdc),
+		GetCmdRenounceTokenMint(cdc),
+		GetCmdEditTokenMetadata(cdc),
+		GetCmdFreezeAccount(cdc),
+		GetCmdUnfreezeAccount(cdc),
+		GetCmdPauseToken(cdc),
+		GetCmdUnpauseToken(cdc),
+		GetCmdCreateAirdrop(cdc),
+		GetCmdClaimAirdrop(cdc),
 	)...)
+	txCmd.AddCommand(GetCmdAirdropTree(cdc))
 
 	return txCmd
 }
@@ -39,7 +74,7 @@ func GetCmdNewToken(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "new [to][symbol][decimals][totalSupply]",
 		Short: "new a token",
-		Long:  ` Example: new-token poc1xxx bhetc 18 1000000000000000000000000000`,
+		Long:  ` Example: new-token poc1xxx bhetc 18 1000000000000000000000000000 --max-supply=2000000000000000000000000000 --mintable=true --burnable=false --freezable=true --pausable=true`,
 
 		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -69,12 +104,28 @@ func GetCmdNewToken(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("Fail to parse totalSupply:%v", args[3])
 			}
 
+			maxSupply, ok := sdk.NewIntFromString(viper.GetString(FlagMaxSupply))
+			if !ok {
+				return fmt.Errorf("Fail to parse maxSupply:%v", viper.GetString(FlagMaxSupply))
+			}
+
 			from := cliCtx.GetFromAddress()
-			msg := types.NewMsgNewToken(from, to, symbol.String(), uint64(decimals.Int64()), totalSupply)
+			msg := types.NewMsgNewToken(from, to, symbol.String(), uint64(decimals.Int64()), totalSupply,
+				maxSupply, viper.GetBool(FlagMintable), viper.GetBool(FlagBurnable),
+				viper.GetBool(FlagFreezable), viper.GetBool(FlagPausable), metadataFromFlags())
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
+	cmd.Flags().String(FlagMaxSupply, "0", "upper bound of the token's total supply, 0 means no cap")
+	cmd.Flags().Bool(FlagMintable, true, "whether the token can be inflated by the issuer")
+	cmd.Flags().Bool(FlagBurnable, true, "whether the token can be burned by holders")
+	cmd.Flags().Bool(FlagFreezable, false, "whether the issuer can freeze a holder's balance of the token")
+	cmd.Flags().Bool(FlagPausable, false, "whether the issuer can pause all transfers of the token")
+	cmd.Flags().String(FlagName, "", "full name of the token")
+	cmd.Flags().String(FlagDescription, "", "description of the token")
+	cmd.Flags().String(FlagLogoURI, "", "URI of the token's logo")
+	cmd.Flags().String(FlagWebsite, "", "website of the token's project")
 	cmd.MarkFlagRequired(client.FlagFrom)
 
 	return cmd
@@ -141,6 +192,259 @@ func GetCmdBurnToken(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+//transfer a token's ownership to another address
+func GetCmdTransferTokenOwnership(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-ownership [to][symbol]",
+		Short: "transfer a token's ownership",
+		Long:  ` Example: transfer-ownership poc1xxx bhetc --require-acceptance --from alice`,
+
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			symbol := sdk.Symbol(args[1])
+			if !symbol.IsValidTokenName() {
+				return fmt.Errorf("%v is not a valid token name", args[1])
+			}
+
+			from := cliCtx.GetFromAddress()
+			msg := types.NewMsgTransferTokenOwnership(from, to, symbol.String(), viper.GetBool(FlagRequireAcceptance))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Bool(FlagRequireAcceptance, false, "new owner must accept the ownership before it takes effect")
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+//accept a token's ownership transferred with --require-acceptance
+func GetCmdAcceptTokenOwnership(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-ownership [symbol]",
+		Short: "accept a token's ownership",
+		Long:  ` Example: accept-ownership bhetc --from bob`,
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			symbol := sdk.Symbol(args[0])
+			if !symbol.IsValidTokenName() {
+				return fmt.Errorf("%v is not a valid token name", args[0])
+			}
+
+			from := cliCtx.GetFromAddress()
+			msg := types.NewMsgAcceptTokenOwnership(from, symbol.String())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+//renounce a token's mint permanently
+func GetCmdRenounceTokenMint(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renounce-mint [symbol]",
+		Short: "renounce a token's mint permanently",
+		Long:  ` Example: renounce-mint bhetc --from alice`,
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			symbol := sdk.Symbol(args[0])
+			if !symbol.IsValidTokenName() {
+				return fmt.Errorf("%v is not a valid token name", args[0])
+			}
+
+			from := cliCtx.GetFromAddress()
+			msg := types.NewMsgRenounceTokenMint(from, symbol.String())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+//edit a token's metadata, fields not provided are left unchanged
+func GetCmdEditTokenMetadata(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit-metadata [symbol]",
+		Short: "edit a token's metadata, only flags provided are modified",
+		Long:  ` Example: edit-metadata bhetc --website=https://example.com --from alice`,
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			symbol := sdk.Symbol(args[0])
+			if !symbol.IsValidTokenName() {
+				return fmt.Errorf("%v is not a valid token name", args[0])
+			}
+
+			from := cliCtx.GetFromAddress()
+			msg := types.NewMsgEditTokenMetadata(from, symbol.String(), metadataFromFlags())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagName, sdk.DoNotModifyTokenMetadata, "full name of the token")
+	cmd.Flags().String(FlagDescription, sdk.DoNotModifyTokenMetadata, "description of the token")
+	cmd.Flags().String(FlagLogoURI, sdk.DoNotModifyTokenMetadata, "URI of the token's logo")
+	cmd.Flags().String(FlagWebsite, sdk.DoNotModifyTokenMetadata, "website of the token's project")
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+//freeze an account's balance of a token
+func GetCmdFreezeAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [target][symbol]",
+		Short: "freeze an account's balance of a token",
+		Long:  ` Example: freeze poc1xxx bhetc --from alice`,
+
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			target, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			symbol := sdk.Symbol(args[1])
+			if !symbol.IsValidTokenName() {
+				return fmt.Errorf("%v is not a valid token name", args[1])
+			}
+
+			from := cliCtx.GetFromAddress()
+			msg := types.NewMsgFreezeAccount(from, target, symbol.String())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+//unfreeze an account's balance of a token
+func GetCmdUnfreezeAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze [target][symbol]",
+		Short: "unfreeze an account's balance of a token",
+		Long:  ` Example: unfreeze poc1xxx bhetc --from alice`,
+
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			target, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			symbol := sdk.Symbol(args[1])
+			if !symbol.IsValidTokenName() {
+				return fmt.Errorf("%v is not a valid token name", args[1])
+			}
+
+			from := cliCtx.GetFromAddress()
+			msg := types.NewMsgUnfreezeAccount(from, target, symbol.String())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+//pause all transfers of a token
+func GetCmdPauseToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [symbol]",
+		Short: "pause all transfers of a token",
+		Long:  ` Example: pause bhetc --from alice`,
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			symbol := sdk.Symbol(args[0])
+			if !symbol.IsValidTokenName() {
+				return fmt.Errorf("%v is not a valid token name", args[0])
+			}
+
+			from := cliCtx.GetFromAddress()
+			msg := types.NewMsgPauseToken(from, symbol.String())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+//unpause a token paused by its issuer
+func GetCmdUnpauseToken(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause [symbol]",
+		Short: "resume transfers of a token paused by its issuer",
+		Long:  ` Example: unpause bhetc --from alice`,
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			symbol := sdk.Symbol(args[0])
+			if !symbol.IsValidTokenName() {
+				return fmt.Errorf("%v is not a valid token name", args[0])
+			}
+
+			from := cliCtx.GetFromAddress()
+			msg := types.NewMsgUnpauseToken(from, symbol.String())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+func metadataFromFlags() sdk.TokenMetadata {
+	return sdk.NewTokenMetadata(
+		viper.GetString(FlagName),
+		viper.GetString(FlagDescription),
+		viper.GetString(FlagLogoURI),
+		viper.GetString(FlagWebsite),
+	)
+}
+
 // GetCmdTokenParamsChangeProposal implements the command to submit a TokenParamsChange proposal
 func GetCmdTokenParamsChangeProposal(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -164,6 +468,22 @@ Where proposal.json contains:
       "key": "is_send_enabled",
       "value": true
     },
+    {
+      "key": "max_supply",
+      "value": "21000000000000000"
+    },
+    {
+      "key": "mintable",
+      "value": false
+    },
+    {
+      "key": "burnable",
+      "value": true
+    },
+    {
+      "key": "freezable",
+      "value": false
+    }
   ],
   "deposit": [
     {
@@ -251,3 +571,429 @@ Where proposal.json contains:
 
 	return cmd
 }
+
+// GetCmdFeeTokenProposal implements the command to submit a FeeToken proposal
+func GetCmdFeeTokenProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-token [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a fee token proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to accept a token as transaction fee along with an initial deposit.
+The rate is the amount of native token one unit of the token is worth, a zero rate
+removes the token from the fee token whitelist.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal fee-token <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Fee Token",
+  "description": "accept testtoken as transaction fee",
+  "symbol": "testtoken",
+  "rate": "0.5",
+  "deposit": [
+    {
+      "denom": "hbc",
+      "amount": "100000"
+    }
+  ]
+}
+`, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseFeeTokenProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewFeeTokenProposal(proposal.Title, proposal.Description, proposal.Symbol, proposal.Rate)
+
+			msg := govtype.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdEnableTokenProposal implements the command to submit an EnableToken proposal
+func GetCmdEnableTokenProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-token [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an enable token proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to make a paused, delisted or migrating token active again
+along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal enable-token <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Enable Token",
+  "description": "enable token proposal",
+  "symbol": "testtoken",
+  "deposit": [
+    {
+      "denom": "hbc",
+      "amount": "100000"
+    }
+  ]
+}
+`, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseEnableTokenProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewEnableTokenProposal(proposal.Title, proposal.Description, proposal.Symbol)
+
+			msg := govtype.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdDelistTokenProposal implements the command to submit a DelistToken proposal
+func GetCmdDelistTokenProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delist-token [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a delist token proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to delist a token along with an initial deposit.
+A delisted token can only be burned. If a successor is given the token is
+migrating instead, its holders can still send and burn it.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal delist-token <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Delist Token",
+  "description": "migrate testtoken to newtoken",
+  "symbol": "testtoken",
+  "successor": "newtoken",
+  "deposit": [
+    {
+      "denom": "hbc",
+      "amount": "100000"
+    }
+  ]
+}
+`, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseDelistTokenProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewDelistTokenProposal(proposal.Title, proposal.Description, proposal.Symbol, proposal.Successor)
+
+			msg := govtype.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdReserveSymbolProposal implements the command to submit a ReserveSymbol proposal
+func GetCmdReserveSymbolProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reserve-symbol [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a reserve symbol proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to reserve a symbol which is not issued yet along with an initial deposit.
+Only the claimant can issue a reserved symbol, an empty claimant keeps everyone from issuing it.
+The reservation ends once the expire time is reached, a zero expire time never expires.
+Reserving an already reserved symbol replaces its reservation.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal reserve-symbol <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Reserve Symbol",
+  "description": "reserve testtoken for its project team",
+  "symbol": "testtoken",
+  "claimant": "poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr",
+  "expire_time": "2020-01-01T00:00:00Z",
+  "deposit": [
+    {
+      "denom": "hbc",
+      "amount": "100000"
+    }
+  ]
+}
+`, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseReserveSymbolProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewReserveSymbolProposal(proposal.Title, proposal.Description, proposal.Symbol, proposal.Claimant, proposal.ExpireTime)
+
+			msg := govtype.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdUnreserveSymbolProposal implements the command to submit an UnreserveSymbol proposal
+func GetCmdUnreserveSymbolProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unreserve-symbol [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit an unreserve symbol proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to remove the reservation of a symbol along with an initial deposit.
+Anyone can issue the symbol once its reservation is removed.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal unreserve-symbol <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Unreserve Symbol",
+  "description": "release testtoken",
+  "symbol": "testtoken",
+  "deposit": [
+    {
+      "denom": "hbc",
+      "amount": "100000"
+    }
+  ]
+}
+`, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseUnreserveSymbolProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewUnreserveSymbolProposal(proposal.Title, proposal.Description, proposal.Symbol)
+
+			msg := govtype.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+//lock an amount of a token for the recipients of a merkle tree
+func GetCmdCreateAirdrop(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-airdrop [amount] [merkle-root] [recipients] [deadline]",
+		Short: "lock an amount of a token for the recipients committed to by a merkle root until a deadline",
+		Long: strings.TrimSpace(`Lock an amount of a token in the airdrop module account, only the issuer of the token can do it.
+The recipients of the merkle tree built by airdrop-tree can claim their amounts until the deadline,
+after which the unclaimed amount returns to the issuer.
+
+Example: create-airdrop 1000000bhetc 9C6F...E1A2 3 2020-01-01T00:00:00Z --from alice`),
+
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+
+			merkleRoot, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid merkle root %v: %v", args[1], err)
+			}
+
+			recipients, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid recipients %v: %v", args[2], err)
+			}
+
+			deadline, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return fmt.Errorf("invalid deadline %v: %v", args[3], err)
+			}
+
+			from := cliCtx.GetFromAddress()
+			msg := types.NewMsgCreateAirdrop(from, amount, merkleRoot, recipients, deadline)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+//claim the amount of an airdrop committed to the sender
+func GetCmdClaimAirdrop(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-airdrop [airdrop-id] [index] [amount] [proof]",
+		Short: "claim the amount committed to the sender by a leaf of an airdrop",
+		Long: strings.TrimSpace(`Claim the amount committed to the sender by the leaf at index of an airdrop,
+the proof is the comma separated hex aunts of the leaf printed by airdrop-tree,
+it is empty if the airdrop has a single recipient.
+
+Example: claim-airdrop 1 0 1000 5B1D...0C3E,77A0...9F12 --from bob`),
+
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			airdropID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid airdrop id %v: %v", args[0], err)
+			}
+
+			index, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid index %v: %v", args[1], err)
+			}
+
+			amount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount %v", args[2])
+			}
+
+			var proof [][]byte
+			if len(args) == 4 && args[3] != "" {
+				for _, s := range strings.Split(args[3], ",") {
+					aunt, err := hex.DecodeString(s)
+					if err != nil {
+						return fmt.Errorf("invalid proof %v: %v", s, err)
+					}
+					proof = append(proof, aunt)
+				}
+			}
+
+			from := cliCtx.GetFromAddress()
+			msg := types.NewMsgClaimAirdrop(from, airdropID, index, amount, proof)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.MarkFlagRequired(client.FlagFrom)
+
+	return cmd
+}
+
+//build the merkle tree of an airdrop offline
+func GetCmdAirdropTree(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "airdrop-tree [entries-file]",
+		Short: "print the merkle root of airdrop entries and the proof of every entry",
+		Long: strings.TrimSpace(`Build the merkle tree of the airdrop entries in a JSON file offline. The issuer creates
+the airdrop with the merkle root and the number of entries, every recipient claims with
+the index, amount and proof of its entry.
+
+Example: airdrop-tree entries.json
+
+Where entries.json contains:
+
+[
+  {"recipient": "poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr", "amount": "1000"},
+  {"recipient": "poc1kkkjfhv7t4swrurnftedsx0lngvc523cfa00s3", "amount": "2000"}
+]`),
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			contents, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var entries []types.AirdropEntry
+			if err := cdc.UnmarshalJSON(contents, &entries); err != nil {
+				return err
+			}
+			if len(entries) == 0 {
+				return fmt.Errorf("no airdrop entries in %v", args[0])
+			}
+
+			root, proofs := types.BuildAirdropTree(entries)
+			fmt.Printf("merkle root: %X\nrecipients:  %d\n", root, len(entries))
+			for i, e := range entries {
+				aunts := make([]string, len(proofs[i].Aunts))
+				for j, aunt := range proofs[i].Aunts {
+					aunts[j] = fmt.Sprintf("%X", aunt)
+				}
+				fmt.Printf("%d %s %s %s\n", i, e.Recipient, e.Amount, strings.Join(aunts, ","))
+			}
+			return nil
+		},
+	}
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/json"
 	"io/ioutil"
+	"time"
 
 	"github.com/pocblockchain/pocc/codec"
 	sdk "github.com/pocblockchain/pocc/types"
@@ -40,6 +41,45 @@ type (
 		Symbol      string    `json:"symbol" yaml:"symbol"`
 		Deposit     sdk.Coins `json:"deposit" yaml:"deposit`
 	}
+
+	FeeTokenProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		Symbol      string    `json:"symbol" yaml:"symbol"`
+		Rate        sdk.Dec   `json:"rate" yaml:"rate"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
+
+	EnableTokenProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		Symbol      string    `json:"symbol" yaml:"symbol"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
+
+	DelistTokenProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		Symbol      string    `json:"symbol" yaml:"symbol"`
+		Successor   string    `json:"successor" yaml:"successor"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
+
+	ReserveSymbolProposalJSON struct {
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Symbol      string         `json:"symbol" yaml:"symbol"`
+		Claimant    sdk.AccAddress `json:"claimant" yaml:"claimant"`
+		ExpireTime  time.Time      `json:"expire_time" yaml:"expire_time"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	UnreserveSymbolProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		Symbol      string    `json:"symbol" yaml:"symbol"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
 )
 
 // ToParamChange converts a ParamChangeJSON object to ParamChange.
@@ -86,3 +126,78 @@ func ParseDisableTokenProposalJSON(cdc *codec.Codec, proposalFile string) (Disab
 
 	return proposal, nil
 }
+
+// ParseFeeTokenProposalJSON reads and parses a feeTokenProposalJSON from a file.
+func ParseFeeTokenProposalJSON(cdc *codec.Codec, proposalFile string) (FeeTokenProposalJSON, error) {
+	proposal := FeeTokenProposalJSON{}
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseEnableTokenProposalJSON reads and parses an enableTokenProposalJSON from a file.
+func ParseEnableTokenProposalJSON(cdc *codec.Codec, proposalFile string) (EnableTokenProposalJSON, error) {
+	proposal := EnableTokenProposalJSON{}
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseDelistTokenProposalJSON reads and parses a delistTokenProposalJSON from a file.
+func ParseDelistTokenProposalJSON(cdc *codec.Codec, proposalFile string) (DelistTokenProposalJSON, error) {
+	proposal := DelistTokenProposalJSON{}
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseReserveSymbolProposalJSON reads and parses a reserveSymbolProposalJSON from a file.
+func ParseReserveSymbolProposalJSON(cdc *codec.Codec, proposalFile string) (ReserveSymbolProposalJSON, error) {
+	proposal := ReserveSymbolProposalJSON{}
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseUnreserveSymbolProposalJSON reads and parses an unreserveSymbolProposalJSON from a file.
+func ParseUnreserveSymbolProposalJSON(cdc *codec.Codec, proposalFile string) (UnreserveSymbolProposalJSON, error) {
+	proposal := UnreserveSymbolProposalJSON{}
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -10,4 +10,9 @@ import (
 var (
 	DisableTokenProposalHandler      = govclient.NewProposalHandler(cli.GetCmdDisableTokenProposal, rest.DisableTokenProposalRESTHandler)
 	TokenParamsChangeProposalHandler = govclient.NewProposalHandler(cli.GetCmdTokenParamsChangeProposal, rest.TokenParamsChangeProposalRESTHandler)
+	FeeTokenProposalHandler          = govclient.NewProposalHandler(cli.GetCmdFeeTokenProposal, rest.FeeTokenProposalRESTHandler)
+	EnableTokenProposalHandler       = govclient.NewProposalHandler(cli.GetCmdEnableTokenProposal, rest.EnableTokenProposalRESTHandler)
+	DelistTokenProposalHandler       = govclient.NewProposalHandler(cli.GetCmdDelistTokenProposal, rest.DelistTokenProposalRESTHandler)
+	ReserveSymbolProposalHandler     = govclient.NewProposalHandler(cli.GetCmdReserveSymbolProposal, rest.ReserveSymbolProposalRESTHandler)
+	UnreserveSymbolProposalHandler   = govclient.NewProposalHandler(cli.GetCmdUnreserveSymbolProposal, rest.UnreserveSymbolProposalRESTHandler)
 )
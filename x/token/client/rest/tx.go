@@ -0,0 +1,396 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"github.com/pocblockchain/pocc/client/context"
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/types/rest"
+	"github.com/pocblockchain/pocc/x/auth/client/utils"
+	"github.com/pocblockchain/pocc/x/token/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/token/{symbol}/transfer_ownership", transferOwnershipHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/token/{symbol}/accept_ownership", acceptOwnershipHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/token/{symbol}/renounce_mint", renounceMintHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/token/{symbol}/metadata", editMetadataHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/token/{symbol}/freeze", freezeAccountHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/token/{symbol}/unfreeze", unfreezeAccountHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/token/{symbol}/pause", pauseTokenHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/token/{symbol}/unpause", unpauseTokenHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/token/airdrops", createAirdropHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/token/airdrops/{airdropID}/claim", claimAirdropHandlerFn(cliCtx)).Methods("POST")
+}
+
+// TransferOwnershipReq defines the properties of a transfer ownership request's body.
+type TransferOwnershipReq struct {
+	BaseReq           rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	To                sdk.AccAddress `json:"to" yaml:"to"`
+	RequireAcceptance bool           `json:"require_acceptance" yaml:"require_acceptance"`
+}
+
+// EditMetadataReq defines the properties of an edit metadata request's body.
+type EditMetadataReq struct {
+	BaseReq  rest.BaseReq       `json:"base_req" yaml:"base_req"`
+	Metadata EditMetadataFields `json:"metadata" yaml:"metadata"`
+}
+
+// EditMetadataFields defines the metadata fields of an edit metadata request,
+// the fields omitted from the request are left unchanged.
+type EditMetadataFields struct {
+	Name        *string `json:"name" yaml:"name"`
+	Description *string `json:"description" yaml:"description"`
+	LogoURI     *string `json:"logo_uri" yaml:"logo_uri"`
+	Website     *string `json:"website" yaml:"website"`
+}
+
+// TokenMetadata returns the metadata update of the fields, the omitted ones
+// being set to sdk.DoNotModifyTokenMetadata.
+func (f EditMetadataFields) TokenMetadata() sdk.TokenMetadata {
+	field := func(value *string) string {
+		if value == nil {
+			return sdk.DoNotModifyTokenMetadata
+		}
+		return *value
+	}
+	return sdk.NewTokenMetadata(field(f.Name), field(f.Description), field(f.LogoURI), field(f.Website))
+}
+
+// FreezeAccountReq defines the properties of a freeze or unfreeze request's body.
+type FreezeAccountReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Target  sdk.AccAddress `json:"target" yaml:"target"`
+}
+
+// IssuerReq defines the properties of a request's body which only needs the issuer's signature.
+type IssuerReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
+
+// CreateAirdropReq defines the properties of a create airdrop request's body.
+type CreateAirdropReq struct {
+	BaseReq    rest.BaseReq `json:"base_req" yaml:"base_req"`
+	Amount     sdk.Coin     `json:"amount" yaml:"amount"`
+	MerkleRoot cmn.HexBytes `json:"merkle_root" yaml:"merkle_root"`
+	Recipients uint64       `json:"recipients" yaml:"recipients"`
+	Deadline   time.Time    `json:"deadline" yaml:"deadline"`
+}
+
+// ClaimAirdropReq defines the properties of a claim airdrop request's body.
+type ClaimAirdropReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Index   uint64         `json:"index" yaml:"index"`
+	Amount  sdk.Int        `json:"amount" yaml:"amount"`
+	Proof   []cmn.HexBytes `json:"proof" yaml:"proof"`
+}
+
+func transferOwnershipHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := mux.Vars(r)["symbol"]
+
+		var req TransferOwnershipReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgTransferTokenOwnership(fromAddr, req.To, symbol, req.RequireAcceptance)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func acceptOwnershipHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := mux.Vars(r)["symbol"]
+
+		var req IssuerReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgAcceptTokenOwnership(fromAddr, symbol)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func renounceMintHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := mux.Vars(r)["symbol"]
+
+		var req IssuerReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRenounceTokenMint(fromAddr, symbol)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func editMetadataHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := mux.Vars(r)["symbol"]
+
+		var req EditMetadataReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgEditTokenMetadata(fromAddr, symbol, req.Metadata.TokenMetadata())
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func freezeAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := mux.Vars(r)["symbol"]
+
+		var req FreezeAccountReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgFreezeAccount(fromAddr, req.Target, symbol)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func unfreezeAccountHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := mux.Vars(r)["symbol"]
+
+		var req FreezeAccountReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgUnfreezeAccount(fromAddr, req.Target, symbol)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func pauseTokenHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := mux.Vars(r)["symbol"]
+
+		var req IssuerReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgPauseToken(fromAddr, symbol)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func unpauseTokenHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		symbol := mux.Vars(r)["symbol"]
+
+		var req IssuerReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgUnpauseToken(fromAddr, symbol)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func createAirdropHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateAirdropReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCreateAirdrop(fromAddr, req.Amount, req.MerkleRoot, req.Recipients, req.Deadline)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func claimAirdropHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		airdropID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)["airdropID"])
+		if !ok {
+			return
+		}
+
+		var req ClaimAirdropReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		proof := make([][]byte, len(req.Proof))
+		for i, aunt := range req.Proof {
+			proof[i] = aunt
+		}
+
+		msg := types.NewMsgClaimAirdrop(fromAddr, airdropID, req.Index, req.Amount, proof)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
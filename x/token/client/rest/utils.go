@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"time"
+
 	sdk "github.com/pocblockchain/pocc/types"
 	"github.com/pocblockchain/pocc/types/rest"
 	"github.com/pocblockchain/pocc/x/token/client/cli"
@@ -29,4 +31,63 @@ type (
 		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit`
 		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
 	}
+
+	// FeeTokenProposalReq defines a fee token request body.
+	FeeTokenProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Symbol      string         `json:"symbol" yaml:"symbol"`
+		Rate        sdk.Dec        `json:"rate" yaml:"rate"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	}
+
+	// EnableTokenProposalReq defines an enable token request body.
+	EnableTokenProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Symbol      string         `json:"symbol" yaml:"symbol"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	}
+
+	// DelistTokenProposalReq defines a delist token request body.
+	DelistTokenProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Symbol      string         `json:"symbol" yaml:"symbol"`
+		Successor   string         `json:"successor" yaml:"successor"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	}
+
+	// ReserveSymbolProposalReq defines a reserve symbol request body.
+	ReserveSymbolProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Symbol      string         `json:"symbol" yaml:"symbol"`
+		Claimant    sdk.AccAddress `json:"claimant" yaml:"claimant"`
+		ExpireTime  time.Time      `json:"expire_time" yaml:"expire_time"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	}
+
+	// UnreserveSymbolProposalReq defines an unreserve symbol request body.
+	UnreserveSymbolProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Symbol      string         `json:"symbol" yaml:"symbol"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	}
 )
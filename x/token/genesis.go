@@ -25,6 +25,18 @@ import (
 type GenesisState struct {
 	GenesisTokenInfos []sdk.TokenInfoWithoutSupply `json:"genesis_token_info"`
 	Params            Params                       `json:"params"`
+	FrozenAccounts    []FrozenAccount              `json:"frozen_accounts"`
+	StatusTransitions []TokenStatusTransition      `json:"status_transitions"`
+	Reservations      []Reservation                `json:"reservations"`
+	NextAirdropID     uint64                       `json:"next_airdrop_id"`
+	Airdrops          Airdrops                     `json:"airdrops"`
+	AirdropClaims     []AirdropClaim               `json:"airdrop_claims"`
+}
+
+//FrozenAccount records an account whose balance of a token is frozen
+type FrozenAccount struct {
+	Symbol  sdk.Symbol     `json:"symbol"`
+	Address sdk.AccAddress `json:"address"`
 }
 
 //NewGenesisState ...
@@ -38,6 +50,10 @@ func NewGenesisState(tokenInfos []sdk.TokenInfoWithoutSupply, params Params) Gen
 
 //ValidateGenesis ...
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
 	tokenMap := make(map[string]struct{}, len(data.GenesisTokenInfos))
 
 	for _, genInfo := range data.GenesisTokenInfos {
@@ -54,6 +70,64 @@ func ValidateGenesis(data GenesisState) error {
 		tokenMap[symbol] = struct{}{}
 	}
 
+	for _, fa := range data.FrozenAccounts {
+		if _, ok := tokenMap[fa.Symbol.String()]; !ok {
+			return fmt.Errorf("frozen account %v of non-exist token %v", fa.Address, fa.Symbol)
+		}
+
+		if fa.Address.Empty() {
+			return fmt.Errorf("frozen account of %v is empty", fa.Symbol)
+		}
+	}
+
+	for _, st := range data.StatusTransitions {
+		if _, ok := tokenMap[st.Symbol.String()]; !ok {
+			return fmt.Errorf("status transition of non-exist token %v", st.Symbol)
+		}
+
+		if !sdk.ValidTokenStatus(st.From) || !sdk.ValidTokenStatus(st.To) {
+			return fmt.Errorf("invalid status transition %v", st)
+		}
+	}
+
+	reservationMap := make(map[string]struct{}, len(data.Reservations))
+	for _, r := range data.Reservations {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		if _, ok := reservationMap[r.Symbol]; ok {
+			return fmt.Errorf("duplicated reservation of %v", r.Symbol)
+		}
+		reservationMap[r.Symbol] = struct{}{}
+	}
+
+	airdropMap := make(map[uint64]Airdrop, len(data.Airdrops))
+	for _, airdrop := range data.Airdrops {
+		if err := airdrop.Validate(); err != nil {
+			return err
+		}
+		if _, ok := tokenMap[airdrop.Amount.Denom]; !ok {
+			return fmt.Errorf("airdrop %d of non-exist token %v", airdrop.AirdropID, airdrop.Amount.Denom)
+		}
+		if _, ok := airdropMap[airdrop.AirdropID]; ok {
+			return fmt.Errorf("duplicated airdrop %d", airdrop.AirdropID)
+		}
+		if airdrop.AirdropID >= data.NextAirdropID {
+			return fmt.Errorf("airdrop %d is not before the next airdrop %d", airdrop.AirdropID, data.NextAirdropID)
+		}
+		airdropMap[airdrop.AirdropID] = airdrop
+	}
+
+	for _, claim := range data.AirdropClaims {
+		airdrop, ok := airdropMap[claim.AirdropID]
+		if !ok {
+			return fmt.Errorf("claim of non-exist airdrop %d", claim.AirdropID)
+		}
+		if claim.Index >= airdrop.Recipients {
+			return fmt.Errorf("claim of leaf %d out of the %d recipients of airdrop %d", claim.Index, airdrop.Recipients, claim.AirdropID)
+		}
+	}
+
 	return nil
 }
 
@@ -65,20 +139,62 @@ func DefaultGenesisState() GenesisState {
 			Issuer:        "",
 			IsSendEnabled: true,
 			Decimals:      sdk.NativeTokenDecimal,
+			MaxSupply:     sdk.ZeroInt(),
+			Burnable:      true,
 		},
 	}
 	sort.Sort(sortGenesisTokenInfoWithoutSupply(genInfos))
 	return GenesisState{
 		GenesisTokenInfos: genInfos,
 		Params:            DefaultParams(),
+		Reservations:      DefaultReservations(),
+		NextAirdropID:     DefaultStartingAirdropID,
 	}
 }
 
 //InitGenesis ...
 func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) []abci.ValidatorUpdate {
 	for _, ti := range data.GenesisTokenInfos {
+		upgradeLegacyTokenInfo(&ti)
 		k.SetTokenInfoWithoutSupply(ctx, &ti)
 	}
+	for _, fa := range data.FrozenAccounts {
+		k.FreezeAccount(ctx, fa.Symbol, fa.Address)
+	}
+	for _, st := range data.StatusTransitions {
+		k.SetStatusTransitions(ctx, st.Symbol, append(k.GetStatusTransitions(ctx, st.Symbol), st))
+	}
+	for _, r := range data.Reservations {
+		k.SetReservation(ctx, r)
+	}
+
+	if data.NextAirdropID == 0 {
+		data.NextAirdropID = DefaultStartingAirdropID
+	}
+	k.SetNextAirdropID(ctx, data.NextAirdropID)
+	for _, airdrop := range data.Airdrops {
+		k.SetAirdrop(ctx, airdrop)
+	}
+	for _, claim := range data.AirdropClaims {
+		k.SetAirdropClaimed(ctx, claim.AirdropID, claim.Index)
+	}
+
+	// mint the coins of the airdrops if they are not provided on genesis, through the supply
+	// keeper so that the total supply and the holder index account for them
+	if len(data.Airdrops) > 0 {
+		total := data.Airdrops.Total()
+		moduleAcc := k.GetAirdropAccount(ctx)
+		if moduleAcc.GetCoins().IsZero() {
+			if err := k.sk.MintCoins(ctx, ModuleName, total); err != nil {
+				panic(err)
+			}
+			if err := k.sk.SendCoinsFromModuleToModule(ctx, ModuleName, AirdropAccountName, total); err != nil {
+				panic(err)
+			}
+		} else if !moduleAcc.GetCoins().IsAllGTE(total) {
+			panic(fmt.Sprintf("%s module account coins %s do not cover the airdrops %s", AirdropAccountName, moduleAcc.GetCoins(), total))
+		}
+	}
 	k.SetParams(ctx, data.Params)
 	return []abci.ValidatorUpdate{}
 }
@@ -96,8 +212,27 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 	}
 	sort.Sort(sortGenesisTokenInfoWithoutSupply(genTokenInfos))
 
+	var frozenAccounts []FrozenAccount
+	var statusTransitions []TokenStatusTransition
+	for _, info := range genTokenInfos {
+		for _, addr := range k.GetFrozenAccounts(ctx, info.Symbol) {
+			frozenAccounts = append(frozenAccounts, FrozenAccount{Symbol: info.Symbol, Address: addr})
+		}
+		statusTransitions = append(statusTransitions, k.GetStatusTransitions(ctx, info.Symbol)...)
+	}
+
 	params := k.GetParams(ctx)
-	return GenesisState{GenesisTokenInfos: genTokenInfos, Params: params}
+	reservations := k.GetReservations(ctx)
+	return GenesisState{
+		GenesisTokenInfos: genTokenInfos,
+		Params:            params,
+		FrozenAccounts:    frozenAccounts,
+		StatusTransitions: statusTransitions,
+		Reservations:      reservations,
+		NextAirdropID:     k.GetNextAirdropID(ctx),
+		Airdrops:          k.GetAirdrops(ctx),
+		AirdropClaims:     k.GetAirdropClaims(ctx),
+	}
 }
 
 //AddTokenInfoWithoutSupplyIntoGenesis add a token into genesis
@@ -137,6 +272,10 @@ func (g GenesisState) String() string {
 		b.WriteString("\n")
 	}
 	b.WriteString(g.Params.String())
+	for _, r := range g.Reservations {
+		b.WriteString("\n")
+		b.WriteString(r.String())
+	}
 
 	return b.String()
 }
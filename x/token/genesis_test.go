@@ -14,6 +14,7 @@ import (
 	sdk "github.com/pocblockchain/pocc/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
+	"time"
 )
 
 func TestNewGenesisState(t *testing.T) {
@@ -92,6 +93,54 @@ func TestIllegalGensisState(t *testing.T) {
 	err = ValidateGenesis(genState)
 	assert.NotNil(t, err)
 
+	//invalid pending issuer
+	ti = []sdk.TokenInfoWithoutSupply{
+		{
+			Symbol:        "btc",
+			Decimals:      8,
+			PendingIssuer: "invalid",
+		},
+	}
+	genState = NewGenesisState(ti, DefaultParams())
+	err = ValidateGenesis(genState)
+	assert.NotNil(t, err)
+
+	//invalid fee destination
+	params := DefaultParams()
+	params.FeeDestination = "validators"
+	genState = NewGenesisState(DefaultGenesisState().GenesisTokenInfos, params)
+	err = ValidateGenesis(genState)
+	assert.NotNil(t, err)
+}
+
+func TestExportGenesisOwnership(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	issuer, err := sdk.AccAddressFromBech32("poc1kkkjfhv7t4swrurnftedsx0lngvc523cfa00s3")
+	assert.Nil(t, err)
+	pending, err := sdk.AccAddressFromBech32("poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr")
+	assert.Nil(t, err)
+	genState := DefaultGenesisState()
+	err = genState.AddTokenInfoWithoutSupplyIntoGenesis(sdk.TokenInfoWithoutSupply{
+		Symbol:        "btc",
+		Issuer:        issuer.String(),
+		IsSendEnabled: true,
+		Decimals:      8,
+		PendingIssuer: pending.String(),
+		MintRenounced: true,
+		MaxSupply:     sdk.ZeroInt(),
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, ValidateGenesis(genState))
+
+	InitGenesis(ctx, keeper, genState)
+	assert.Equal(t, pending.String(), keeper.GetPendingIssuer(ctx, "btc"))
+	assert.True(t, keeper.IsMintRenounced(ctx, "btc"))
+
+	exported := ExportGenesis(ctx, keeper)
+	assert.True(t, genState.Equal(exported))
 }
 
 func TestSortGenTokenInfo(t *testing.T) {
@@ -153,3 +202,165 @@ func TestAddTokenInfoWithoutSupplyIntoGenesis(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Equal(t, beforeLen+1, len(p.GenesisTokenInfos))
 }
+
+func TestExportGenesisFrozenAccounts(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	addr, err := sdk.AccAddressFromBech32("poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr")
+	assert.Nil(t, err)
+
+	genState := DefaultGenesisState()
+	err = genState.AddTokenInfoWithoutSupplyIntoGenesis(sdk.TokenInfoWithoutSupply{
+		Symbol:    "bhd",
+		Decimals:  18,
+		MaxSupply: sdk.ZeroInt(),
+		Freezable: true,
+	})
+	assert.Nil(t, err)
+	genState.FrozenAccounts = []FrozenAccount{{Symbol: "bhd", Address: addr}}
+	assert.Nil(t, ValidateGenesis(genState))
+
+	InitGenesis(ctx, keeper, genState)
+	assert.True(t, keeper.IsAccountFrozen(ctx, "bhd", addr))
+	assert.Equal(t, genState, ExportGenesis(ctx, keeper))
+
+	//frozen account of a token not in genesis
+	genState.FrozenAccounts = []FrozenAccount{{Symbol: "bhe", Address: addr}}
+	assert.NotNil(t, ValidateGenesis(genState))
+}
+
+func TestExportGenesisStatusTransitions(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx.WithBlockHeight(5)
+	keeper := input.tokenKeeper
+
+	genState := DefaultGenesisState()
+	err := genState.AddTokenInfoWithoutSupplyIntoGenesis(sdk.TokenInfoWithoutSupply{
+		Symbol:    "bhd",
+		Decimals:  18,
+		MaxSupply: sdk.ZeroInt(),
+	})
+	assert.Nil(t, err)
+	InitGenesis(ctx, keeper, genState)
+
+	keeper.SetStatus(ctx, "bhd", sdk.TokenStatusPaused, 1, "")
+	keeper.SetStatus(ctx, "bhd", sdk.TokenStatusDelisted, 2, "")
+
+	exported := ExportGenesis(ctx, keeper)
+	assert.Equal(t, 2, len(exported.StatusTransitions))
+	assert.Nil(t, ValidateGenesis(exported))
+
+	//import into a fresh chain
+	input = setupTestEnv(t)
+	InitGenesis(input.ctx, input.tokenKeeper, exported)
+	assert.Equal(t, sdk.TokenStatusDelisted, input.tokenKeeper.GetStatus(input.ctx, "bhd"))
+	assert.Equal(t, exported.StatusTransitions, input.tokenKeeper.GetStatusTransitions(input.ctx, "bhd"))
+	assert.Equal(t, exported, ExportGenesis(input.ctx, input.tokenKeeper))
+
+	//status transition of a token not in genesis
+	exported.StatusTransitions[0].Symbol = "bhe"
+	assert.NotNil(t, ValidateGenesis(exported))
+}
+
+func TestExportGenesisReservations(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	claimant := sdk.AccAddress([]byte("claimant"))
+	genState := DefaultGenesisState()
+	genState.Reservations = append(genState.Reservations, NewReservation("zzz", claimant, time.Unix(1000, 0).UTC()))
+	assert.Nil(t, ValidateGenesis(genState))
+
+	InitGenesis(ctx, keeper, genState)
+	reservation, found := keeper.GetReservation(ctx, "zzz")
+	assert.True(t, found)
+	assert.True(t, reservation.CanClaim(claimant))
+	assert.Equal(t, genState, ExportGenesis(ctx, keeper))
+
+	//duplicated reservation
+	genState.Reservations = append(genState.Reservations, NewReservation("zzz", nil, time.Time{}))
+	assert.NotNil(t, ValidateGenesis(genState))
+
+	//the native token can not be reserved
+	genState.Reservations = []Reservation{NewReservation(sdk.NativeToken, nil, time.Time{})}
+	assert.NotNil(t, ValidateGenesis(genState))
+}
+
+func TestExportGenesisAirdrops(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	issuer, _ := sdk.AccAddressFromBech32("poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr")
+	root := make([]byte, 32)
+	genState := DefaultGenesisState()
+	genState.NextAirdropID = 3
+	genState.Airdrops = Airdrops{
+		NewAirdrop(1, issuer, sdk.NewCoin(sdk.NativeToken, sdk.NewInt(100)), root, 2, time.Unix(1000, 0).UTC()),
+		NewAirdrop(2, issuer, sdk.NewCoin(sdk.NativeToken, sdk.NewInt(200)), root, 3, time.Unix(2000, 0).UTC()),
+	}
+	genState.AirdropClaims = []AirdropClaim{NewAirdropClaim(1, 1), NewAirdropClaim(2, 0)}
+	assert.Nil(t, ValidateGenesis(genState))
+
+	supplyBefore := input.supplyKeeper.GetSupply(ctx).GetTotal()
+	InitGenesis(ctx, keeper, genState)
+	assert.Equal(t, uint64(3), keeper.GetNextAirdropID(ctx))
+	assert.True(t, keeper.IsAirdropClaimed(ctx, 1, 1))
+	assert.Equal(t, genState.Airdrops.Total(), keeper.GetAirdropAccount(ctx).GetCoins())
+
+	//the airdropped coins are minted
+	assert.Equal(t, supplyBefore.Add(genState.Airdrops.Total()), input.supplyKeeper.GetSupply(ctx).GetTotal())
+
+	exported := ExportGenesis(ctx, keeper)
+	assert.Equal(t, genState.NextAirdropID, exported.NextAirdropID)
+	assert.Equal(t, genState.Airdrops, exported.Airdrops)
+	assert.Equal(t, genState.AirdropClaims, exported.AirdropClaims)
+
+	//claim of a leaf out of the recipients
+	genState.AirdropClaims = []AirdropClaim{NewAirdropClaim(1, 2)}
+	assert.NotNil(t, ValidateGenesis(genState))
+
+	//claim of a non-exist airdrop
+	genState.AirdropClaims = []AirdropClaim{NewAirdropClaim(3, 0)}
+	assert.NotNil(t, ValidateGenesis(genState))
+
+	//airdrop not before the next airdrop ID
+	genState.AirdropClaims = nil
+	genState.NextAirdropID = 2
+	assert.NotNil(t, ValidateGenesis(genState))
+
+	//airdrop of a non-exist token
+	genState.NextAirdropID = 3
+	genState.Airdrops[0].Amount = sdk.NewCoin("bhd", sdk.NewInt(100))
+	assert.NotNil(t, ValidateGenesis(genState))
+}
+
+func TestInitGenesisFundedAirdrops(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	issuer, _ := sdk.AccAddressFromBech32("poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr")
+	genState := DefaultGenesisState()
+	genState.NextAirdropID = 2
+	genState.Airdrops = Airdrops{
+		NewAirdrop(1, issuer, sdk.NewCoin(sdk.NativeToken, sdk.NewInt(100)), make([]byte, 32), 2, time.Unix(1000, 0).UTC()),
+	}
+
+	//the airdrop account funded on genesis must cover the airdrops
+	moduleAcc := keeper.GetAirdropAccount(ctx)
+	assert.Nil(t, moduleAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, sdk.NewInt(99)))))
+	input.supplyKeeper.SetModuleAccount(ctx, moduleAcc)
+	assert.Panics(t, func() { InitGenesis(ctx, keeper, genState) })
+
+	//no coins are minted if it does
+	assert.Nil(t, moduleAcc.SetCoins(genState.Airdrops.Total()))
+	input.supplyKeeper.SetModuleAccount(ctx, moduleAcc)
+	supplyBefore := input.supplyKeeper.GetSupply(ctx).GetTotal()
+	InitGenesis(ctx, keeper, genState)
+	assert.Equal(t, genState.Airdrops.Total(), keeper.GetAirdropAccount(ctx).GetCoins())
+	assert.Equal(t, supplyBefore, input.supplyKeeper.GetSupply(ctx).GetTotal())
+}
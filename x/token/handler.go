@@ -21,6 +21,36 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case types.MsgBurnToken:
 			return handleMsgBurnToken(ctx, keeper, msg)
 
+		case types.MsgTransferTokenOwnership:
+			return handleMsgTransferTokenOwnership(ctx, keeper, msg)
+
+		case types.MsgAcceptTokenOwnership:
+			return handleMsgAcceptTokenOwnership(ctx, keeper, msg)
+
+		case types.MsgRenounceTokenMint:
+			return handleMsgRenounceTokenMint(ctx, keeper, msg)
+
+		case types.MsgEditTokenMetadata:
+			return handleMsgEditTokenMetadata(ctx, keeper, msg)
+
+		case types.MsgFreezeAccount:
+			return handleMsgFreezeAccount(ctx, keeper, msg)
+
+		case types.MsgUnfreezeAccount:
+			return handleMsgUnfreezeAccount(ctx, keeper, msg)
+
+		case types.MsgPauseToken:
+			return handleMsgPauseToken(ctx, keeper, msg)
+
+		case types.MsgUnpauseToken:
+			return handleMsgUnpauseToken(ctx, keeper, msg)
+
+		case types.MsgCreateAirdrop:
+			return handleMsgCreateAirdrop(ctx, keeper, msg)
+
+		case types.MsgClaimAirdrop:
+			return handleMsgClaimAirdrop(ctx, keeper, msg)
+
 		default:
 			errMsg := fmt.Sprintf("Unrecognized token Msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -34,17 +64,25 @@ func handleMsgNewToken(ctx sdk.Context, keeper Keeper, msg types.MsgNewToken) sd
 	if ti := keeper.GetTokenInfoWithoutSupply(ctx, msg.Symbol); ti != nil {
 		return sdk.ErrAlreadyExitSymbol(fmt.Sprintf("token %s already exist", msg.Symbol)).Result()
 	}
-	reserved := keeper.GetParams(ctx).ReservedSymbols
-	for _, r := range reserved {
-		if r == msg.Symbol.String() {
-			return types.ErrSymbolReserved(fmt.Sprintf("%v is reserved", msg.Symbol)).Result()
-		}
+
+	if keeper.BlacklistedAddr(msg.To) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.To)).Result()
 	}
 
-	issueFee := sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, keeper.GetParams(ctx).NewTokenFee))
+	//only the claimant can issue a reserved symbol before the reservation expires
+	reservation, reserved := keeper.GetReservation(ctx, msg.Symbol)
+	claimed := reserved && !reservation.IsExpired(ctx.BlockHeader().Time)
+	if claimed && !reservation.CanClaim(msg.From) {
+		return types.ErrSymbolReserved(fmt.Sprintf("%v is reserved", msg.Symbol)).Result()
+	}
+
+	issueFee := sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, keeper.GetNewTokenFee(ctx, msg.Symbol)))
 
-	//transfer openFee to communityPool
-	err := keeper.dk.AddCoinsFromAccountToFeePool(ctx, msg.From, issueFee)
+	getCoins := coinsGetter(ctx, keeper)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, msg.From, msg.To)
+
+	//transfer openFee to the fee destination
+	err := keeper.PayIssueFee(ctx, msg.From, issueFee)
 	if err != nil {
 		return err.Result()
 	}
@@ -56,7 +94,17 @@ func handleMsgNewToken(ctx sdk.Context, keeper Keeper, msg types.MsgNewToken) sd
 		Issuer:        msg.From.String(),
 		IsSendEnabled: true,
 		Decimals:      msg.Decimals,
+		MaxSupply:     msg.MaxSupply,
+		Mintable:      msg.Mintable,
+		Burnable:      msg.Burnable,
+		Freezable:     msg.Freezable,
+		Pausable:      msg.Pausable,
+		Status:        sdk.TokenStatusActive,
+		Metadata:      msg.Metadata,
 	})
+	if reserved {
+		keeper.DeleteReservation(ctx, msg.Symbol)
+	}
 
 	//minted newCoins
 	mintedCoins := sdk.NewCoins(sdk.NewCoin(msg.Symbol.String(), msg.TotalSupply))
@@ -79,18 +127,32 @@ func handleMsgNewToken(ctx sdk.Context, keeper Keeper, msg types.MsgNewToken) sd
 			sdk.NewAttribute(types.AttributeKeyRecipient, msg.To.String()),
 			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol.String()),
 			sdk.NewAttribute(types.AttributeKeyAmount, totalSupply.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxSupply, msg.MaxSupply.String()),
 			sdk.NewAttribute(types.AttributeKeyIssueFee, issueFee.String()),
 		),
 	)
+	if claimed {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClaimSymbol,
+				sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol.String()),
+				sdk.NewAttribute(types.AttributeKeyClaimant, msg.From.String()),
+			),
+		)
+	}
 
-	return sdk.Result{Events: ctx.EventManager().Events()}
+	receipt := sdk.NewReceipt(sdk.CategoryTypeNewToken, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
 }
 
 func handleMsgInflateToken(ctx sdk.Context, keeper Keeper, msg types.MsgInflateToken) sdk.Result {
 	ctx.Logger().Info("handleMsgInflateToken", "msg", msg)
 
-
 	symbol := sdk.Symbol(msg.Amount[0].Denom)
+	if status := keeper.GetStatus(ctx, symbol); !status.AllowsInflate() {
+		return types.ErrStatusNotAllowed(symbol.String(), status, "inflate").Result()
+	}
+
 	if !keeper.IsSendEnabled(ctx, symbol) {
 		return sdk.ErrTransactionIsNotEnabled(fmt.Sprintf("%v is not sendenable", symbol)).Result()
 	}
@@ -100,17 +162,38 @@ func handleMsgInflateToken(ctx sdk.Context, keeper Keeper, msg types.MsgInflateT
 		return sdk.ErrInvalidAccount(fmt.Sprintf("%s is not allowed to inflate %v", msg.From, symbol)).Result()
 	}
 
-	//transfer openFee to communityPool
-	//since issuer have pay when new token, do not charge again when inflate token
-	//issueFee := sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, keeper.GetParams(ctx).NewTokenFee))
-	//err := keeper.dk.AddCoinsFromAccountToFeePool(ctx, msg.From, issueFee)
-	//if err != nil {
-	//	return err.Result()
-	//}
+	if keeper.BlacklistedAddr(msg.To) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive transactions", msg.To)).Result()
+	}
+
+	if keeper.IsMintRenounced(ctx, symbol) {
+		return types.ErrMintRenounced(symbol.String()).Result()
+	}
+
+	if !keeper.IsMintable(ctx, symbol) {
+		return types.ErrNotMintable(symbol.String()).Result()
+	}
+
+	maxSupply := keeper.GetMaxSupply(ctx, symbol)
+	newSupply := keeper.GetTotalSupply(ctx, symbol).Add(msg.Amount[0].Amount)
+	if maxSupply.IsPositive() && newSupply.GT(maxSupply) {
+		return types.ErrExceedMaxSupply(fmt.Sprintf("%v's supply %v would exceed max supply %v", symbol, newSupply, maxSupply)).Result()
+	}
+
+	issueFee := sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, keeper.GetParams(ctx).InflateTokenFee))
+
+	getCoins := coinsGetter(ctx, keeper)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, msg.From, msg.To)
+
+	//transfer inflate fee to the fee destination, it is zero by default since the issuer has paid when new token
+	err := keeper.PayIssueFee(ctx, msg.From, issueFee)
+	if err != nil {
+		return err.Result()
+	}
 
 	//minted inflatedCoins
 	inflatedCoins := sdk.NewCoins(msg.Amount[0])
-	err := keeper.sk.MintCoins(ctx, types.ModuleName, inflatedCoins)
+	err = keeper.sk.MintCoins(ctx, types.ModuleName, inflatedCoins)
 	if err != nil {
 		return err.Result()
 	}
@@ -129,22 +212,36 @@ func handleMsgInflateToken(ctx sdk.Context, keeper Keeper, msg types.MsgInflateT
 			sdk.NewAttribute(types.AttributeKeyRecipient, msg.To.String()),
 			sdk.NewAttribute(types.AttributeKeySymbol, symbol.String()),
 			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount[0].Amount.String()),
-	//		sdk.NewAttribute(types.AttributeKeyIssueFee, issueFee.String()),
+			sdk.NewAttribute(types.AttributeKeyIssueFee, issueFee.String()),
 		),
 	)
 
-	return sdk.Result{Events: ctx.EventManager().Events()}
+	receipt := sdk.NewReceipt(sdk.CategoryTypeInflateToken, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
 }
 
 func handleMsgBurnToken(ctx sdk.Context, keeper Keeper, msg types.MsgBurnToken) sdk.Result {
 	ctx.Logger().Info("handleMsgBurnToken", "msg", msg)
 
 	for _, coin := range msg.Amount {
-		if !keeper.IsSendEnabled(ctx, sdk.Symbol(coin.Denom)) {
+		status := keeper.GetStatus(ctx, sdk.Symbol(coin.Denom))
+		if !status.AllowsBurn() {
+			return types.ErrStatusNotAllowed(coin.Denom, status, "burn").Result()
+		}
+
+		//holders of a delisted token can no longer send it but may still burn it
+		if status != sdk.TokenStatusDelisted && !keeper.IsSendEnabled(ctx, sdk.Symbol(coin.Denom)) {
 			return sdk.ErrTransactionIsNotEnabled(fmt.Sprintf("%v is not sendenable", coin.Denom)).Result()
 		}
+
+		if !keeper.IsBurnable(ctx, sdk.Symbol(coin.Denom)) {
+			return types.ErrNotBurnable(coin.Denom).Result()
+		}
 	}
 
+	getCoins := coinsGetter(ctx, keeper)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, msg.From)
+
 	//send the burned coins to token module
 	err := keeper.sk.SendCoinsFromAccountToModule(ctx, msg.From, types.ModuleName, msg.Amount)
 	if err != nil {
@@ -167,5 +264,389 @@ func handleMsgBurnToken(ctx sdk.Context, keeper Keeper, msg types.MsgBurnToken)
 		),
 	)
 
+	receipt := sdk.NewReceipt(sdk.CategoryTypeBurnToken, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
+}
+
+func handleMsgTransferTokenOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgTransferTokenOwnership) sdk.Result {
+	ctx.Logger().Info("handleMsgTransferTokenOwnership", "msg", msg)
+
+	if msg.Symbol.String() == sdk.NativeToken {
+		return sdk.ErrInvalidTx("Not allowed to transfer native token's ownership").Result()
+	}
+
+	if !keeper.IsTokenSupported(ctx, msg.Symbol) {
+		return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", msg.Symbol)).Result()
+	}
+
+	//Only token owner can transfer the ownership
+	if msg.From.String() != keeper.GetIssuer(ctx, msg.Symbol) {
+		return sdk.ErrInvalidAccount(fmt.Sprintf("%s is not allowed to transfer ownership of %v", msg.From, msg.Symbol)).Result()
+	}
+
+	var event sdk.Event
+	if msg.RequireAcceptance {
+		keeper.SetPendingIssuer(ctx, msg.Symbol, msg.To)
+		event = sdk.NewEvent(
+			types.EventTypeTransferTokenOwnership,
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeyPendingIssuer, msg.To.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol.String()),
+		)
+	} else {
+		keeper.TransferOwnership(ctx, msg.Symbol, msg.To)
+		event = sdk.NewEvent(
+			types.EventTypeTransferTokenOwnership,
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeyNewIssuer, msg.To.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol.String()),
+		)
+	}
+
+	ctx.EventManager().EmitEvent(event)
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgAcceptTokenOwnership(ctx sdk.Context, keeper Keeper, msg types.MsgAcceptTokenOwnership) sdk.Result {
+	ctx.Logger().Info("handleMsgAcceptTokenOwnership", "msg", msg)
+
+	if !keeper.IsTokenSupported(ctx, msg.Symbol) {
+		return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", msg.Symbol)).Result()
+	}
+
+	//Only the pending issuer can accept the ownership
+	if msg.From.String() != keeper.GetPendingIssuer(ctx, msg.Symbol) {
+		return types.ErrNoPendingOwner(fmt.Sprintf("%v is not transferred to %s", msg.Symbol, msg.From)).Result()
+	}
+
+	oldIssuer := keeper.GetIssuer(ctx, msg.Symbol)
+	keeper.AcceptOwnership(ctx, msg.Symbol)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAcceptTokenOwnership,
+			sdk.NewAttribute(types.AttributeKeyIssuer, oldIssuer),
+			sdk.NewAttribute(types.AttributeKeyNewIssuer, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRenounceTokenMint(ctx sdk.Context, keeper Keeper, msg types.MsgRenounceTokenMint) sdk.Result {
+	ctx.Logger().Info("handleMsgRenounceTokenMint", "msg", msg)
+
+	if msg.Symbol.String() == sdk.NativeToken {
+		return sdk.ErrInvalidTx("Not allowed to renounce native token's mint").Result()
+	}
+
+	if !keeper.IsTokenSupported(ctx, msg.Symbol) {
+		return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", msg.Symbol)).Result()
+	}
+
+	//Only token owner can renounce the mint
+	if msg.From.String() != keeper.GetIssuer(ctx, msg.Symbol) {
+		return sdk.ErrInvalidAccount(fmt.Sprintf("%s is not allowed to renounce mint of %v", msg.From, msg.Symbol)).Result()
+	}
+
+	if keeper.IsMintRenounced(ctx, msg.Symbol) {
+		return types.ErrMintRenounced(msg.Symbol.String()).Result()
+	}
+
+	keeper.RenounceMint(ctx, msg.Symbol)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRenounceTokenMint,
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol.String()),
+		),
+	)
+
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+func handleMsgEditTokenMetadata(ctx sdk.Context, keeper Keeper, msg types.MsgEditTokenMetadata) sdk.Result {
+	ctx.Logger().Info("handleMsgEditTokenMetadata", "msg", msg)
+
+	if !keeper.IsTokenSupported(ctx, msg.Symbol) {
+		return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", msg.Symbol)).Result()
+	}
+
+	//Only token owner can edit the metadata
+	if msg.From.String() != keeper.GetIssuer(ctx, msg.Symbol) {
+		return sdk.ErrInvalidAccount(fmt.Sprintf("%s is not allowed to edit metadata of %v", msg.From, msg.Symbol)).Result()
+	}
+
+	metadata, err := keeper.GetMetadata(ctx, msg.Symbol).UpdateMetadata(msg.Metadata)
+	if err != nil {
+		return types.ErrInvalidMetadata(err.Error()).Result()
+	}
+
+	keeper.SetMetadata(ctx, msg.Symbol, metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEditTokenMetadata,
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgFreezeAccount(ctx sdk.Context, keeper Keeper, msg types.MsgFreezeAccount) sdk.Result {
+	ctx.Logger().Info("handleMsgFreezeAccount", "msg", msg)
+
+	if msg.Symbol.String() == sdk.NativeToken {
+		return sdk.ErrInvalidTx("Not allowed to freeze native token").Result()
+	}
+
+	if !keeper.IsTokenSupported(ctx, msg.Symbol) {
+		return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", msg.Symbol)).Result()
+	}
+
+	//Only token owner can freeze an account
+	if msg.From.String() != keeper.GetIssuer(ctx, msg.Symbol) {
+		return sdk.ErrInvalidAccount(fmt.Sprintf("%s is not allowed to freeze accounts of %v", msg.From, msg.Symbol)).Result()
+	}
+
+	if !keeper.IsFreezable(ctx, msg.Symbol) {
+		return types.ErrNotFreezable(msg.Symbol.String()).Result()
+	}
+
+	// module accounts must stay able to refund the coins they lock
+	if keeper.BlacklistedAddr(msg.Target) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("module account %s is not allowed to be frozen", msg.Target)).Result()
+	}
+
+	if keeper.IsAccountFrozen(ctx, msg.Symbol, msg.Target) {
+		return types.ErrAccountFrozen(msg.Symbol.String(), msg.Target).Result()
+	}
+
+	keeper.FreezeAccount(ctx, msg.Symbol, msg.Target)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFreezeAccount,
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeyTarget, msg.Target.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+//unfreeze is allowed even if the token is not freezable any more, so that no account is left frozen forever
+func handleMsgUnfreezeAccount(ctx sdk.Context, keeper Keeper, msg types.MsgUnfreezeAccount) sdk.Result {
+	ctx.Logger().Info("handleMsgUnfreezeAccount", "msg", msg)
+
+	if !keeper.IsTokenSupported(ctx, msg.Symbol) {
+		return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", msg.Symbol)).Result()
+	}
+
+	//Only token owner can unfreeze an account
+	if msg.From.String() != keeper.GetIssuer(ctx, msg.Symbol) {
+		return sdk.ErrInvalidAccount(fmt.Sprintf("%s is not allowed to unfreeze accounts of %v", msg.From, msg.Symbol)).Result()
+	}
+
+	if !keeper.IsAccountFrozen(ctx, msg.Symbol, msg.Target) {
+		return types.ErrAccountNotFrozen(msg.Symbol.String(), msg.Target).Result()
+	}
+
+	keeper.UnfreezeAccount(ctx, msg.Symbol, msg.Target)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnfreezeAccount,
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeyTarget, msg.Target.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgPauseToken(ctx sdk.Context, keeper Keeper, msg types.MsgPauseToken) sdk.Result {
+	ctx.Logger().Info("handleMsgPauseToken", "msg", msg)
+
+	if msg.Symbol.String() == sdk.NativeToken {
+		return sdk.ErrInvalidTx("Not allowed to pause native token").Result()
+	}
+
+	if !keeper.IsTokenSupported(ctx, msg.Symbol) {
+		return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", msg.Symbol)).Result()
+	}
+
+	//Only token owner can pause the token
+	if msg.From.String() != keeper.GetIssuer(ctx, msg.Symbol) {
+		return sdk.ErrInvalidAccount(fmt.Sprintf("%s is not allowed to pause %v", msg.From, msg.Symbol)).Result()
+	}
+
+	if !keeper.IsPausable(ctx, msg.Symbol) {
+		return types.ErrNotPausable(msg.Symbol.String()).Result()
+	}
+
+	if status := keeper.GetStatus(ctx, msg.Symbol); status != sdk.TokenStatusActive {
+		return types.ErrInvalidStatusTransition(msg.Symbol.String(), status, sdk.TokenStatusPaused).Result()
+	}
+
+	keeper.SetStatusByIssuer(ctx, msg.Symbol, sdk.TokenStatusPaused, msg.From)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePauseToken,
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+//only a pause by the issuer can be lifted by the issuer, a pause by governance needs an enable token proposal
+func handleMsgUnpauseToken(ctx sdk.Context, keeper Keeper, msg types.MsgUnpauseToken) sdk.Result {
+	ctx.Logger().Info("handleMsgUnpauseToken", "msg", msg)
+
+	if !keeper.IsTokenSupported(ctx, msg.Symbol) {
+		return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", msg.Symbol)).Result()
+	}
+
+	//Only token owner can unpause the token
+	if msg.From.String() != keeper.GetIssuer(ctx, msg.Symbol) {
+		return sdk.ErrInvalidAccount(fmt.Sprintf("%s is not allowed to unpause %v", msg.From, msg.Symbol)).Result()
+	}
+
+	if !keeper.IsPausedByIssuer(ctx, msg.Symbol) {
+		return types.ErrNotPausedByIssuer(msg.Symbol.String()).Result()
+	}
+
+	keeper.SetStatusByIssuer(ctx, msg.Symbol, sdk.TokenStatusActive, msg.From)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnpauseToken,
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeySymbol, msg.Symbol.String()),
+		),
+	)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgCreateAirdrop(ctx sdk.Context, keeper Keeper, msg types.MsgCreateAirdrop) sdk.Result {
+	ctx.Logger().Info("handleMsgCreateAirdrop", "msg", msg)
+
+	symbol := sdk.Symbol(msg.Amount.Denom)
+	if !keeper.IsTokenSupported(ctx, symbol) {
+		return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", symbol)).Result()
+	}
+
+	//Only token owner can airdrop token
+	if msg.From.String() != keeper.GetIssuer(ctx, symbol) {
+		return sdk.ErrInvalidAccount(fmt.Sprintf("%s is not allowed to airdrop %v", msg.From, symbol)).Result()
+	}
+
+	if status := keeper.GetStatus(ctx, symbol); !status.AllowsSend() {
+		return types.ErrStatusNotAllowed(symbol.String(), status, "airdrop").Result()
+	}
+
+	if !keeper.IsSendEnabled(ctx, symbol) {
+		return sdk.ErrTransactionIsNotEnabled(fmt.Sprintf("%v is not sendenable", symbol)).Result()
+	}
+
+	if keeper.IsAccountFrozen(ctx, symbol, msg.From) {
+		return types.ErrAccountFrozen(symbol.String(), msg.From).Result()
+	}
+
+	if !msg.Deadline.After(ctx.BlockHeader().Time) {
+		return types.ErrInvalidAirdrop(fmt.Sprintf("deadline %v has passed", msg.Deadline)).Result()
+	}
+
+	getCoins := coinsGetter(ctx, keeper)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, msg.From)
+
+	airdropID, err := keeper.CreateAirdrop(ctx, msg.From, msg.Amount, msg.MerkleRoot, msg.Recipients, msg.Deadline)
+	if err != nil {
+		return err.Result()
+	}
+
+	//ignore events in SendCoinsFromAccountToModule
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateAirdrop,
+			sdk.NewAttribute(types.AttributeKeyAirdropID, fmt.Sprintf("%d", airdropID)),
+			sdk.NewAttribute(types.AttributeKeyIssuer, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDeadline, msg.Deadline.String()),
+		),
+	)
+
+	receipt := sdk.NewReceipt(sdk.CategoryTypeCreateAirdrop, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
+}
+
+func handleMsgClaimAirdrop(ctx sdk.Context, keeper Keeper, msg types.MsgClaimAirdrop) sdk.Result {
+	ctx.Logger().Info("handleMsgClaimAirdrop", "msg", msg)
+
+	airdrop, found := keeper.GetAirdrop(ctx, msg.AirdropID)
+	if !found {
+		return types.ErrUnknownAirdrop(msg.AirdropID).Result()
+	}
+
+	//the airdrop is refunded in the EndBlock of the block reaching its deadline
+	if airdrop.IsExpired(ctx.BlockHeader().Time) {
+		return types.ErrInvalidAirdrop(fmt.Sprintf("airdrop %d has expired", msg.AirdropID)).Result()
+	}
+
+	symbol := sdk.Symbol(airdrop.Amount.Denom)
+	if status := keeper.GetStatus(ctx, symbol); !status.AllowsSend() {
+		return types.ErrStatusNotAllowed(symbol.String(), status, "claim").Result()
+	}
+
+	if keeper.IsAccountFrozen(ctx, symbol, msg.From) {
+		return types.ErrAccountFrozen(symbol.String(), msg.From).Result()
+	}
+
+	if keeper.IsAirdropClaimed(ctx, msg.AirdropID, msg.Index) {
+		return types.ErrAirdropClaimed(msg.AirdropID, msg.Index).Result()
+	}
+
+	if !types.VerifyAirdropProof(airdrop.MerkleRoot, airdrop.Recipients, msg.Index, msg.Entry(), msg.Aunts()) {
+		return types.ErrInvalidAirdropProof(msg.AirdropID, msg.Index).Result()
+	}
+
+	getCoins := coinsGetter(ctx, keeper)
+	snapshot := sdk.NewBalanceSnapshot(getCoins, msg.From)
+
+	if err := keeper.ClaimAirdrop(ctx, airdrop, msg.Index, msg.From, msg.Amount); err != nil {
+		return err.Result()
+	}
+
+	//ignore events in SendCoinsFromModuleToAccount
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimAirdrop,
+			sdk.NewAttribute(types.AttributeKeyAirdropID, fmt.Sprintf("%d", msg.AirdropID)),
+			sdk.NewAttribute(types.AttributeKeyAirdropIndex, fmt.Sprintf("%d", msg.Index)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoin(airdrop.Amount.Denom, msg.Amount).String()),
+		),
+	)
+
+	receipt := sdk.NewReceipt(sdk.CategoryTypeClaimAirdrop, snapshot.Flows(getCoins))
+	return sdk.Result{Data: sdk.MustMarshalReceipt(receipt), Events: ctx.EventManager().Events()}
+}
+
+// coinsGetter reads balances for the receipt of a msg
+func coinsGetter(ctx sdk.Context, keeper Keeper) func(sdk.AccAddress) sdk.Coins {
+	return func(addr sdk.AccAddress) sdk.Coins {
+		return keeper.GetCoins(ctx, addr)
+	}
+}
@@ -1,8 +1,11 @@
 package token
 
 import (
+	"github.com/pocblockchain/pocc/x/auth"
 	"github.com/pocblockchain/pocc/x/distribution"
+	"github.com/pocblockchain/pocc/x/supply"
 	"testing"
+	"time"
 
 	sdk "github.com/pocblockchain/pocc/types"
 	"github.com/pocblockchain/pocc/x/token/types"
@@ -46,7 +49,7 @@ func TestHandleMsgNewTokenSuccess(t *testing.T) {
 	totalAmt, ok := sdk.NewIntFromString("10000000000000000000000")
 	assert.True(t, ok)
 
-	msg := types.NewMsgNewToken(fromAddr, toAddr, "bhd", 18, totalAmt)
+	msg := types.NewMsgNewToken(fromAddr, toAddr, "bhd", 18, totalAmt, sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 
 	res := handleMsgNewToken(ctx, tk, msg)
 	assert.Equal(t, sdk.CodeOK, res.Code)
@@ -122,6 +125,7 @@ func TestHandleMsgNewTokenFail(t *testing.T) {
 		Decimals:      8,
 		IsSendEnabled: true,
 		TotalSupply:   sdk.NewInt(2100000000000000),
+		MaxSupply:     sdk.ZeroInt(),
 	}
 	tk.SetTokenInfo(ctx, &btcTokenInfo)
 
@@ -134,26 +138,35 @@ func TestHandleMsgNewTokenFail(t *testing.T) {
 	assert.Nil(t, got)
 
 	//token already exist
-	msg := types.NewMsgNewToken(fromAddr, toAddr, "btc", 8, sdk.NewInt(1000000))
+	msg := types.NewMsgNewToken(fromAddr, toAddr, "btc", 8, sdk.NewInt(1000000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	res := handleMsgNewToken(ctx, tk, msg)
 	assert.Equal(t, sdk.CodeSymbolAlreadyExist, res.Code)
 
+	//module accounts can not receive the new token
+	msg = types.NewMsgNewToken(fromAddr, supplyKeeper.GetModuleAddress(types.AirdropAccountName), "eth", 18, sdk.NewInt(1000000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
+	res = handleMsgNewToken(ctx, tk, msg)
+	assert.Equal(t, sdk.CodeUnauthorized, res.Code)
+	assert.Nil(t, tk.GetTokenInfo(ctx, "eth"))
+
 	//token is a reserved symbol
-	msg = types.NewMsgNewToken(fromAddr, toAddr, "eos", 18, sdk.NewInt(1000000))
+	for _, r := range DefaultReservations() {
+		tk.SetReservation(ctx, r)
+	}
+	msg = types.NewMsgNewToken(fromAddr, toAddr, "eos", 18, sdk.NewInt(1000000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	res = handleMsgNewToken(ctx, tk, msg)
 	assert.Equal(t, types.CodeSymbolReserved, res.Code)
 
-	msg = types.NewMsgNewToken(fromAddr, toAddr, "bsv", 18, sdk.NewInt(1000000))
+	msg = types.NewMsgNewToken(fromAddr, toAddr, "bsv", 18, sdk.NewInt(1000000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	res = handleMsgNewToken(ctx, tk, msg)
 	assert.Equal(t, types.CodeSymbolReserved, res.Code)
 
-	msg = types.NewMsgNewToken(fromAddr, toAddr, "bch", 18, sdk.NewInt(1000000))
+	msg = types.NewMsgNewToken(fromAddr, toAddr, "bch", 18, sdk.NewInt(1000000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	res = handleMsgNewToken(ctx, tk, msg)
 	assert.Equal(t, types.CodeSymbolReserved, res.Code)
 
 	//fromAccount does not exist
 	nonExistAddr, err := sdk.AccAddressFromBech32("poc1fk7g27wg5aznua285jt2kplmfr6rtv0sxn42gh")
-	msg = types.NewMsgNewToken(nonExistAddr, toAddr, "bhd", 18, sdk.NewInt(1000000))
+	msg = types.NewMsgNewToken(nonExistAddr, toAddr, "bhd", 18, sdk.NewInt(1000000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	res = handleMsgNewToken(ctx, tk, msg)
 	assert.Equal(t, sdk.CodeInsufficientCoins, res.Code)
 
@@ -161,12 +174,119 @@ func TestHandleMsgNewTokenFail(t *testing.T) {
 	param := tk.GetParams(ctx)
 	param.NewTokenFee = TestNewTokenFee.MulRaw(6)
 	tk.SetParams(ctx, param)
-	msg = types.NewMsgNewToken(fromAddr, toAddr, "bhd", 18, sdk.NewInt(1000000))
+	msg = types.NewMsgNewToken(fromAddr, toAddr, "bhd", 18, sdk.NewInt(1000000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	res = handleMsgNewToken(ctx, tk, msg)
 	assert.Equal(t, sdk.CodeInsufficientCoins, res.Code)
 
 }
 
+func TestHandleMsgNewTokenFeeDestination(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	tk := input.tokenKeeper
+	ak := input.accountKeeper
+	dk := input.distrKeeper
+	supplyKeeper := input.supplyKeeper
+
+	params := tk.GetParams(ctx)
+	params.NewTokenFee = TestNewTokenFee
+	params.NewTokenFeeSchedule = []types.SymbolLengthFee{types.NewSymbolLengthFee(3, TestNewTokenFee.MulRaw(2))}
+	params.FeeDestination = types.FeeDestinationBurn
+	tk.SetParams(ctx, params)
+
+	fromAddr := sdk.AccAddress([]byte("fromAddr"))
+	fromAcc := ak.GetOrNewAccount(ctx, fromAddr)
+	fromAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, TestNewTokenFee.MulRaw(5))))
+	ak.SetAccount(ctx, fromAcc)
+	nativeSupply := supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(sdk.NativeToken)
+
+	//a short symbol pays the premium fee, which is burned
+	msg := types.NewMsgNewToken(fromAddr, fromAddr, "abc", 18, sdk.NewInt(100), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
+	res := handleMsgNewToken(ctx, tk, msg)
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, TestNewTokenFee.MulRaw(3), ak.GetAccount(ctx, fromAddr).GetCoins().AmountOf(sdk.NativeToken))
+	assert.Equal(t, nativeSupply.Sub(TestNewTokenFee.MulRaw(2)), supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(sdk.NativeToken))
+
+	//a longer symbol pays the flat fee, which goes to the fee collector
+	params.FeeDestination = types.FeeDestinationFeeCollector
+	tk.SetParams(ctx, params)
+	msg = types.NewMsgNewToken(fromAddr, fromAddr, "abcd", 18, sdk.NewInt(100), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
+	res = handleMsgNewToken(ctx, tk, msg)
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, TestNewTokenFee.MulRaw(2), ak.GetAccount(ctx, fromAddr).GetCoins().AmountOf(sdk.NativeToken))
+	assert.Equal(t, TestNewTokenFee, supplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins().AmountOf(sdk.NativeToken))
+
+	//the inflate fee goes to the community pool
+	params.FeeDestination = types.FeeDestinationCommunityPool
+	params.InflateTokenFee = TestNewTokenFee
+	tk.SetParams(ctx, params)
+	inflateMsg := types.NewMsgInflateToken(fromAddr, fromAddr, sdk.NewCoins(sdk.NewCoin("abc", sdk.NewInt(100))))
+	res = handleMsgInflateToken(ctx, tk, inflateMsg)
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, TestNewTokenFee, ak.GetAccount(ctx, fromAddr).GetCoins().AmountOf(sdk.NativeToken))
+	assert.Equal(t, sdk.NewInt(200), ak.GetAccount(ctx, fromAddr).GetCoins().AmountOf("abc"))
+	assert.Equal(t, TestNewTokenFee, dk.GetFeePool(ctx).CommunityPool.AmountOf(sdk.NativeToken).TruncateInt())
+
+	//an issuer short of the inflate fee can not inflate
+	params.InflateTokenFee = TestNewTokenFee.MulRaw(2)
+	tk.SetParams(ctx, params)
+	res = handleMsgInflateToken(ctx, tk, inflateMsg)
+	assert.Equal(t, sdk.CodeInsufficientCoins, res.Code)
+	assert.Equal(t, sdk.NewInt(200), ak.GetAccount(ctx, fromAddr).GetCoins().AmountOf("abc"))
+
+	//an unknown fee destination fails instead of paying the community pool
+	params.FeeDestination = "burnn"
+	params.InflateTokenFee = TestNewTokenFee
+	tk.SetParams(ctx, params)
+	res = handleMsgInflateToken(ctx, tk, inflateMsg)
+	assert.Equal(t, types.CodeInvalidInput, res.Code)
+	assert.Equal(t, TestNewTokenFee, dk.GetFeePool(ctx).CommunityPool.AmountOf(sdk.NativeToken).TruncateInt())
+}
+
+func TestHandleMsgNewTokenReservedSymbol(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	tk := input.tokenKeeper
+	ak := input.accountKeeper
+
+	params := tk.GetParams(ctx)
+	params.NewTokenFee = TestNewTokenFee
+	tk.SetParams(ctx, params)
+
+	claimant := sdk.AccAddress([]byte("claimant"))
+	other := sdk.AccAddress([]byte("other"))
+	for _, addr := range []sdk.AccAddress{claimant, other} {
+		acc := ak.GetOrNewAccount(ctx, addr)
+		acc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, TestNewTokenFee.MulRaw(5))))
+		ak.SetAccount(ctx, acc)
+	}
+
+	tk.SetReservation(ctx, types.NewReservation("abc", claimant, time.Unix(2000, 0)))
+	tk.SetReservation(ctx, types.NewReservation("abd", claimant, time.Unix(500, 0)))
+
+	//only the claimant can issue a reserved symbol
+	msg := types.NewMsgNewToken(other, other, "abc", 18, sdk.NewInt(100), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
+	res := handleMsgNewToken(ctx, tk, msg)
+	assert.Equal(t, types.CodeSymbolReserved, res.Code)
+
+	msg = types.NewMsgNewToken(claimant, claimant, "abc", 18, sdk.NewInt(100), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
+	res = handleMsgNewToken(ctx, tk, msg)
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, claimant.String(), tk.GetIssuer(ctx, "abc"))
+	assert.Equal(t, types.EventTypeClaimSymbol, res.Events[len(res.Events)-1].Type)
+	_, found := tk.GetReservation(ctx, "abc")
+	assert.False(t, found)
+
+	//anyone can issue a symbol whose reservation has expired
+	msg = types.NewMsgNewToken(other, other, "abd", 18, sdk.NewInt(100), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
+	res = handleMsgNewToken(ctx, tk, msg)
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, other.String(), tk.GetIssuer(ctx, "abd"))
+	assert.Equal(t, types.EventTypeNewToken, res.Events[len(res.Events)-1].Type)
+	_, found = tk.GetReservation(ctx, "abd")
+	assert.False(t, found)
+}
+
 func TestHandleMsgInflateTokenSuccess(t *testing.T) {
 	input := setupTestEnv(t)
 	ctx := input.ctx
@@ -202,7 +322,7 @@ func TestHandleMsgInflateTokenSuccess(t *testing.T) {
 	totalAmt, ok := sdk.NewIntFromString("10000000000000000000000")
 	assert.True(t, ok)
 
-	msg := types.NewMsgNewToken(fromAddr, toAddr, "bhd", 18, totalAmt)
+	msg := types.NewMsgNewToken(fromAddr, toAddr, "bhd", 18, totalAmt, sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 
 	res := handleMsgNewToken(ctx, tk, msg)
 	assert.Equal(t, sdk.CodeOK, res.Code)
@@ -312,7 +432,7 @@ func TestHandleMsgInflateTokenFail(t *testing.T) {
 	totalAmt, ok := sdk.NewIntFromString("10000000000000000000000")
 	assert.True(t, ok)
 
-	msg := types.NewMsgNewToken(fromAddr, toAddr, "bhd", 18, totalAmt)
+	msg := types.NewMsgNewToken(fromAddr, toAddr, "bhd", 18, totalAmt, sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 
 	res := handleMsgNewToken(ctx, tk, msg)
 	assert.Equal(t, sdk.CodeOK, res.Code)
@@ -373,6 +493,11 @@ func TestHandleMsgInflateTokenFail(t *testing.T) {
 	res = handleMsgInflateToken(ctx, tk, infateMsg)
 	assert.Equal(t, sdk.CodeInvalidAccount, res.Code)
 
+	//fail because the recipient is a module account
+	infateMsg = types.NewMsgInflateToken(fromAddr, supplyKeeper.GetModuleAddress(types.ModuleName), inflateCoins)
+	res = handleMsgInflateToken(ctx, tk, infateMsg)
+	assert.Equal(t, sdk.CodeUnauthorized, res.Code)
+
 	//insufficient openFee
 	//param := tk.GetParams(ctx)
 	//param.NewTokenFee = TestNewTokenFee.MulRaw(5)
@@ -429,7 +554,7 @@ func TestHandleMsBurnTokenSuccess(t *testing.T) {
 	totalSupply, ok := sdk.NewIntFromString("10000000000000000000000")
 	assert.True(t, ok)
 
-	newMsg := types.NewMsgNewToken(fromAddr, toAddr, "bhd", 18, totalSupply)
+	newMsg := types.NewMsgNewToken(fromAddr, toAddr, "bhd", 18, totalSupply, sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	res := handleMsgNewToken(ctx, tk, newMsg)
 	assert.Equal(t, sdk.CodeOK, res.Code)
 	assert.Equal(t, 1, len(res.Events))
@@ -447,6 +572,13 @@ func TestHandleMsBurnTokenSuccess(t *testing.T) {
 	assert.Equal(t, 1, len(res.Events))
 	assert.Equal(t, types.EventTypeBurnToken, res.Events[0].Type)
 
+	//check receipt
+	receipts, err := sdk.GetReceiptsFromData(input.cdc, res.Data)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(receipts))
+	assert.Equal(t, sdk.CategoryTypeBurnToken, receipts[0].Category)
+	assert.Equal(t, []sdk.Flow{sdk.NewBalanceFlow(fromAddr, "bhd", totalSupply, burnAmt.Neg())}, receipts[0].Flows)
+
 	//check  coins
 	toAcc := ak.GetAccount(ctx, toAddr)
 	assert.Equal(t, totalSupply.Sub(burnAmt), toAcc.GetCoins().AmountOf("bhd"))
@@ -483,7 +615,7 @@ func TestHandleMsBurnTokenFail(t *testing.T) {
 	totalSupply, ok := sdk.NewIntFromString("10000000000000000000000")
 	assert.True(t, ok)
 
-	newMsg := types.NewMsgNewToken(fromAddr, toAddr, "bhd", 18, totalSupply)
+	newMsg := types.NewMsgNewToken(fromAddr, toAddr, "bhd", 18, totalSupply, sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	res := handleMsgNewToken(ctx, tk, newMsg)
 	assert.Equal(t, sdk.CodeOK, res.Code)
 	assert.Equal(t, 1, len(res.Events))
@@ -516,3 +648,563 @@ func TestHandleMsBurnTokenFail(t *testing.T) {
 	assert.Equal(t, totalSupply, supply)
 	assert.Equal(t, totalSupply, tk.GetTotalSupply(ctx, "bhd"))
 }
+
+func TestHandleMsgTransferTokenOwnership(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	tk := input.tokenKeeper
+	ak := input.accountKeeper
+
+	params := tk.GetParams(ctx)
+	params.NewTokenFee = TestNewTokenFee
+	tk.SetParams(ctx, params)
+
+	fromAddr, err := sdk.AccAddressFromBech32("poc1kkkjfhv7t4swrurnftedsx0lngvc523cfa00s3")
+	assert.Nil(t, err)
+	fromAcc := ak.GetOrNewAccount(ctx, fromAddr)
+	fromAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, TestNewTokenFee.MulRaw(5))))
+	ak.SetAccount(ctx, fromAcc)
+
+	toAddr, err := sdk.AccAddressFromBech32("poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr")
+	assert.Nil(t, err)
+
+	res := handleMsgNewToken(ctx, tk, types.NewMsgNewToken(fromAddr, fromAddr, "bhd", 18, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{}))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+
+	//native token's ownership can not be transferred
+	res = handleMsgTransferTokenOwnership(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgTransferTokenOwnership(fromAddr, toAddr, sdk.NativeToken, false))
+	assert.Equal(t, sdk.CodeInvalidTx, res.Code)
+
+	//token does not exist
+	res = handleMsgTransferTokenOwnership(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgTransferTokenOwnership(fromAddr, toAddr, "eth", false))
+	assert.Equal(t, sdk.CodeUnsupportToken, res.Code)
+
+	//only issuer can transfer ownership
+	res = handleMsgTransferTokenOwnership(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgTransferTokenOwnership(toAddr, fromAddr, "bhd", false))
+	assert.Equal(t, sdk.CodeInvalidAccount, res.Code)
+
+	//transfer without acceptance
+	res = handleMsgTransferTokenOwnership(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgTransferTokenOwnership(fromAddr, toAddr, "bhd", false))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, 1, len(res.Events))
+	assert.Equal(t, types.EventTypeTransferTokenOwnership, res.Events[0].Type)
+	ti := tk.GetTokenInfo(ctx, "bhd")
+	assert.Equal(t, toAddr.String(), ti.Issuer)
+	assert.Equal(t, "", ti.PendingIssuer)
+
+	//the old issuer is not allowed to inflate any more
+	res = handleMsgInflateToken(ctx, tk, types.NewMsgInflateToken(fromAddr, fromAddr, sdk.NewCoins(sdk.NewCoin("bhd", sdk.NewInt(100)))))
+	assert.Equal(t, sdk.CodeInvalidAccount, res.Code)
+	res = handleMsgInflateToken(ctx, tk, types.NewMsgInflateToken(toAddr, toAddr, sdk.NewCoins(sdk.NewCoin("bhd", sdk.NewInt(100)))))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+
+	//transfer back with acceptance
+	res = handleMsgTransferTokenOwnership(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgTransferTokenOwnership(toAddr, fromAddr, "bhd", true))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	ti = tk.GetTokenInfo(ctx, "bhd")
+	assert.Equal(t, toAddr.String(), ti.Issuer)
+	assert.Equal(t, fromAddr.String(), ti.PendingIssuer)
+
+	//only pending issuer can accept
+	res = handleMsgAcceptTokenOwnership(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgAcceptTokenOwnership(toAddr, "bhd"))
+	assert.Equal(t, types.CodeNoPendingOwner, res.Code)
+
+	res = handleMsgAcceptTokenOwnership(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgAcceptTokenOwnership(fromAddr, "bhd"))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, 1, len(res.Events))
+	assert.Equal(t, types.EventTypeAcceptTokenOwnership, res.Events[0].Type)
+	ti = tk.GetTokenInfo(ctx, "bhd")
+	assert.Equal(t, fromAddr.String(), ti.Issuer)
+	assert.Equal(t, "", ti.PendingIssuer)
+
+	//nothing pending any more
+	res = handleMsgAcceptTokenOwnership(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgAcceptTokenOwnership(fromAddr, "bhd"))
+	assert.Equal(t, types.CodeNoPendingOwner, res.Code)
+}
+
+func TestHandleMsgRenounceTokenMint(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	tk := input.tokenKeeper
+	ak := input.accountKeeper
+
+	params := tk.GetParams(ctx)
+	params.NewTokenFee = TestNewTokenFee
+	tk.SetParams(ctx, params)
+
+	fromAddr, err := sdk.AccAddressFromBech32("poc1kkkjfhv7t4swrurnftedsx0lngvc523cfa00s3")
+	assert.Nil(t, err)
+	fromAcc := ak.GetOrNewAccount(ctx, fromAddr)
+	fromAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, TestNewTokenFee.MulRaw(5))))
+	ak.SetAccount(ctx, fromAcc)
+
+	toAddr, err := sdk.AccAddressFromBech32("poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr")
+	assert.Nil(t, err)
+
+	res := handleMsgNewToken(ctx, tk, types.NewMsgNewToken(fromAddr, fromAddr, "bhd", 18, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{}))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+
+	//only issuer can renounce mint
+	res = handleMsgRenounceTokenMint(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgRenounceTokenMint(toAddr, "bhd"))
+	assert.Equal(t, sdk.CodeInvalidAccount, res.Code)
+	assert.False(t, tk.IsMintRenounced(ctx, "bhd"))
+
+	res = handleMsgRenounceTokenMint(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgRenounceTokenMint(fromAddr, "bhd"))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, 1, len(res.Events))
+	assert.Equal(t, types.EventTypeRenounceTokenMint, res.Events[0].Type)
+	assert.True(t, tk.IsMintRenounced(ctx, "bhd"))
+
+	//renounce twice
+	res = handleMsgRenounceTokenMint(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgRenounceTokenMint(fromAddr, "bhd"))
+	assert.Equal(t, types.CodeMintRenounced, res.Code)
+
+	//inflate is forbidden
+	res = handleMsgInflateToken(ctx, tk, types.NewMsgInflateToken(fromAddr, fromAddr, sdk.NewCoins(sdk.NewCoin("bhd", sdk.NewInt(100)))))
+	assert.Equal(t, types.CodeMintRenounced, res.Code)
+	assert.Equal(t, sdk.NewInt(10000), tk.GetTotalSupply(ctx, "bhd"))
+
+	//new issuer can not inflate either
+	res = handleMsgTransferTokenOwnership(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgTransferTokenOwnership(fromAddr, toAddr, "bhd", false))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	res = handleMsgInflateToken(ctx, tk, types.NewMsgInflateToken(toAddr, toAddr, sdk.NewCoins(sdk.NewCoin("bhd", sdk.NewInt(100)))))
+	assert.Equal(t, types.CodeMintRenounced, res.Code)
+
+	//burn is still allowed
+	res = handleMsgBurnToken(ctx, tk, types.NewMsgBurnToken(fromAddr, sdk.NewCoins(sdk.NewCoin("bhd", sdk.NewInt(100)))))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, sdk.NewInt(9900), tk.GetTotalSupply(ctx, "bhd"))
+}
+
+func TestHandleMsgSupplyFlags(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	tk := input.tokenKeeper
+	ak := input.accountKeeper
+
+	params := tk.GetParams(ctx)
+	params.NewTokenFee = TestNewTokenFee
+	tk.SetParams(ctx, params)
+
+	fromAddr, err := sdk.AccAddressFromBech32("poc1kkkjfhv7t4swrurnftedsx0lngvc523cfa00s3")
+	assert.Nil(t, err)
+	fromAcc := ak.GetOrNewAccount(ctx, fromAddr)
+	fromAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, TestNewTokenFee.MulRaw(5))))
+	ak.SetAccount(ctx, fromAcc)
+
+	//capped, mintable but not burnable
+	res := handleMsgNewToken(ctx, tk, types.NewMsgNewToken(fromAddr, fromAddr, "bhd", 18, sdk.NewInt(1000), sdk.NewInt(1500), true, false, false, false, sdk.TokenMetadata{}))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	ti := tk.GetTokenInfo(ctx, "bhd")
+	assert.Equal(t, sdk.NewInt(1500), ti.MaxSupply)
+	assert.True(t, ti.Mintable)
+	assert.False(t, ti.Burnable)
+
+	res = handleMsgInflateToken(ctx, tk, types.NewMsgInflateToken(fromAddr, fromAddr, sdk.NewCoins(sdk.NewCoin("bhd", sdk.NewInt(500)))))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, sdk.NewInt(1500), tk.GetTotalSupply(ctx, "bhd"))
+
+	res = handleMsgInflateToken(ctx, tk, types.NewMsgInflateToken(fromAddr, fromAddr, sdk.NewCoins(sdk.NewCoin("bhd", sdk.NewInt(1)))))
+	assert.Equal(t, types.CodeExceedMaxSupply, res.Code)
+	assert.Equal(t, sdk.NewInt(1500), tk.GetTotalSupply(ctx, "bhd"))
+
+	res = handleMsgBurnToken(ctx, tk, types.NewMsgBurnToken(fromAddr, sdk.NewCoins(sdk.NewCoin("bhd", sdk.NewInt(1)))))
+	assert.Equal(t, types.CodeNotBurnable, res.Code)
+
+	//not mintable but burnable
+	res = handleMsgNewToken(ctx, tk, types.NewMsgNewToken(fromAddr, fromAddr, "bhe", 18, sdk.NewInt(1000), sdk.ZeroInt(), false, true, false, false, sdk.TokenMetadata{}))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+
+	res = handleMsgInflateToken(ctx, tk, types.NewMsgInflateToken(fromAddr, fromAddr, sdk.NewCoins(sdk.NewCoin("bhe", sdk.NewInt(1)))))
+	assert.Equal(t, types.CodeNotMintable, res.Code)
+
+	res = handleMsgBurnToken(ctx, tk, types.NewMsgBurnToken(fromAddr, sdk.NewCoins(sdk.NewCoin("bhe", sdk.NewInt(1)))))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, sdk.NewInt(999), tk.GetTotalSupply(ctx, "bhe"))
+}
+
+func TestHandleMsgEditTokenMetadata(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	tk := input.tokenKeeper
+	ak := input.accountKeeper
+
+	params := tk.GetParams(ctx)
+	params.NewTokenFee = TestNewTokenFee
+	tk.SetParams(ctx, params)
+
+	fromAddr, err := sdk.AccAddressFromBech32("poc1kkkjfhv7t4swrurnftedsx0lngvc523cfa00s3")
+	assert.Nil(t, err)
+	fromAcc := ak.GetOrNewAccount(ctx, fromAddr)
+	fromAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, TestNewTokenFee.MulRaw(5))))
+	ak.SetAccount(ctx, fromAcc)
+
+	toAddr, err := sdk.AccAddressFromBech32("poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr")
+	assert.Nil(t, err)
+
+	metadata := sdk.NewTokenMetadata("BH Dollar", "a stable token", "https://bhd.io/logo.png", "https://bhd.io")
+	res := handleMsgNewToken(ctx, tk, types.NewMsgNewToken(fromAddr, fromAddr, "bhd", 18, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, metadata))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, metadata, tk.GetMetadata(ctx, "bhd"))
+
+	keep := sdk.DoNotModifyTokenMetadata
+
+	//only issuer can edit metadata
+	res = handleMsgEditTokenMetadata(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgEditTokenMetadata(toAddr, "bhd", sdk.NewTokenMetadata("evil", keep, keep, keep)))
+	assert.Equal(t, sdk.CodeInvalidAccount, res.Code)
+	assert.Equal(t, metadata, tk.GetMetadata(ctx, "bhd"))
+
+	//token does not exist
+	res = handleMsgEditTokenMetadata(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgEditTokenMetadata(fromAddr, "bhe", sdk.NewTokenMetadata("bhe", keep, keep, keep)))
+	assert.Equal(t, sdk.CodeUnsupportToken, res.Code)
+
+	res = handleMsgEditTokenMetadata(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgEditTokenMetadata(fromAddr, "bhd", sdk.NewTokenMetadata(keep, keep, keep, "https://bhd.org")))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, 1, len(res.Events))
+	assert.Equal(t, types.EventTypeEditTokenMetadata, res.Events[0].Type)
+	assert.Equal(t, sdk.NewTokenMetadata("BH Dollar", "a stable token", "https://bhd.io/logo.png", "https://bhd.org"), tk.GetMetadata(ctx, "bhd"))
+
+	//other fields are untouched
+	assert.Equal(t, fromAddr.String(), tk.GetIssuer(ctx, "bhd"))
+	assert.Equal(t, sdk.NewInt(10000), tk.GetTotalSupply(ctx, "bhd"))
+}
+
+func TestHandleMsgFreezeAccount(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	tk := input.tokenKeeper
+	ak := input.accountKeeper
+
+	params := tk.GetParams(ctx)
+	params.NewTokenFee = TestNewTokenFee
+	tk.SetParams(ctx, params)
+
+	fromAddr, err := sdk.AccAddressFromBech32("poc1kkkjfhv7t4swrurnftedsx0lngvc523cfa00s3")
+	assert.Nil(t, err)
+	fromAcc := ak.GetOrNewAccount(ctx, fromAddr)
+	fromAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, TestNewTokenFee.MulRaw(5))))
+	ak.SetAccount(ctx, fromAcc)
+
+	holder, err := sdk.AccAddressFromBech32("poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr")
+	assert.Nil(t, err)
+
+	res := handleMsgNewToken(ctx, tk, types.NewMsgNewToken(fromAddr, holder, "bhd", 18, sdk.NewInt(10000), sdk.ZeroInt(), true, true, true, false, sdk.TokenMetadata{}))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	res = handleMsgNewToken(ctx, tk, types.NewMsgNewToken(fromAddr, holder, "bhe", 18, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{}))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+
+	//only issuer can freeze
+	res = handleMsgFreezeAccount(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgFreezeAccount(holder, holder, "bhd"))
+	assert.Equal(t, sdk.CodeInvalidAccount, res.Code)
+
+	//token is not freezable
+	res = handleMsgFreezeAccount(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgFreezeAccount(fromAddr, holder, "bhe"))
+	assert.Equal(t, types.CodeNotFreezable, res.Code)
+
+	//native token can not be frozen
+	res = handleMsgFreezeAccount(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgFreezeAccount(fromAddr, holder, sdk.NativeToken))
+	assert.Equal(t, sdk.CodeInvalidTx, res.Code)
+
+	//module accounts can not be frozen
+	res = handleMsgFreezeAccount(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgFreezeAccount(fromAddr, supply.NewModuleAddress(types.AirdropAccountName), "bhd"))
+	assert.Equal(t, sdk.CodeUnauthorized, res.Code)
+	assert.Nil(t, tk.GetFrozenAccounts(ctx, "bhd"))
+
+	res = handleMsgFreezeAccount(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgFreezeAccount(fromAddr, holder, "bhd"))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, 1, len(res.Events))
+	assert.Equal(t, types.EventTypeFreezeAccount, res.Events[0].Type)
+	assert.True(t, tk.IsAccountFrozen(ctx, "bhd", holder))
+	assert.False(t, tk.IsAccountFrozen(ctx, "bhe", holder))
+	assert.Equal(t, []sdk.AccAddress{holder}, tk.GetFrozenAccounts(ctx, "bhd"))
+
+	//freeze twice
+	res = handleMsgFreezeAccount(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgFreezeAccount(fromAddr, holder, "bhd"))
+	assert.Equal(t, types.CodeAccountFrozen, res.Code)
+
+	//only issuer can unfreeze
+	res = handleMsgUnfreezeAccount(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgUnfreezeAccount(holder, holder, "bhd"))
+	assert.Equal(t, sdk.CodeInvalidAccount, res.Code)
+
+	//unfreeze is still allowed after the token becomes unfreezable
+	ti := tk.GetTokenInfoWithoutSupply(ctx, "bhd")
+	ti.Freezable = false
+	tk.SetTokenInfoWithoutSupply(ctx, ti)
+
+	res = handleMsgUnfreezeAccount(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgUnfreezeAccount(fromAddr, holder, "bhd"))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, 1, len(res.Events))
+	assert.Equal(t, types.EventTypeUnfreezeAccount, res.Events[0].Type)
+	assert.False(t, tk.IsAccountFrozen(ctx, "bhd", holder))
+	assert.Nil(t, tk.GetFrozenAccounts(ctx, "bhd"))
+
+	res = handleMsgUnfreezeAccount(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgUnfreezeAccount(fromAddr, holder, "bhd"))
+	assert.Equal(t, types.CodeAccountNotFrozen, res.Code)
+}
+
+func TestHandleMsgTokenStatus(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	tk := input.tokenKeeper
+	ak := input.accountKeeper
+
+	params := tk.GetParams(ctx)
+	params.NewTokenFee = TestNewTokenFee
+	tk.SetParams(ctx, params)
+
+	fromAddr, err := sdk.AccAddressFromBech32("poc1kkkjfhv7t4swrurnftedsx0lngvc523cfa00s3")
+	assert.Nil(t, err)
+	fromAcc := ak.GetOrNewAccount(ctx, fromAddr)
+	fromAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, TestNewTokenFee.MulRaw(5))))
+	ak.SetAccount(ctx, fromAcc)
+
+	newMsg := types.NewMsgNewToken(fromAddr, fromAddr, "bhd", 18, sdk.NewInt(1000000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
+	res := handleMsgNewToken(ctx, tk, newMsg)
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, sdk.TokenStatusActive, tk.GetStatus(ctx, "bhd"))
+
+	inflateMsg := types.NewMsgInflateToken(fromAddr, fromAddr, sdk.NewCoins(sdk.NewCoin("bhd", sdk.NewInt(100))))
+	burnMsg := types.NewMsgBurnToken(fromAddr, sdk.NewCoins(sdk.NewCoin("bhd", sdk.NewInt(10))))
+
+	//paused, nothing is allowed
+	tk.SetStatus(ctx, "bhd", sdk.TokenStatusPaused, 0, "")
+	res = handleMsgInflateToken(ctx, tk, inflateMsg)
+	assert.Equal(t, types.CodeInvalidStatus, res.Code)
+	res = handleMsgBurnToken(ctx, tk, burnMsg)
+	assert.Equal(t, types.CodeInvalidStatus, res.Code)
+
+	//migrating, burn but no inflation
+	tk.SetStatus(ctx, "bhd", sdk.TokenStatusMigrating, 0, EthToken)
+	res = handleMsgInflateToken(ctx, tk, inflateMsg)
+	assert.Equal(t, types.CodeInvalidStatus, res.Code)
+	res = handleMsgBurnToken(ctx, tk, burnMsg)
+	assert.Equal(t, sdk.CodeOK, res.Code)
+
+	//delisted, holders can still burn although the token can not be sent
+	tk.SetStatus(ctx, "bhd", sdk.TokenStatusDelisted, 0, "")
+	assert.False(t, tk.IsSendEnabled(ctx, "bhd"))
+	res = handleMsgInflateToken(ctx, tk, inflateMsg)
+	assert.Equal(t, types.CodeInvalidStatus, res.Code)
+	res = handleMsgBurnToken(ctx, tk, burnMsg)
+	assert.Equal(t, sdk.CodeOK, res.Code)
+
+	//active again
+	tk.SetStatus(ctx, "bhd", sdk.TokenStatusActive, 0, "")
+	res = handleMsgInflateToken(ctx, tk, inflateMsg)
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, sdk.NewInt(1000080), tk.GetTotalSupply(ctx, "bhd"))
+	assert.Equal(t, 4, len(tk.GetStatusTransitions(ctx, "bhd")))
+}
+
+func TestHandleMsgPauseToken(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	tk := input.tokenKeeper
+	ak := input.accountKeeper
+
+	params := tk.GetParams(ctx)
+	params.NewTokenFee = TestNewTokenFee
+	tk.SetParams(ctx, params)
+
+	fromAddr, err := sdk.AccAddressFromBech32("poc1kkkjfhv7t4swrurnftedsx0lngvc523cfa00s3")
+	assert.Nil(t, err)
+	fromAcc := ak.GetOrNewAccount(ctx, fromAddr)
+	fromAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, TestNewTokenFee.MulRaw(5))))
+	ak.SetAccount(ctx, fromAcc)
+
+	holder, err := sdk.AccAddressFromBech32("poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr")
+	assert.Nil(t, err)
+
+	res := handleMsgNewToken(ctx, tk, types.NewMsgNewToken(fromAddr, holder, "bhd", 18, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, true, sdk.TokenMetadata{}))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	res = handleMsgNewToken(ctx, tk, types.NewMsgNewToken(fromAddr, holder, "bhe", 18, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{}))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.True(t, tk.IsPausable(ctx, "bhd"))
+	assert.False(t, tk.IsPausable(ctx, "bhe"))
+
+	//only issuer can pause
+	res = handleMsgPauseToken(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgPauseToken(holder, "bhd"))
+	assert.Equal(t, sdk.CodeInvalidAccount, res.Code)
+
+	//token is not pausable
+	res = handleMsgPauseToken(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgPauseToken(fromAddr, "bhe"))
+	assert.Equal(t, types.CodeNotPausable, res.Code)
+
+	//native token can not be paused
+	res = handleMsgPauseToken(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgPauseToken(fromAddr, sdk.NativeToken))
+	assert.Equal(t, sdk.CodeInvalidTx, res.Code)
+
+	//unpause a token which is not paused
+	res = handleMsgUnpauseToken(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgUnpauseToken(fromAddr, "bhd"))
+	assert.Equal(t, types.CodeInvalidStatus, res.Code)
+
+	res = handleMsgPauseToken(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgPauseToken(fromAddr, "bhd"))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, 1, len(res.Events))
+	assert.Equal(t, types.EventTypePauseToken, res.Events[0].Type)
+	assert.Equal(t, sdk.TokenStatusPaused, tk.GetStatus(ctx, "bhd"))
+	assert.True(t, tk.IsPausedByIssuer(ctx, "bhd"))
+	assert.False(t, tk.IsSendEnabled(ctx, "bhd"))
+	assert.True(t, tk.IsSendEnabled(ctx, "bhe"))
+
+	transitions := tk.GetStatusTransitions(ctx, "bhd")
+	assert.Equal(t, 1, len(transitions))
+	assert.Equal(t, fromAddr, transitions[0].Issuer)
+	assert.Equal(t, uint64(0), transitions[0].ProposalID)
+
+	//pause twice
+	res = handleMsgPauseToken(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgPauseToken(fromAddr, "bhd"))
+	assert.Equal(t, types.CodeInvalidStatus, res.Code)
+
+	//only issuer can unpause
+	res = handleMsgUnpauseToken(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgUnpauseToken(holder, "bhd"))
+	assert.Equal(t, sdk.CodeInvalidAccount, res.Code)
+
+	res = handleMsgUnpauseToken(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgUnpauseToken(fromAddr, "bhd"))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, 1, len(res.Events))
+	assert.Equal(t, types.EventTypeUnpauseToken, res.Events[0].Type)
+	assert.Equal(t, sdk.TokenStatusActive, tk.GetStatus(ctx, "bhd"))
+	assert.True(t, tk.IsSendEnabled(ctx, "bhd"))
+	assert.Equal(t, 2, len(tk.GetStatusTransitions(ctx, "bhd")))
+
+	//a pause by governance can not be lifted by the issuer
+	tk.SetStatus(ctx, "bhd", sdk.TokenStatusPaused, 1, "")
+	assert.False(t, tk.IsPausedByIssuer(ctx, "bhd"))
+	res = handleMsgUnpauseToken(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgUnpauseToken(fromAddr, "bhd"))
+	assert.Equal(t, types.CodeInvalidStatus, res.Code)
+	assert.Equal(t, sdk.TokenStatusPaused, tk.GetStatus(ctx, "bhd"))
+}
+
+func TestHandleMsgAirdrop(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+	tk := input.tokenKeeper
+	ak := input.accountKeeper
+
+	params := tk.GetParams(ctx)
+	params.NewTokenFee = TestNewTokenFee
+	tk.SetParams(ctx, params)
+
+	fromAddr, err := sdk.AccAddressFromBech32("poc1kkkjfhv7t4swrurnftedsx0lngvc523cfa00s3")
+	assert.Nil(t, err)
+	fromAcc := ak.GetOrNewAccount(ctx, fromAddr)
+	fromAcc.SetCoins(sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, TestNewTokenFee.MulRaw(5))))
+	ak.SetAccount(ctx, fromAcc)
+
+	alice, err := sdk.AccAddressFromBech32("poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr")
+	assert.Nil(t, err)
+	bob := sdk.AccAddress([]byte("airdrop-recipient-bob"))
+
+	res := handleMsgNewToken(ctx, tk, types.NewMsgNewToken(fromAddr, fromAddr, "bhd", 18, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{}))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+
+	entries := []types.AirdropEntry{
+		types.NewAirdropEntry(alice, sdk.NewInt(100)),
+		types.NewAirdropEntry(bob, sdk.NewInt(200)),
+		types.NewAirdropEntry(fromAddr, sdk.NewInt(300)),
+	}
+	root, proofs := types.BuildAirdropTree(entries)
+	amount := sdk.NewCoin("bhd", sdk.NewInt(600))
+	deadline := time.Unix(2000, 0).UTC()
+
+	//only issuer can airdrop
+	res = handleMsgCreateAirdrop(ctx, tk, types.NewMsgCreateAirdrop(alice, amount, root, 3, deadline))
+	assert.Equal(t, sdk.CodeInvalidAccount, res.Code)
+
+	//deadline has passed
+	res = handleMsgCreateAirdrop(ctx, tk, types.NewMsgCreateAirdrop(fromAddr, amount, root, 3, ctx.BlockHeader().Time))
+	assert.Equal(t, types.CodeInvalidAirdrop, res.Code)
+
+	res = handleMsgCreateAirdrop(ctx.WithEventManager(sdk.NewEventManager()), tk, types.NewMsgCreateAirdrop(fromAddr, amount, root, 3, deadline))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, 1, len(res.Events))
+	assert.Equal(t, types.EventTypeCreateAirdrop, res.Events[0].Type)
+	assert.Equal(t, sdk.NewInt(9400), tk.GetCoins(ctx, fromAddr).AmountOf("bhd"))
+	assert.Equal(t, sdk.NewCoins(amount), tk.GetAirdropAccount(ctx).GetCoins())
+	assert.Equal(t, types.DefaultStartingAirdropID+1, tk.GetNextAirdropID(ctx))
+
+	airdropID := types.DefaultStartingAirdropID
+	claim := func(from sdk.AccAddress, index uint64, amt int64) sdk.Result {
+		msg := types.NewMsgClaimAirdrop(from, airdropID, index, sdk.NewInt(amt), proofs[index].Aunts)
+		return handleMsgClaimAirdrop(ctx.WithEventManager(sdk.NewEventManager()), tk, msg)
+	}
+
+	//unknown airdrop
+	res = handleMsgClaimAirdrop(ctx, tk, types.NewMsgClaimAirdrop(alice, 100, 0, sdk.NewInt(100), proofs[0].Aunts))
+	assert.Equal(t, types.CodeUnknownAirdrop, res.Code)
+
+	//wrong amount, wrong index and wrong recipient
+	res = claim(alice, 0, 101)
+	assert.Equal(t, types.CodeInvalidAirdrop, res.Code)
+	res = claim(alice, 1, 100)
+	assert.Equal(t, types.CodeInvalidAirdrop, res.Code)
+	res = claim(bob, 0, 100)
+	assert.Equal(t, types.CodeInvalidAirdrop, res.Code)
+
+	res = claim(alice, 0, 100)
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, 1, len(res.Events))
+	assert.Equal(t, types.EventTypeClaimAirdrop, res.Events[0].Type)
+	assert.Equal(t, sdk.NewInt(100), tk.GetCoins(ctx, alice).AmountOf("bhd"))
+	assert.True(t, tk.IsAirdropClaimed(ctx, airdropID, 0))
+	airdrop, found := tk.GetAirdrop(ctx, airdropID)
+	assert.True(t, found)
+	assert.Equal(t, sdk.NewInt(500), airdrop.Remaining)
+
+	//claim twice
+	res = claim(alice, 0, 100)
+	assert.Equal(t, types.CodeAirdropClaimed, res.Code)
+
+	//frozen recipient
+	tk.FreezeAccount(ctx, "bhd", bob)
+	res = claim(bob, 1, 200)
+	assert.Equal(t, types.CodeAccountFrozen, res.Code)
+	tk.UnfreezeAccount(ctx, "bhd", bob)
+
+	res = claim(bob, 1, 200)
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	assert.Equal(t, sdk.NewInt(200), tk.GetCoins(ctx, bob).AmountOf("bhd"))
+
+	_, broken := AirdropFundsInvariant(tk)(ctx)
+	assert.False(t, broken)
+
+	//nothing is refunded before the deadline
+	EndBlocker(ctx, tk)
+	_, found = tk.GetAirdrop(ctx, airdropID)
+	assert.True(t, found)
+
+	//the airdrop can not be claimed after the deadline
+	ctx = ctx.WithBlockTime(deadline)
+	res = claim(fromAddr, 2, 300)
+	assert.Equal(t, types.CodeInvalidAirdrop, res.Code)
+
+	//a failed refund does not panic and is retried in the next blocks
+	airdropAcc := tk.GetAirdropAccount(ctx)
+	airdropCoins := airdropAcc.GetCoins()
+	assert.Nil(t, airdropAcc.SetCoins(nil))
+	input.supplyKeeper.SetModuleAccount(ctx, airdropAcc)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	assert.NotPanics(t, func() { EndBlocker(ctx, tk) })
+	events := ctx.EventManager().Events()
+	assert.Equal(t, types.EventTypeRefundAirdropFailed, events[len(events)-1].Type)
+	_, found = tk.GetAirdrop(ctx, airdropID)
+	assert.True(t, found)
+	assert.Nil(t, airdropAcc.SetCoins(airdropCoins))
+	input.supplyKeeper.SetModuleAccount(ctx, airdropAcc)
+
+	//the unclaimed amount returns to the issuer at the deadline
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx, tk)
+	events = ctx.EventManager().Events()
+	assert.Equal(t, types.EventTypeRefundAirdrop, events[len(events)-1].Type)
+	_, found = tk.GetAirdrop(ctx, airdropID)
+	assert.False(t, found)
+	assert.False(t, tk.IsAirdropClaimed(ctx, airdropID, 0))
+	assert.Equal(t, 0, len(tk.GetAirdropClaims(ctx)))
+	assert.Equal(t, sdk.NewInt(9700), tk.GetCoins(ctx, fromAddr).AmountOf("bhd"))
+	assert.True(t, tk.GetAirdropAccount(ctx).GetCoins().IsZero())
+
+	_, broken = AirdropFundsInvariant(tk)(ctx)
+	assert.False(t, broken)
+}
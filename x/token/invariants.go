@@ -0,0 +1,120 @@
+package token
+
+import (
+	"fmt"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/token/types"
+)
+
+// RegisterInvariants registers the token module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "supply-token-info",
+		SupplyTokenInfoInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-supply",
+		TotalSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account-balance",
+		ModuleAccountBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "airdrop-funds",
+		AirdropFundsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the token module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := SupplyTokenInfoInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = TotalSupplyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = ModuleAccountBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return AirdropFundsInvariant(k)(ctx)
+	}
+}
+
+// SupplyTokenInfoInvariant checks that every denom with a non-zero supply in x/supply has a token info
+func SupplyTokenInfoInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		for _, coin := range k.sk.GetSupply(ctx).GetTotal() {
+			if !coin.Amount.IsPositive() {
+				continue
+			}
+			if k.GetTokenInfoWithoutSupply(ctx, sdk.Symbol(coin.Denom)) == nil {
+				count++
+				msg += fmt.Sprintf("\t%s has a supply of %s but no token info\n", coin.Denom, coin.Amount)
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "supply-token-info",
+			fmt.Sprintf("amount of denoms without token info found %d\n%s", count, msg)), broken
+	}
+}
+
+// TotalSupplyInvariant checks that the sum of all account balances of every
+// registered token equals its total supply
+func TotalSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		var balances sdk.Coins
+		for _, acc := range k.ak.GetAllAccounts(ctx) {
+			balances = balances.Add(acc.GetCoins())
+		}
+
+		for _, symbol := range k.GetSymbols(ctx) {
+			expected := k.GetTotalSupply(ctx, sdk.Symbol(symbol))
+			balance := balances.AmountOf(symbol)
+			if !balance.Equal(expected) {
+				count++
+				msg += fmt.Sprintf("\t%s: sum of balances %s, total supply %s\n", symbol, balance, expected)
+			}
+		}
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "total-supply",
+			fmt.Sprintf("amount of tokens with inconsistent supply found %d\n%s", count, msg)), broken
+	}
+}
+
+// ModuleAccountBalanceInvariant checks that the token module account holds no coins,
+// since minted coins are sent out on inflate and received coins are burned right away
+func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var balance sdk.Coins
+		if acc := k.ak.GetAccount(ctx, k.sk.GetModuleAddress(types.ModuleName)); acc != nil {
+			balance = acc.GetCoins()
+		}
+
+		broken := !balance.IsZero()
+
+		return sdk.FormatInvariant(types.ModuleName, "module-account-balance",
+			fmt.Sprintf("\ttoken module account coins: %s\n", balance)), broken
+	}
+}
+
+// AirdropFundsInvariant checks that the airdrop module account holds exactly the unclaimed amounts of the airdrops
+func AirdropFundsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var balance sdk.Coins
+		if acc := k.ak.GetAccount(ctx, k.sk.GetModuleAddress(types.AirdropAccountName)); acc != nil {
+			balance = acc.GetCoins()
+		}
+		expected := k.GetAirdrops(ctx).Total()
+
+		broken := !balance.IsEqual(expected)
+
+		return sdk.FormatInvariant(types.ModuleName, "airdrop-funds",
+			fmt.Sprintf("\tairdrop module account coins: %s\n\tunclaimed airdrops: %s\n", balance, expected)), broken
+	}
+}
@@ -0,0 +1,68 @@
+package token
+
+import (
+	"testing"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/supply"
+	"github.com/pocblockchain/pocc/x/token/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvariants(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	tk := input.tokenKeeper
+	ak := input.accountKeeper
+	supplyKeeper := input.supplyKeeper
+
+	params := tk.GetParams(ctx)
+	params.NewTokenFee = TestNewTokenFee
+	tk.SetParams(ctx, params)
+
+	_, broken := AllInvariants(tk)(ctx)
+	assert.False(t, broken)
+
+	// fund the issuer and keep the supply consistent
+	fromAddr, err := sdk.AccAddressFromBech32("poc1kkkjfhv7t4swrurnftedsx0lngvc523cfa00s3")
+	assert.Nil(t, err)
+	funds := sdk.NewCoins(sdk.NewCoin(sdk.NativeToken, TestNewTokenFee.MulRaw(5)))
+	fromAcc := ak.GetOrNewAccount(ctx, fromAddr)
+	fromAcc.SetCoins(funds)
+	ak.SetAccount(ctx, fromAcc)
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(supplyKeeper.GetSupply(ctx).GetTotal().Add(funds)))
+
+	// issue, inflate and burn a token
+	totalAmt := sdk.NewInt(1000000)
+	res := handleMsgNewToken(ctx, tk, types.NewMsgNewToken(fromAddr, fromAddr, "bhd", 18, totalAmt, sdk.ZeroInt(), true, true, true, false, sdk.TokenMetadata{}))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	res = handleMsgInflateToken(ctx, tk, types.NewMsgInflateToken(fromAddr, fromAddr, sdk.NewCoins(sdk.NewCoin("bhd", sdk.NewInt(500)))))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+	res = handleMsgBurnToken(ctx, tk, types.NewMsgBurnToken(fromAddr, sdk.NewCoins(sdk.NewCoin("bhd", sdk.NewInt(200)))))
+	assert.Equal(t, sdk.CodeOK, res.Code)
+
+	_, broken = AllInvariants(tk)(ctx)
+	assert.False(t, broken)
+
+	// a denom in supply without token info
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(supplyKeeper.GetSupply(ctx).GetTotal().Add(sdk.NewCoins(sdk.NewCoin(EthToken, sdk.NewInt(100))))))
+	_, broken = SupplyTokenInfoInvariant(tk)(ctx)
+	assert.True(t, broken)
+
+	// a token whose supply does not match the balances
+	tsi := castToTokenInfoWithoutSupply(TestTokenData[2])
+	tk.SetTokenInfoWithoutSupply(ctx, &tsi)
+	_, broken = SupplyTokenInfoInvariant(tk)(ctx)
+	assert.False(t, broken)
+	msg, broken := TotalSupplyInvariant(tk)(ctx)
+	assert.True(t, broken)
+	assert.Contains(t, msg, EthToken)
+
+	// coins left in the token module account
+	_, broken = ModuleAccountBalanceInvariant(tk)(ctx)
+	assert.False(t, broken)
+	err = supplyKeeper.SendCoinsFromAccountToModule(ctx, fromAddr, types.ModuleName, sdk.NewCoins(sdk.NewCoin("bhd", sdk.NewInt(100))))
+	assert.Nil(t, err)
+	_, broken = ModuleAccountBalanceInvariant(tk)(ctx)
+	assert.True(t, broken)
+}
@@ -13,8 +13,11 @@ package token
 
 import (
 	"bytes"
+	"sort"
+
 	"github.com/pocblockchain/pocc/codec"
 	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/auth"
 	"github.com/pocblockchain/pocc/x/params"
 	"github.com/pocblockchain/pocc/x/supply"
 	"github.com/pocblockchain/pocc/x/token/types"
@@ -23,6 +26,30 @@ import (
 // TokenStoreKeyPrefix define prefix for storing tokeninfk
 var TokenStoreKeyPrefix = []byte{0x01}
 
+// FrozenAccountKeyPrefix define prefix for storing frozen accounts of a token
+var FrozenAccountKeyPrefix = []byte{0x02}
+
+// StatusTransitionKeyPrefix define prefix for storing the status transitions of a token
+var StatusTransitionKeyPrefix = []byte{0x03}
+
+// IssuerTokenKeyPrefix define prefix for indexing tokens by their issuer
+var IssuerTokenKeyPrefix = []byte{0x04}
+
+// ReservationKeyPrefix define prefix for storing the reservations of symbols
+var ReservationKeyPrefix = []byte{0x05}
+
+// AirdropIDKey define key for storing the ID of the next airdrop
+var AirdropIDKey = []byte{0x06}
+
+// AirdropKeyPrefix define prefix for storing airdrops by their IDs
+var AirdropKeyPrefix = []byte{0x07}
+
+// AirdropQueueKeyPrefix define prefix for queueing airdrops by their deadlines
+var AirdropQueueKeyPrefix = []byte{0x08}
+
+// AirdropClaimKeyPrefix define prefix for storing the claimed leaves of airdrops
+var AirdropClaimKeyPrefix = []byte{0x09}
+
 /*
  Note:
 	TokenInfoWithoutSupply stored in token module and total supply stored in supply module.
@@ -41,32 +68,106 @@ type TokenKeeper interface {
 	IsSendEnabled(ctx sdk.Context, symbol sdk.Symbol) bool
 	EnableSend(ctx sdk.Context, symbol sdk.Symbol)
 	DisableSend(ctx sdk.Context, symbol sdk.Symbol)
+
+	GetPendingIssuer(ctx sdk.Context, symbol sdk.Symbol) string
+	TransferOwnership(ctx sdk.Context, symbol sdk.Symbol, newIssuer sdk.AccAddress)
+	SetPendingIssuer(ctx sdk.Context, symbol sdk.Symbol, pendingIssuer sdk.AccAddress)
+	AcceptOwnership(ctx sdk.Context, symbol sdk.Symbol)
+
+	IsMintRenounced(ctx sdk.Context, symbol sdk.Symbol) bool
+	RenounceMint(ctx sdk.Context, symbol sdk.Symbol)
+
+	GetMaxSupply(ctx sdk.Context, symbol sdk.Symbol) sdk.Int
+	IsMintable(ctx sdk.Context, symbol sdk.Symbol) bool
+	IsBurnable(ctx sdk.Context, symbol sdk.Symbol) bool
+	IsFreezable(ctx sdk.Context, symbol sdk.Symbol) bool
+
+	GetStatus(ctx sdk.Context, symbol sdk.Symbol) sdk.TokenStatus
+	SetStatus(ctx sdk.Context, symbol sdk.Symbol, status sdk.TokenStatus, proposalID uint64, successor string)
+	SetStatusByIssuer(ctx sdk.Context, symbol sdk.Symbol, status sdk.TokenStatus, issuer sdk.AccAddress)
+	IsPausable(ctx sdk.Context, symbol sdk.Symbol) bool
+	IsPausedByIssuer(ctx sdk.Context, symbol sdk.Symbol) bool
+	GetStatusTransitions(ctx sdk.Context, symbol sdk.Symbol) []types.TokenStatusTransition
+
+	GetMetadata(ctx sdk.Context, symbol sdk.Symbol) sdk.TokenMetadata
+	SetMetadata(ctx sdk.Context, symbol sdk.Symbol, metadata sdk.TokenMetadata)
+
+	IsAccountFrozen(ctx sdk.Context, symbol sdk.Symbol, addr sdk.AccAddress) bool
+	FreezeAccount(ctx sdk.Context, symbol sdk.Symbol, addr sdk.AccAddress)
+	UnfreezeAccount(ctx sdk.Context, symbol sdk.Symbol, addr sdk.AccAddress)
+	GetFrozenAccounts(ctx sdk.Context, symbol sdk.Symbol) []sdk.AccAddress
+
+	GetReservation(ctx sdk.Context, symbol sdk.Symbol) (types.Reservation, bool)
+	SetReservation(ctx sdk.Context, reservation types.Reservation)
+	DeleteReservation(ctx sdk.Context, symbol sdk.Symbol)
+	GetReservations(ctx sdk.Context) []types.Reservation
 }
 
 //Keeper ...
 type Keeper struct {
 	storeKey      sdk.StoreKey // Unexposed key to access store from sdk.Context
 	cdc           *codec.Codec // The wire codec for binary encoding/decoding
+	ak            types.AccountKeeper
 	dk            types.DistrKeeper
 	sk            types.SupplyKeeper
 	paramSubSpace params.Subspace
+	cache         *tokenCache // LRU cache of decoded TokenInfoWithoutSupply, sized by TokenCacheSize
+
+	blacklistedAddrs map[string]bool // module accounts, which tokens can not be issued to nor frozen
 }
 
 //NewKeeper create token's Keeper
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, dk types.DistrKeeper, sk types.SupplyKeeper, paramSubSpace params.Subspace) Keeper {
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, ak types.AccountKeeper, dk types.DistrKeeper, sk types.SupplyKeeper,
+	paramSubSpace params.Subspace, blacklistedAddrs map[string]bool) Keeper {
 	return Keeper{
-		storeKey:      storeKey,
-		cdc:           cdc,
-		dk:            dk,
-		sk:            sk,
-		paramSubSpace: paramSubSpace.WithKeyTable(types.ParamKeyTable()),
+		storeKey:         storeKey,
+		cdc:              cdc,
+		ak:               ak,
+		dk:               dk,
+		sk:               sk,
+		paramSubSpace:    paramSubSpace.WithKeyTable(types.ParamKeyTable()),
+		cache:            newTokenCache(types.DefaultTokenCacheSize),
+		blacklistedAddrs: blacklistedAddrs,
 	}
 }
 
+// BlacklistedAddr checks if a given address is blacklisted, i.e. a module account
+func (k *Keeper) BlacklistedAddr(addr sdk.AccAddress) bool {
+	return k.blacklistedAddrs[addr.String()]
+}
+
 func tokenStoreKey(symbol string) []byte {
 	return append(TokenStoreKeyPrefix, []byte(symbol)...)
 }
 
+// frozenAccountsPrefix returns the prefix of all frozen accounts of a token,
+// the separator keeps a symbol from matching the accounts of a longer one
+func frozenAccountsPrefix(symbol string) []byte {
+	return append(append(FrozenAccountKeyPrefix, []byte(symbol)...), ':')
+}
+
+func frozenAccountKey(symbol string, addr sdk.AccAddress) []byte {
+	return append(frozenAccountsPrefix(symbol), addr.Bytes()...)
+}
+
+func statusTransitionKey(symbol string) []byte {
+	return append(StatusTransitionKeyPrefix, []byte(symbol)...)
+}
+
+func reservationKey(symbol string) []byte {
+	return append(ReservationKeyPrefix, []byte(symbol)...)
+}
+
+// issuerTokensPrefix returns the prefix of all tokens issued by issuer,
+// the separator keeps an issuer from matching the tokens of a longer one
+func issuerTokensPrefix(issuer string) []byte {
+	return append(append(IssuerTokenKeyPrefix, []byte(issuer)...), ':')
+}
+
+func issuerTokenKey(issuer, symbol string) []byte {
+	return append(issuerTokensPrefix(issuer), []byte(symbol)...)
+}
+
 var _ TokenKeeper = (*Keeper)(nil)
 
 //SetTokenInfoWithoutSupply sets TokenInfoWithoutSupply
@@ -77,14 +178,102 @@ func (k *Keeper) SetTokenInfoWithoutSupply(ctx sdk.Context, tokenInfo *sdk.Token
 		Issuer:        tokenInfo.Issuer,
 		Decimals:      tokenInfo.Decimals,
 		IsSendEnabled: tokenInfo.IsSendEnabled,
+		PendingIssuer: tokenInfo.PendingIssuer,
+		MintRenounced: tokenInfo.MintRenounced,
+		MaxSupply:     tokenInfo.MaxSupply,
+		Mintable:      tokenInfo.Mintable,
+		Burnable:      tokenInfo.Burnable,
+		Freezable:     tokenInfo.Freezable,
+		Pausable:      tokenInfo.Pausable,
+		Status:        tokenInfo.Status,
+		Metadata:      tokenInfo.Metadata,
 	}
+	k.updateIssuerIndex(ctx, tokenInfo.Symbol.String(), tokenInfo.Issuer)
 	store.Set(tokenStoreKey(tokenInfo.Symbol.String()), k.cdc.MustMarshalBinaryBare(ti))
+	k.cache.remove(tokenInfo.Symbol.String())
 }
 
 //DeleteTokenInfoWithoutSupply delete TokenInfoWithoutSupply
 func (k *Keeper) DeleteTokenInfoWithoutSupply(ctx sdk.Context, symbol string) {
+	k.updateIssuerIndex(ctx, symbol, "")
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(tokenStoreKey(symbol))
+	k.cache.remove(symbol)
+}
+
+//updateIssuerIndex moves the token from the index entry of its stored issuer to the one of issuer,
+//it must be called before the token info itself is written, an empty issuer removes the entry
+func (k *Keeper) updateIssuerIndex(ctx sdk.Context, symbol, issuer string) {
+	store := ctx.KVStore(k.storeKey)
+	if bz := store.Get(tokenStoreKey(symbol)); bz != nil {
+		var old sdk.TokenInfoWithoutSupply
+		k.cdc.MustUnmarshalBinaryBare(bz, &old)
+		if old.Issuer == issuer {
+			return
+		}
+		if old.Issuer != "" {
+			store.Delete(issuerTokenKey(old.Issuer, symbol))
+		}
+	}
+
+	if issuer != "" {
+		store.Set(issuerTokenKey(issuer, symbol), []byte{})
+	}
+}
+
+//GetSymbolsByIssuer returns the symbols of the tokens issued by issuer, sorted by symbol
+func (k *Keeper) GetSymbolsByIssuer(ctx sdk.Context, issuer string) []string {
+	var symbols []string
+	store := ctx.KVStore(k.storeKey)
+	prefix := issuerTokensPrefix(issuer)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		symbols = append(symbols, string(bytes.TrimPrefix(iter.Key(), prefix)))
+	}
+	return symbols
+}
+
+//GetSymbolsByPrefix returns the symbols starting with prefix, sorted by symbol
+func (k *Keeper) GetSymbolsByPrefix(ctx sdk.Context, prefix string) []string {
+	var symbols []string
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, tokenStoreKey(prefix))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		symbols = append(symbols, string(bytes.TrimPrefix(iter.Key(), TokenStoreKeyPrefix)))
+	}
+	return symbols
+}
+
+//IterateSymbols iterates in order over the symbols starting with prefix which sort after the cursor after,
+//only over the ones of the tokens issued by issuer if it is not empty. Both the issuer index and the token
+//store are keyed by symbol, so the iteration starts right at the cursor and stops as soon as cb returns true.
+func (k *Keeper) IterateSymbols(ctx sdk.Context, issuer, prefix, after string, cb func(symbol string) (stop bool)) {
+	keyPrefix := TokenStoreKeyPrefix
+	if issuer != "" {
+		keyPrefix = issuerTokensPrefix(issuer)
+	}
+
+	start := append(append([]byte{}, keyPrefix...), []byte(prefix)...)
+	end := sdk.PrefixEndBytes(start)
+	if after != "" && after >= prefix {
+		//the smallest key greater than the one of after
+		cursor := append(append(append([]byte{}, keyPrefix...), []byte(after)...), 0x00)
+		if end != nil && bytes.Compare(cursor, end) >= 0 {
+			return
+		}
+		start = cursor
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if cb(string(bytes.TrimPrefix(iter.Key(), keyPrefix))) {
+			break
+		}
+	}
 }
 
 //GetAllTokenInfoWithoutSupply get all token's TokenInfoWithoutSupply
@@ -94,9 +283,7 @@ func (k *Keeper) GetAllTokenInfoWithoutSupply(ctx sdk.Context) []sdk.TokenInfoWi
 	iter := sdk.KVStorePrefixIterator(store, TokenStoreKeyPrefix)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
-		var ti sdk.TokenInfoWithoutSupply
-		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &ti)
-		tokens = append(tokens, ti)
+		tokens = append(tokens, k.decodeTokenInfoWithoutSupply(iter.Value()))
 	}
 	return tokens
 }
@@ -108,12 +295,36 @@ func (k *Keeper) GetTokenInfoWithoutSupply(ctx sdk.Context, symbol sdk.Symbol) *
 		return nil
 	}
 	bz := store.Get(tokenStoreKey(symbol.String()))
-	var ti sdk.TokenInfoWithoutSupply
-	k.cdc.MustUnmarshalBinaryBare(bz, &ti)
+	if cached, ok := k.cache.get(symbol.String(), bz); ok {
+		return &cached
+	}
+
+	ti := k.decodeTokenInfoWithoutSupply(bz)
+	k.cache.add(symbol.String(), bz, ti)
 
 	return &ti
 }
 
+//decodeTokenInfoWithoutSupply decodes a stored TokenInfoWithoutSupply, upgrading it if it is a legacy one
+func (k *Keeper) decodeTokenInfoWithoutSupply(bz []byte) sdk.TokenInfoWithoutSupply {
+	var tsi sdk.TokenInfoWithoutSupply
+	k.cdc.MustUnmarshalBinaryBare(bz, &tsi)
+	upgradeLegacyTokenInfo(&tsi)
+	return tsi
+}
+
+//upgradeLegacyTokenInfo keeps a token issued before the max supply and the mintable and burnable flags
+//were introduced uncapped, mintable and burnable as it used to be. Such a token is recognized by its
+//missing max supply, which is always set once a token has been stored by this version.
+func upgradeLegacyTokenInfo(tsi *sdk.TokenInfoWithoutSupply) {
+	if !tsi.MaxSupply.IsNil() {
+		return
+	}
+	tsi.MaxSupply = sdk.ZeroInt()
+	tsi.Mintable = true
+	tsi.Burnable = true
+}
+
 //GetTokenInfo get a specified  tokeninfo, whose totalsupply is stored in supply module
 func (k *Keeper) GetTokenInfo(ctx sdk.Context, symbol sdk.Symbol) *sdk.TokenInfo {
 	store := ctx.KVStore(k.storeKey)
@@ -122,10 +333,7 @@ func (k *Keeper) GetTokenInfo(ctx sdk.Context, symbol sdk.Symbol) *sdk.TokenInfo
 	}
 
 	bz := store.Get(tokenStoreKey(symbol.String()))
-	var tsi sdk.TokenInfoWithoutSupply
-	k.cdc.MustUnmarshalBinaryBare(bz, &tsi)
-
-	ti := castToTokenInfo(tsi)
+	ti := castToTokenInfo(k.decodeTokenInfoWithoutSupply(bz))
 	ti.TotalSupply = k.sk.GetSupply(ctx).GetTotal().AmountOf(ti.Symbol.String())
 	return &ti
 }
@@ -137,10 +345,7 @@ func (k *Keeper) GetAllTokenInfo(ctx sdk.Context) []sdk.TokenInfo {
 	iter := sdk.KVStorePrefixIterator(store, TokenStoreKeyPrefix)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
-		var tsi sdk.TokenInfoWithoutSupply
-		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &tsi)
-
-		ti := castToTokenInfo(tsi)
+		ti := castToTokenInfo(k.decodeTokenInfoWithoutSupply(iter.Value()))
 		ti.TotalSupply = k.sk.GetSupply(ctx).GetTotal().AmountOf(ti.Symbol.String())
 		tokens = append(tokens, ti)
 	}
@@ -165,14 +370,14 @@ func (k *Keeper) IsTokenSupported(ctx sdk.Context, symbol sdk.Symbol) bool {
 	return false
 }
 
-//IsSendEnabled ...
+//IsSendEnabled returns whether the token can be sent, which requires both the send flag and a status allowing it
 func (k *Keeper) IsSendEnabled(ctx sdk.Context, symbol sdk.Symbol) bool {
 	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
 	if token == nil {
 		return false
 	}
 
-	return token.IsSendEnabled
+	return token.IsSendEnabled && token.Status.AllowsSend()
 }
 
 //GetDecimals ...
@@ -213,12 +418,273 @@ func (k *Keeper) DisableSend(ctx sdk.Context, symbol sdk.Symbol) {
 	k.SetTokenInfoWithoutSupply(ctx, token)
 }
 
+//GetPendingIssuer returns the address waiting to accept the token's ownership
+func (k *Keeper) GetPendingIssuer(ctx sdk.Context, symbol sdk.Symbol) string {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil {
+		return ""
+	}
+	return token.PendingIssuer
+}
+
+//TransferOwnership hands the token over to newIssuer immediately and clears any pending issuer
+func (k *Keeper) TransferOwnership(ctx sdk.Context, symbol sdk.Symbol, newIssuer sdk.AccAddress) {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil {
+		return
+	}
+	token.Issuer = newIssuer.String()
+	token.PendingIssuer = ""
+	k.SetTokenInfoWithoutSupply(ctx, token)
+}
+
+//SetPendingIssuer records pendingIssuer as the token's next issuer, who still has to accept it
+func (k *Keeper) SetPendingIssuer(ctx sdk.Context, symbol sdk.Symbol, pendingIssuer sdk.AccAddress) {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil {
+		return
+	}
+	token.PendingIssuer = pendingIssuer.String()
+	k.SetTokenInfoWithoutSupply(ctx, token)
+}
+
+//AcceptOwnership makes the pending issuer the token's issuer
+func (k *Keeper) AcceptOwnership(ctx sdk.Context, symbol sdk.Symbol) {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil || token.PendingIssuer == "" {
+		return
+	}
+	token.Issuer = token.PendingIssuer
+	token.PendingIssuer = ""
+	k.SetTokenInfoWithoutSupply(ctx, token)
+}
+
+//IsMintRenounced ...
+func (k *Keeper) IsMintRenounced(ctx sdk.Context, symbol sdk.Symbol) bool {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil {
+		return false
+	}
+	return token.MintRenounced
+}
+
+//RenounceMint disables inflation of the token permanently
+func (k *Keeper) RenounceMint(ctx sdk.Context, symbol sdk.Symbol) {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil {
+		return
+	}
+	token.MintRenounced = true
+	k.SetTokenInfoWithoutSupply(ctx, token)
+}
+
+//GetMaxSupply returns the token's supply cap, zero means no cap
+func (k *Keeper) GetMaxSupply(ctx sdk.Context, symbol sdk.Symbol) sdk.Int {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil || !token.IsCapped() {
+		return sdk.ZeroInt()
+	}
+	return token.MaxSupply
+}
+
+//IsMintable ...
+func (k *Keeper) IsMintable(ctx sdk.Context, symbol sdk.Symbol) bool {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil {
+		return false
+	}
+	return token.Mintable
+}
+
+//IsBurnable ...
+func (k *Keeper) IsBurnable(ctx sdk.Context, symbol sdk.Symbol) bool {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil {
+		return false
+	}
+	return token.Burnable
+}
+
+//IsFreezable ...
+func (k *Keeper) IsFreezable(ctx sdk.Context, symbol sdk.Symbol) bool {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil {
+		return false
+	}
+	return token.Freezable
+}
+
+//IsPausable ...
+func (k *Keeper) IsPausable(ctx sdk.Context, symbol sdk.Symbol) bool {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil {
+		return false
+	}
+	return token.Pausable
+}
+
+//IsPausedByIssuer checks whether the token is paused and the issuer, not governance, paused it
+func (k *Keeper) IsPausedByIssuer(ctx sdk.Context, symbol sdk.Symbol) bool {
+	if k.GetStatus(ctx, symbol) != sdk.TokenStatusPaused {
+		return false
+	}
+
+	transitions := k.GetStatusTransitions(ctx, symbol)
+	if len(transitions) == 0 {
+		return false
+	}
+	return transitions[len(transitions)-1].ByIssuer()
+}
+
+//GetStatus returns the token's lifecycle status
+func (k *Keeper) GetStatus(ctx sdk.Context, symbol sdk.Symbol) sdk.TokenStatus {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil {
+		return sdk.TokenStatusActive
+	}
+	return token.Status
+}
+
+//SetStatus moves the token to status and records the transition, proposalID is zero if no proposal triggered it
+func (k *Keeper) SetStatus(ctx sdk.Context, symbol sdk.Symbol, status sdk.TokenStatus, proposalID uint64, successor string) {
+	k.setStatus(ctx, symbol, status, proposalID, successor, nil)
+}
+
+//SetStatusByIssuer moves the token to status on behalf of its issuer and records the transition
+func (k *Keeper) SetStatusByIssuer(ctx sdk.Context, symbol sdk.Symbol, status sdk.TokenStatus, issuer sdk.AccAddress) {
+	k.setStatus(ctx, symbol, status, 0, "", issuer)
+}
+
+func (k *Keeper) setStatus(ctx sdk.Context, symbol sdk.Symbol, status sdk.TokenStatus, proposalID uint64, successor string, issuer sdk.AccAddress) {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil {
+		return
+	}
+
+	transition := types.NewTokenStatusTransition(symbol, token.Status, status, ctx.BlockHeight(), ctx.BlockHeader().Time, proposalID, successor)
+	transition.Issuer = issuer
+	token.Status = status
+	k.SetTokenInfoWithoutSupply(ctx, token)
+	k.SetStatusTransitions(ctx, symbol, append(k.GetStatusTransitions(ctx, symbol), transition))
+}
+
+//GetStatusTransitions returns the status transitions of the token, oldest first
+func (k *Keeper) GetStatusTransitions(ctx sdk.Context, symbol sdk.Symbol) []types.TokenStatusTransition {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(statusTransitionKey(symbol.String()))
+	if bz == nil {
+		return nil
+	}
+
+	var transitions []types.TokenStatusTransition
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &transitions)
+	return transitions
+}
+
+//SetStatusTransitions ...
+func (k *Keeper) SetStatusTransitions(ctx sdk.Context, symbol sdk.Symbol, transitions []types.TokenStatusTransition) {
+	store := ctx.KVStore(k.storeKey)
+	if len(transitions) == 0 {
+		store.Delete(statusTransitionKey(symbol.String()))
+		return
+	}
+	store.Set(statusTransitionKey(symbol.String()), k.cdc.MustMarshalBinaryLengthPrefixed(transitions))
+}
+
+//GetMetadata ...
+func (k *Keeper) GetMetadata(ctx sdk.Context, symbol sdk.Symbol) sdk.TokenMetadata {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil {
+		return sdk.TokenMetadata{}
+	}
+	return token.Metadata
+}
+
+//SetMetadata ...
+func (k *Keeper) SetMetadata(ctx sdk.Context, symbol sdk.Symbol, metadata sdk.TokenMetadata) {
+	token := k.GetTokenInfoWithoutSupply(ctx, symbol)
+	if token == nil {
+		return
+	}
+	token.Metadata = metadata
+	k.SetTokenInfoWithoutSupply(ctx, token)
+}
+
 //GetSymbolIterator ...
 func (k *Keeper) GetSymbolIterator(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
 	return sdk.KVStorePrefixIterator(store, TokenStoreKeyPrefix)
 }
 
+//IsAccountFrozen checks whether addr's balance of the token is frozen
+func (k *Keeper) IsAccountFrozen(ctx sdk.Context, symbol sdk.Symbol, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(frozenAccountKey(symbol.String(), addr))
+}
+
+//FreezeAccount ...
+func (k *Keeper) FreezeAccount(ctx sdk.Context, symbol sdk.Symbol, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(frozenAccountKey(symbol.String(), addr), []byte{})
+}
+
+//UnfreezeAccount ...
+func (k *Keeper) UnfreezeAccount(ctx sdk.Context, symbol sdk.Symbol, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(frozenAccountKey(symbol.String(), addr))
+}
+
+//GetFrozenAccounts returns all frozen accounts of the token
+func (k *Keeper) GetFrozenAccounts(ctx sdk.Context, symbol sdk.Symbol) []sdk.AccAddress {
+	var addrs []sdk.AccAddress
+	prefix := frozenAccountsPrefix(symbol.String())
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		addrs = append(addrs, sdk.AccAddress(bytes.TrimPrefix(iter.Key(), prefix)))
+	}
+	return addrs
+}
+
+//GetReservation returns the reservation of the symbol if it is reserved
+func (k *Keeper) GetReservation(ctx sdk.Context, symbol sdk.Symbol) (types.Reservation, bool) {
+	var reservation types.Reservation
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(reservationKey(symbol.String()))
+	if bz == nil {
+		return reservation, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &reservation)
+	return reservation, true
+}
+
+//SetReservation adds or updates the reservation of a symbol
+func (k *Keeper) SetReservation(ctx sdk.Context, reservation types.Reservation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(reservationKey(reservation.Symbol), k.cdc.MustMarshalBinaryLengthPrefixed(reservation))
+}
+
+//DeleteReservation ...
+func (k *Keeper) DeleteReservation(ctx sdk.Context, symbol sdk.Symbol) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(reservationKey(symbol.String()))
+}
+
+//GetReservations returns all reservations, sorted by symbol
+func (k *Keeper) GetReservations(ctx sdk.Context) []types.Reservation {
+	var reservations []types.Reservation
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, ReservationKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var reservation types.Reservation
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &reservation)
+		reservations = append(reservations, reservation)
+	}
+	return reservations
+}
+
 //GetSymbols ...
 func (k *Keeper) GetSymbols(ctx sdk.Context) []string {
 	var symbols []string
@@ -234,6 +700,12 @@ func (k *Keeper) GetSymbols(ctx sdk.Context) []string {
 // SetParams sets the token module's parameters.
 func (k *Keeper) SetParams(ctx sdk.Context, params Params) {
 	k.paramSubSpace.SetParamSet(ctx, &params)
+	k.cache.resize(params.TokenCacheSize)
+}
+
+// ResizeCache resizes the token cache when TokenCacheSize has been changed by a param change proposal
+func (k *Keeper) ResizeCache(ctx sdk.Context) {
+	k.cache.resize(k.GetParams(ctx).TokenCacheSize)
 }
 
 // GetParams gets the token module's parameters.
@@ -242,12 +714,105 @@ func (k *Keeper) GetParams(ctx sdk.Context) (params Params) {
 	return
 }
 
+// GetNewTokenFee returns the fee of issuing symbol according to the new token fee schedule
+func (k *Keeper) GetNewTokenFee(ctx sdk.Context, symbol sdk.Symbol) sdk.Int {
+	return k.GetParams(ctx).GetNewTokenFee(symbol.String())
+}
+
+// PayIssueFee charges the new token or inflate token fee to from and sends it to the
+// fee destination chosen by governance
+func (k *Keeper) PayIssueFee(ctx sdk.Context, from sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	switch dest := k.GetParams(ctx).FeeDestination; dest {
+	case types.FeeDestinationCommunityPool:
+		return k.dk.AddCoinsFromAccountToFeePool(ctx, from, fee)
+
+	case types.FeeDestinationBurn:
+		if err := k.sk.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, fee); err != nil {
+			return err
+		}
+		return k.sk.BurnCoins(ctx, types.ModuleName, fee)
+
+	case types.FeeDestinationFeeCollector:
+		return k.sk.SendCoinsFromAccountToModule(ctx, from, auth.FeeCollectorName, fee)
+
+	default:
+		return types.ErrInvalidParameter(string(types.KeyFeeDestination), dest)
+	}
+}
+
+// GetFeeTokens returns the issued tokens accepted as transaction fee
+func (k *Keeper) GetFeeTokens(ctx sdk.Context) []types.FeeToken {
+	return k.GetParams(ctx).FeeTokens
+}
+
+// GetFeeToken returns the fee token of symbol if it is accepted as transaction fee
+func (k *Keeper) GetFeeToken(ctx sdk.Context, symbol string) (types.FeeToken, bool) {
+	for _, ft := range k.GetFeeTokens(ctx) {
+		if ft.Symbol == symbol {
+			return ft, true
+		}
+	}
+	return types.FeeToken{}, false
+}
+
+// SetFeeToken adds the fee token to the whitelist or updates its rate, a zero rate removes it
+func (k *Keeper) SetFeeToken(ctx sdk.Context, feeToken types.FeeToken) {
+	params := k.GetParams(ctx)
+
+	feeTokens := make([]types.FeeToken, 0, len(params.FeeTokens)+1)
+	for _, ft := range params.FeeTokens {
+		if ft.Symbol != feeToken.Symbol {
+			feeTokens = append(feeTokens, ft)
+		}
+	}
+	if feeToken.Rate.IsPositive() {
+		feeTokens = append(feeTokens, feeToken)
+	}
+	sort.Slice(feeTokens, func(i, j int) bool { return feeTokens[i].Symbol < feeTokens[j].Symbol })
+
+	params.FeeTokens = feeTokens
+	k.paramSubSpace.SetParamSet(ctx, &params)
+}
+
+// IsFeeToken returns whether denom is accepted as transaction fee, the native token always is.
+// A whitelisted token is only accepted while its status allows fee payment.
+func (k *Keeper) IsFeeToken(ctx sdk.Context, denom string) bool {
+	_, ok := k.GetFeeTokenRate(ctx, denom)
+	return ok
+}
+
+// GetFeeTokenRate returns how many native tokens one unit of denom is worth as transaction fee
+// and whether denom is accepted as fee, the rate of the native token is one.
+func (k *Keeper) GetFeeTokenRate(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	if denom == sdk.NativeToken {
+		return sdk.OneDec(), true
+	}
+	ft, found := k.GetFeeToken(ctx, denom)
+	if !found || !k.GetStatus(ctx, sdk.Symbol(denom)).AllowsFeePayment() {
+		return sdk.Dec{}, false
+	}
+	return ft.Rate, true
+}
+
 func castToTokenInfo(tsi sdk.TokenInfoWithoutSupply) sdk.TokenInfo {
 	return sdk.TokenInfo{
 		Symbol:        tsi.Symbol,
 		Issuer:        tsi.Issuer,
 		IsSendEnabled: tsi.IsSendEnabled,
 		Decimals:      tsi.Decimals,
+		PendingIssuer: tsi.PendingIssuer,
+		MintRenounced: tsi.MintRenounced,
+		MaxSupply:     tsi.MaxSupply,
+		Mintable:      tsi.Mintable,
+		Burnable:      tsi.Burnable,
+		Freezable:     tsi.Freezable,
+		Pausable:      tsi.Pausable,
+		Status:        tsi.Status,
+		Metadata:      tsi.Metadata,
 	}
 }
 
@@ -257,6 +822,15 @@ func castToTokenInfoWithoutSupply(tsi sdk.TokenInfo) sdk.TokenInfoWithoutSupply
 		Issuer:        tsi.Issuer,
 		IsSendEnabled: tsi.IsSendEnabled,
 		Decimals:      tsi.Decimals,
+		PendingIssuer: tsi.PendingIssuer,
+		MintRenounced: tsi.MintRenounced,
+		MaxSupply:     tsi.MaxSupply,
+		Mintable:      tsi.Mintable,
+		Burnable:      tsi.Burnable,
+		Freezable:     tsi.Freezable,
+		Pausable:      tsi.Pausable,
+		Status:        tsi.Status,
+		Metadata:      tsi.Metadata,
 	}
 }
 
@@ -270,7 +844,17 @@ func (k *Keeper) SetTokenInfo(ctx sdk.Context, tokenInfo *sdk.TokenInfo) {
 		Issuer:        tokenInfo.Issuer,
 		Decimals:      tokenInfo.Decimals,
 		IsSendEnabled: tokenInfo.IsSendEnabled,
+		PendingIssuer: tokenInfo.PendingIssuer,
+		MintRenounced: tokenInfo.MintRenounced,
+		MaxSupply:     tokenInfo.MaxSupply,
+		Mintable:      tokenInfo.Mintable,
+		Burnable:      tokenInfo.Burnable,
+		Freezable:     tokenInfo.Freezable,
+		Pausable:      tokenInfo.Pausable,
+		Status:        tokenInfo.Status,
+		Metadata:      tokenInfo.Metadata,
 	}
+	k.updateIssuerIndex(ctx, tokenInfo.Symbol.String(), tokenInfo.Issuer)
 	store.Set(tokenStoreKey(tokenInfo.Symbol.String()), k.cdc.MustMarshalBinaryBare(tsi))
 
 	//update supply
@@ -285,6 +869,7 @@ func (k *Keeper) SetTokenInfo(ctx sdk.Context, tokenInfo *sdk.TokenInfo) {
 
 //DeleteTokenInfo delete TokenInfo and total supply
 func (k *Keeper) DeleteTokenInfo(ctx sdk.Context, symbol string) {
+	k.updateIssuerIndex(ctx, symbol, "")
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(tokenStoreKey(symbol))
 
@@ -294,3 +879,12 @@ func (k *Keeper) DeleteTokenInfo(ctx sdk.Context, symbol string) {
 	newCoins := oldCoins.Sub(oldAmt)
 	k.sk.SetSupply(ctx, supply.NewSupply(newCoins))
 }
+
+//GetCoins returns the balance of addr, which is recorded in the receipts of token msgs
+func (k *Keeper) GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	acc := k.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdk.NewCoins()
+	}
+	return acc.GetCoins()
+}
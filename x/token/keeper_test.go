@@ -12,8 +12,10 @@
 package token
 
 import (
+	"github.com/pocblockchain/pocc/codec"
 	sdk "github.com/pocblockchain/pocc/types"
 	"github.com/pocblockchain/pocc/x/supply"
+	"github.com/pocblockchain/pocc/x/token/types"
 	"github.com/stretchr/testify/assert"
 
 	"testing"
@@ -124,3 +126,185 @@ func TestTokenInfoEncoding(t *testing.T) {
 		assert.Equal(t, tsi, got)
 	}
 }
+
+func TestFrozenAccounts(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	keeper.FreezeAccount(ctx, "bh", addr1)
+	keeper.FreezeAccount(ctx, "bhd", addr2)
+
+	//a symbol does not match the frozen accounts of a longer one
+	assert.Equal(t, []sdk.AccAddress{addr1}, keeper.GetFrozenAccounts(ctx, "bh"))
+	assert.Equal(t, []sdk.AccAddress{addr2}, keeper.GetFrozenAccounts(ctx, "bhd"))
+	assert.False(t, keeper.IsAccountFrozen(ctx, "bh", addr2))
+	assert.False(t, keeper.IsAccountFrozen(ctx, "bhd", addr1))
+
+	keeper.UnfreezeAccount(ctx, "bh", addr1)
+	assert.False(t, keeper.IsAccountFrozen(ctx, "bh", addr1))
+	assert.True(t, keeper.IsAccountFrozen(ctx, "bhd", addr2))
+}
+
+func TestFeeTokens(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	assert.True(t, keeper.IsFeeToken(ctx, sdk.NativeToken))
+	assert.False(t, keeper.IsFeeToken(ctx, BtcToken))
+	assert.Equal(t, 0, len(keeper.GetFeeTokens(ctx)))
+
+	keeper.SetFeeToken(ctx, types.NewFeeToken(EthToken, sdk.NewDecWithPrec(5, 1)))
+	keeper.SetFeeToken(ctx, types.NewFeeToken(BtcToken, sdk.NewDec(2)))
+	assert.True(t, keeper.IsFeeToken(ctx, BtcToken))
+	assert.True(t, keeper.IsFeeToken(ctx, EthToken))
+
+	//fee tokens are kept sorted by symbol
+	assert.Equal(t, []types.FeeToken{
+		types.NewFeeToken(BtcToken, sdk.NewDec(2)),
+		types.NewFeeToken(EthToken, sdk.NewDecWithPrec(5, 1)),
+	}, keeper.GetFeeTokens(ctx))
+
+	//update rate
+	keeper.SetFeeToken(ctx, types.NewFeeToken(BtcToken, sdk.NewDec(3)))
+	ft, found := keeper.GetFeeToken(ctx, BtcToken)
+	assert.True(t, found)
+	assert.Equal(t, sdk.NewDec(3), ft.Rate)
+	assert.Equal(t, 2, len(keeper.GetFeeTokens(ctx)))
+
+	rate, ok := keeper.GetFeeTokenRate(ctx, BtcToken)
+	assert.True(t, ok)
+	assert.Equal(t, sdk.NewDec(3), rate)
+	rate, ok = keeper.GetFeeTokenRate(ctx, sdk.NativeToken)
+	assert.True(t, ok)
+	assert.Equal(t, sdk.OneDec(), rate)
+
+	//a zero rate removes the fee token
+	keeper.SetFeeToken(ctx, types.NewFeeToken(BtcToken, sdk.ZeroDec()))
+	assert.False(t, keeper.IsFeeToken(ctx, BtcToken))
+	_, ok = keeper.GetFeeTokenRate(ctx, BtcToken)
+	assert.False(t, ok)
+	assert.Equal(t, []types.FeeToken{types.NewFeeToken(EthToken, sdk.NewDecWithPrec(5, 1))}, keeper.GetFeeTokens(ctx))
+}
+
+// legacyTokenInfoWithoutSupply is the layout of a token stored before the max supply and
+// the mintable and burnable flags were introduced
+type legacyTokenInfoWithoutSupply struct {
+	Symbol        sdk.Symbol `json:"symbol"`
+	Issuer        string     `json:"issuer"`
+	IsSendEnabled bool       `json:"is_send_enabled"`
+	Decimals      uint64     `json:"decimals"`
+	PendingIssuer string     `json:"pending_issuer"`
+	MintRenounced bool       `json:"mint_renounced"`
+}
+
+func TestLegacyTokenInfo(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	legacy := legacyTokenInfoWithoutSupply{
+		Symbol:        "btc",
+		Issuer:        "poc1kkkjfhv7t4swrurnftedsx0lngvc523cfa00s3",
+		IsSendEnabled: true,
+		Decimals:      8,
+	}
+	legacyCdc := codec.New()
+	legacyCdc.RegisterConcrete(legacyTokenInfoWithoutSupply{}, "poc/token/TokenInfoWithoutSupply", nil)
+	ctx.KVStore(keeper.storeKey).Set(tokenStoreKey("btc"), legacyCdc.MustMarshalBinaryBare(legacy))
+
+	//a legacy token stays uncapped, mintable and burnable
+	tsi := keeper.GetTokenInfoWithoutSupply(ctx, "btc")
+	assert.NotNil(t, tsi)
+	assert.Equal(t, legacy.Issuer, tsi.Issuer)
+	assert.Equal(t, uint64(8), tsi.Decimals)
+	assert.True(t, tsi.MaxSupply.IsZero())
+	assert.True(t, tsi.Mintable)
+	assert.True(t, tsi.Burnable)
+	assert.False(t, tsi.Freezable)
+	assert.True(t, keeper.IsMintable(ctx, "btc"))
+	assert.True(t, keeper.IsBurnable(ctx, "btc"))
+	assert.True(t, keeper.GetTokenInfo(ctx, "btc").Mintable)
+	assert.True(t, keeper.GetAllTokenInfoWithoutSupply(ctx)[0].Mintable)
+
+	//the upgraded flags are kept once the token is stored again
+	keeper.RenounceMint(ctx, "btc")
+	tsi = keeper.GetTokenInfoWithoutSupply(ctx, "btc")
+	assert.True(t, tsi.MintRenounced)
+	assert.True(t, tsi.Mintable)
+	assert.True(t, tsi.Burnable)
+
+	//a token which is not mintable is not taken for a legacy one
+	keeper.SetTokenInfoWithoutSupply(ctx, &sdk.TokenInfoWithoutSupply{
+		Symbol:        "eth",
+		Issuer:        legacy.Issuer,
+		IsSendEnabled: true,
+		Decimals:      18,
+		MaxSupply:     sdk.ZeroInt(),
+	})
+	assert.False(t, keeper.IsMintable(ctx, "eth"))
+	assert.False(t, keeper.IsBurnable(ctx, "eth"))
+}
+
+func TestIssuerIndex(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	addr1 := sdk.AccAddress([]byte("addr1_______________"))
+	addr2 := sdk.AccAddress([]byte("addr2_______________"))
+
+	for _, symbol := range []string{"bhd", "bha"} {
+		keeper.SetTokenInfo(ctx, &sdk.TokenInfo{Symbol: sdk.Symbol(symbol), Issuer: addr1.String(), Decimals: 8, TotalSupply: sdk.NewInt(100), MaxSupply: sdk.ZeroInt()})
+	}
+	keeper.SetTokenInfo(ctx, &sdk.TokenInfo{Symbol: "bhe", Decimals: 8, TotalSupply: sdk.NewInt(100), MaxSupply: sdk.ZeroInt()})
+	assert.Equal(t, []string{"bha", "bhd"}, keeper.GetSymbolsByIssuer(ctx, addr1.String()))
+	assert.Nil(t, keeper.GetSymbolsByIssuer(ctx, addr2.String()))
+
+	//the index follows ownership
+	keeper.TransferOwnership(ctx, "bhd", addr2)
+	assert.Equal(t, []string{"bha"}, keeper.GetSymbolsByIssuer(ctx, addr1.String()))
+	assert.Equal(t, []string{"bhd"}, keeper.GetSymbolsByIssuer(ctx, addr2.String()))
+
+	keeper.DeleteTokenInfoWithoutSupply(ctx, "bha")
+	assert.Nil(t, keeper.GetSymbolsByIssuer(ctx, addr1.String()))
+
+	assert.Equal(t, []string{"bhd", "bhe"}, keeper.GetSymbolsByPrefix(ctx, "bh"))
+	assert.Nil(t, keeper.GetSymbolsByPrefix(ctx, "eth"))
+}
+
+func TestIterateSymbols(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	issuer := sdk.AccAddress([]byte("issuer______________"))
+	for _, symbol := range []string{"bha", "bhb", "bhc", "cat"} {
+		keeper.SetTokenInfo(ctx, &sdk.TokenInfo{Symbol: sdk.Symbol(symbol), Issuer: issuer.String(), Decimals: 8, TotalSupply: sdk.NewInt(100), MaxSupply: sdk.ZeroInt()})
+	}
+	keeper.SetTokenInfo(ctx, &sdk.TokenInfo{Symbol: "bhd", Decimals: 8, TotalSupply: sdk.NewInt(100), MaxSupply: sdk.ZeroInt()})
+
+	collect := func(issuer, prefix, after string, max int) []string {
+		var symbols []string
+		keeper.IterateSymbols(ctx, issuer, prefix, after, func(symbol string) bool {
+			symbols = append(symbols, symbol)
+			return len(symbols) == max
+		})
+		return symbols
+	}
+
+	assert.Equal(t, []string{"bha", "bhb", "bhc", "bhd"}, collect("", "bh", "", 0))
+	assert.Equal(t, []string{"bhc", "bhd"}, collect("", "bh", "bhb", 0))
+	assert.Equal(t, []string{"bha", "bhb", "bhc", "cat"}, collect(issuer.String(), "", "", 0))
+	assert.Equal(t, []string{"bhc", "cat"}, collect(issuer.String(), "", "bhb", 0))
+	assert.Equal(t, []string{"bha", "bhb", "bhc"}, collect(issuer.String(), "bh", "b", 0))
+	assert.Nil(t, collect("", "bh", "bhd", 0))
+	assert.Nil(t, collect("", "bh", "c", 0))
+
+	//the iteration stops as soon as the callback returns true
+	assert.Equal(t, []string{"bhb", "bhc"}, collect("", "", "bha", 2))
+}
@@ -11,6 +11,8 @@
 package token
 
 import (
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/params"
 	"github.com/pocblockchain/pocc/x/token/types"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -47,14 +49,71 @@ func TestGetSetParams(t *testing.T) {
 	assert.True(t, param2.Equal(param2Get))
 	assert.NotEqual(t, param1, param2Get)
 
-	//append a new symbol
+	//append a new symbol length fee
 	param3 := param2
-	param3.ReservedSymbols = append(param3.ReservedSymbols, "mytoken")
+	param3.NewTokenFeeSchedule = append(param3.NewTokenFeeSchedule, types.NewSymbolLengthFee(3, sdk.NewInt(100)))
 	keeper.SetParams(ctx, param3)
 	param3Get := keeper.GetParams(ctx)
 	assert.Equal(t, param3, param3Get)
 	assert.True(t, param3.Equal(param3Get))
 	assert.NotEqual(t, param2, param3Get)
-	t.Logf("%v", param3.ReservedSymbols)
+	t.Logf("%v", param3.NewTokenFeeSchedule)
 
 }
+
+func TestParamChangeProposalValidation(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+	handler := params.NewParamChangeProposalHandler(input.paramsKeeper)
+
+	keeper.SetParams(ctx, types.DefaultParams())
+	changeParam := func(key, value string) sdk.Result {
+		proposal := params.NewParameterChangeProposal("Test", "description",
+			[]params.ParamChange{params.NewParamChange(DefaultParamspace, key, value)})
+		return handler(ctx, proposal)
+	}
+
+	//a misspelled fee destination is rejected
+	assert.False(t, changeParam(string(types.KeyFeeDestination), `"burnn"`).IsOK())
+	//a negative inflate fee is rejected
+	assert.False(t, changeParam(string(types.KeyInflateTokenFee), `"-1"`).IsOK())
+	assert.Equal(t, types.DefaultParams(), keeper.GetParams(ctx))
+
+	//a valid change is applied
+	assert.True(t, changeParam(string(types.KeyFeeDestination), `"burn"`).IsOK())
+	assert.Equal(t, types.FeeDestinationBurn, keeper.GetParams(ctx).FeeDestination)
+}
+
+func TestFeeTokensParamChangeProposal(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+	handler := params.NewParamChangeProposalHandler(input.paramsKeeper)
+
+	keeper.SetParams(ctx, types.DefaultParams())
+	keeper.SetFeeToken(ctx, types.NewFeeToken(BtcToken, sdk.NewDec(2)))
+	changeFeeTokens := func(feeTokens []types.FeeToken) sdk.Result {
+		value := string(input.cdc.MustMarshalJSON(feeTokens))
+		proposal := params.NewParameterChangeProposal("Test", "description",
+			[]params.ParamChange{params.NewParamChange(DefaultParamspace, string(types.KeyFeeTokens), value)})
+		return handler(ctx, proposal)
+	}
+
+	//zero and negative rates are rejected
+	assert.False(t, changeFeeTokens([]types.FeeToken{types.NewFeeToken(EthToken, sdk.ZeroDec())}).IsOK())
+	assert.False(t, changeFeeTokens([]types.FeeToken{types.NewFeeToken(EthToken, sdk.NewDec(-1))}).IsOK())
+	//duplicate fee tokens are rejected
+	assert.False(t, changeFeeTokens([]types.FeeToken{
+		types.NewFeeToken(EthToken, sdk.NewDec(1)),
+		types.NewFeeToken(EthToken, sdk.NewDec(2)),
+	}).IsOK())
+	//the native token can not be whitelisted
+	assert.False(t, changeFeeTokens([]types.FeeToken{types.NewFeeToken(sdk.NativeToken, sdk.NewDec(1))}).IsOK())
+	assert.Equal(t, []types.FeeToken{types.NewFeeToken(BtcToken, sdk.NewDec(2))}, keeper.GetFeeTokens(ctx))
+
+	//a valid whitelist is applied
+	feeTokens := []types.FeeToken{types.NewFeeToken(BtcToken, sdk.NewDec(3)), types.NewFeeToken(EthToken, sdk.NewDec(1))}
+	assert.True(t, changeFeeTokens(feeTokens).IsOK())
+	assert.Equal(t, feeTokens, keeper.GetFeeTokens(ctx))
+}
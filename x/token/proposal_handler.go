@@ -18,6 +18,49 @@ func processChangeParam(key, value string, ti *sdk.TokenInfoWithoutSupply, cdc *
 		}
 		ti.IsSendEnabled = val
 
+	case sdk.KeyMaxSupply:
+		val := sdk.ZeroInt()
+		err := cdc.UnmarshalJSON([]byte(value), &val)
+		if err != nil {
+			return err
+		}
+		if val.IsNegative() {
+			return fmt.Errorf("max supply %v is negative", val)
+		}
+		ti.MaxSupply = val
+
+	case sdk.KeyMintable:
+		val := false
+		err := cdc.UnmarshalJSON([]byte(value), &val)
+		if err != nil {
+			return err
+		}
+		ti.Mintable = val
+
+	case sdk.KeyBurnable:
+		val := false
+		err := cdc.UnmarshalJSON([]byte(value), &val)
+		if err != nil {
+			return err
+		}
+		ti.Burnable = val
+
+	case sdk.KeyFreezable:
+		val := false
+		err := cdc.UnmarshalJSON([]byte(value), &val)
+		if err != nil {
+			return err
+		}
+		ti.Freezable = val
+
+	case sdk.KeyPausable:
+		val := false
+		err := cdc.UnmarshalJSON([]byte(value), &val)
+		if err != nil {
+			return err
+		}
+		ti.Pausable = val
+
 	default:
 		return fmt.Errorf("Unkonwn parameter:%v", key)
 	}
@@ -46,6 +89,11 @@ func handleTokenParamsChangeProposal(ctx sdk.Context, keeper Keeper, proposal ty
 		attr = append(attr, sdk.NewAttribute(types.AttributeKeyTokenParam, pc.Key), sdk.NewAttribute(types.AttributeKeyTokenParamValue, pc.Value))
 	}
 
+	totalSupply := keeper.GetTotalSupply(ctx, ti.Symbol)
+	if ti.IsCapped() && totalSupply.GT(ti.MaxSupply) {
+		return types.ErrExceedMaxSupply(fmt.Sprintf("%v's supply %v exceeds max supply %v", ti.Symbol, totalSupply, ti.MaxSupply)).Result()
+	}
+
 	keeper.SetTokenInfoWithoutSupply(ctx, ti)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.EventTypeExecuteTokenParamsChangeProposal, attr...),
@@ -53,6 +101,18 @@ func handleTokenParamsChangeProposal(ctx sdk.Context, keeper Keeper, proposal ty
 	return sdk.Result{}
 }
 
+// changeStatus moves the token to the given status on behalf of the proposal being executed,
+// a pause by governance takes over a pause by the issuer so that the issuer can not lift it
+func changeStatus(ctx sdk.Context, keeper Keeper, ti *sdk.TokenInfoWithoutSupply, status sdk.TokenStatus, successor string) sdk.Error {
+	if ti.Status == status && !(status == sdk.TokenStatusPaused && keeper.IsPausedByIssuer(ctx, ti.Symbol)) {
+		return types.ErrInvalidStatusTransition(ti.Symbol.String(), ti.Status, status)
+	}
+
+	proposalID, _ := govtypes.ProposalIDFromContext(ctx)
+	keeper.SetStatus(ctx, ti.Symbol, status, proposalID, successor)
+	return nil
+}
+
 func handleDisableTokenProposal(ctx sdk.Context, keeper Keeper, proposal types.DisableTokenProposal) sdk.Result {
 	ctx.Logger().Info("handleDisableTokenProposal", "proposal", proposal)
 
@@ -65,12 +125,155 @@ func handleDisableTokenProposal(ctx sdk.Context, keeper Keeper, proposal types.D
 		return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", proposal.Symbol)).Result()
 	}
 
-	ti.IsSendEnabled = false
-	keeper.SetTokenInfoWithoutSupply(ctx, ti)
+	if err := changeStatus(ctx, keeper, ti, sdk.TokenStatusPaused, ""); err != nil {
+		return err.Result()
+	}
+	keeper.DisableSend(ctx, ti.Symbol)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeExecuteDisableTokenProposal,
 			sdk.NewAttribute(types.AttributeKeyToken, proposal.Symbol),
+			sdk.NewAttribute(types.AttributeKeyStatus, sdk.TokenStatusPaused.String()),
+		),
+	)
+	return sdk.Result{}
+}
+
+func handleEnableTokenProposal(ctx sdk.Context, keeper Keeper, proposal types.EnableTokenProposal) sdk.Result {
+	ctx.Logger().Info("handleEnableTokenProposal", "proposal", proposal)
+
+	if proposal.Symbol == sdk.NativeToken {
+		return sdk.ErrInvalidTx("Not allowed to enable native token").Result()
+	}
+
+	ti := keeper.GetTokenInfoWithoutSupply(ctx, sdk.Symbol(proposal.Symbol))
+	if ti == nil {
+		return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", proposal.Symbol)).Result()
+	}
+
+	if err := changeStatus(ctx, keeper, ti, sdk.TokenStatusActive, ""); err != nil {
+		return err.Result()
+	}
+	keeper.EnableSend(ctx, ti.Symbol)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteEnableTokenProposal,
+			sdk.NewAttribute(types.AttributeKeyToken, proposal.Symbol),
+			sdk.NewAttribute(types.AttributeKeyStatus, sdk.TokenStatusActive.String()),
+		),
+	)
+	return sdk.Result{}
+}
+
+func handleDelistTokenProposal(ctx sdk.Context, keeper Keeper, proposal types.DelistTokenProposal) sdk.Result {
+	ctx.Logger().Info("handleDelistTokenProposal", "proposal", proposal)
+
+	if proposal.Symbol == sdk.NativeToken {
+		return sdk.ErrInvalidTx("Not allowed to delist native token").Result()
+	}
+
+	ti := keeper.GetTokenInfoWithoutSupply(ctx, sdk.Symbol(proposal.Symbol))
+	if ti == nil {
+		return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", proposal.Symbol)).Result()
+	}
+
+	//holders can only migrate to an existing active token
+	if proposal.Successor != "" {
+		successor := sdk.Symbol(proposal.Successor)
+		if !keeper.IsTokenSupported(ctx, successor) {
+			return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", proposal.Successor)).Result()
+		}
+		if status := keeper.GetStatus(ctx, successor); status != sdk.TokenStatusActive {
+			return types.ErrStatusNotAllowed(proposal.Successor, status, "be migrated to").Result()
+		}
+	}
+
+	status := proposal.TargetStatus()
+	if err := changeStatus(ctx, keeper, ti, status, proposal.Successor); err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteDelistTokenProposal,
+			sdk.NewAttribute(types.AttributeKeyToken, proposal.Symbol),
+			sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+			sdk.NewAttribute(types.AttributeKeySuccessor, proposal.Successor),
+		),
+	)
+	return sdk.Result{}
+}
+
+func handleFeeTokenProposal(ctx sdk.Context, keeper Keeper, proposal types.FeeTokenProposal) sdk.Result {
+	ctx.Logger().Info("handleFeeTokenProposal", "proposal", proposal)
+
+	if proposal.Symbol == sdk.NativeToken {
+		return sdk.ErrInvalidTx("Not allowed to change native token's fee rate").Result()
+	}
+
+	if proposal.Rate.IsZero() {
+		if _, found := keeper.GetFeeToken(ctx, proposal.Symbol); !found {
+			return types.ErrNotFeeToken(proposal.Symbol).Result()
+		}
+	} else if keeper.GetTokenInfoWithoutSupply(ctx, sdk.Symbol(proposal.Symbol)) == nil {
+		return sdk.ErrUnSupportToken(fmt.Sprintf("token %s does not exist", proposal.Symbol)).Result()
+	}
+
+	keeper.SetFeeToken(ctx, types.NewFeeToken(proposal.Symbol, proposal.Rate))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteFeeTokenProposal,
+			sdk.NewAttribute(types.AttributeKeyToken, proposal.Symbol),
+			sdk.NewAttribute(types.AttributeKeyFeeRate, proposal.Rate.String()),
+		),
+	)
+	return sdk.Result{}
+}
+
+func handleReserveSymbolProposal(ctx sdk.Context, keeper Keeper, proposal types.ReserveSymbolProposal) sdk.Result {
+	ctx.Logger().Info("handleReserveSymbolProposal", "proposal", proposal)
+
+	if proposal.Symbol == sdk.NativeToken {
+		return sdk.ErrInvalidTx("Not allowed to reserve native token").Result()
+	}
+
+	if keeper.GetTokenInfoWithoutSupply(ctx, sdk.Symbol(proposal.Symbol)) != nil {
+		return sdk.ErrAlreadyExitSymbol(fmt.Sprintf("token %s already exist", proposal.Symbol)).Result()
+	}
+
+	//a reservation which expires before the proposal passes is meaningless
+	reservation := proposal.Reservation()
+	if reservation.IsExpired(ctx.BlockHeader().Time) {
+		return sdk.ErrInvalidTx(fmt.Sprintf("reservation of %s expires at %v", proposal.Symbol, proposal.ExpireTime)).Result()
+	}
+
+	keeper.SetReservation(ctx, reservation)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteReserveSymbolProposal,
+			sdk.NewAttribute(types.AttributeKeyToken, proposal.Symbol),
+			sdk.NewAttribute(types.AttributeKeyClaimant, proposal.Claimant.String()),
+			sdk.NewAttribute(types.AttributeKeyExpireTime, proposal.ExpireTime.String()),
+		),
+	)
+	return sdk.Result{}
+}
+
+func handleUnreserveSymbolProposal(ctx sdk.Context, keeper Keeper, proposal types.UnreserveSymbolProposal) sdk.Result {
+	ctx.Logger().Info("handleUnreserveSymbolProposal", "proposal", proposal)
+
+	symbol := sdk.Symbol(proposal.Symbol)
+	if _, found := keeper.GetReservation(ctx, symbol); !found {
+		return types.ErrSymbolNotReserved(proposal.Symbol).Result()
+	}
+
+	keeper.DeleteReservation(ctx, symbol)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExecuteUnreserveSymbolProposal,
+			sdk.NewAttribute(types.AttributeKeyToken, proposal.Symbol),
 		),
 	)
 	return sdk.Result{}
@@ -86,6 +289,21 @@ func NewTokenProposalHandler(k Keeper) govtypes.Handler {
 		case types.DisableTokenProposal:
 			return handleDisableTokenProposal(ctx, k, c)
 
+		case types.FeeTokenProposal:
+			return handleFeeTokenProposal(ctx, k, c)
+
+		case types.EnableTokenProposal:
+			return handleEnableTokenProposal(ctx, k, c)
+
+		case types.DelistTokenProposal:
+			return handleDelistTokenProposal(ctx, k, c)
+
+		case types.ReserveSymbolProposal:
+			return handleReserveSymbolProposal(ctx, k, c)
+
+		case types.UnreserveSymbolProposal:
+			return handleUnreserveSymbolProposal(ctx, k, c)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized token proposal content type: %T", c)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -2,9 +2,11 @@ package token
 
 import (
 	sdk "github.com/pocblockchain/pocc/types"
+	govtypes "github.com/pocblockchain/pocc/x/gov/types"
 	"github.com/pocblockchain/pocc/x/token/types"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func changeProposal(symbol string, changes []types.ParamChange) types.TokenParamsChangeProposal {
@@ -68,6 +70,7 @@ func TestTokenParamsChangeProposalFailed(t *testing.T) {
 		Issuer:        "btc",
 		IsSendEnabled: true,
 		Decimals:      8,
+		MaxSupply:     sdk.ZeroInt(),
 	}
 	keeper.SetTokenInfoWithoutSupply(ctx, &btcTi)
 
@@ -75,6 +78,7 @@ func TestTokenParamsChangeProposalFailed(t *testing.T) {
 		Symbol:        sdk.NativeToken,
 		IsSendEnabled: true,
 		Decimals:      8,
+		MaxSupply:     sdk.ZeroInt(),
 	}
 
 	keeper.SetTokenInfoWithoutSupply(ctx, &nativeTi)
@@ -115,6 +119,57 @@ func TestTokenParamsChangeProposalFailed(t *testing.T) {
 
 }
 
+func TestTokenParamsChangeProposalSupplyFlags(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	keeper.SetTokenInfo(ctx, &sdk.TokenInfo{
+		Symbol:        "btc",
+		Issuer:        "",
+		Decimals:      18,
+		TotalSupply:   sdk.NewInt(1000),
+		IsSendEnabled: true,
+	})
+
+	hdlr := NewTokenProposalHandler(keeper)
+	changes := []types.ParamChange{
+		types.NewParamChange(sdk.KeyMaxSupply, `"2000"`),
+		types.NewParamChange(sdk.KeyMintable, "true"),
+		types.NewParamChange(sdk.KeyBurnable, "true"),
+	}
+	res := hdlr(ctx, changeProposal("btc", changes))
+	require.Equal(t, sdk.CodeOK, res.Code)
+
+	ti := keeper.GetTokenInfo(ctx, "btc")
+	require.Equal(t, sdk.NewInt(2000), ti.MaxSupply)
+	require.True(t, ti.Mintable)
+	require.True(t, ti.Burnable)
+
+	//max supply below total supply
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	changes = []types.ParamChange{types.NewParamChange(sdk.KeyMaxSupply, `"999"`)}
+	res = hdlr(ctx, changeProposal("btc", changes))
+	require.Equal(t, types.CodeExceedMaxSupply, res.Code)
+	require.Equal(t, sdk.NewInt(2000), keeper.GetMaxSupply(ctx, "btc"))
+
+	//negative max supply
+	changes = []types.ParamChange{types.NewParamChange(sdk.KeyMaxSupply, `"-1"`)}
+	res = hdlr(ctx, changeProposal("btc", changes))
+	require.Equal(t, types.CodeInvalidInput, res.Code)
+
+	//remove the cap and disable mint
+	changes = []types.ParamChange{
+		types.NewParamChange(sdk.KeyMaxSupply, `"0"`),
+		types.NewParamChange(sdk.KeyMintable, "false"),
+	}
+	res = hdlr(ctx, changeProposal("btc", changes))
+	require.Equal(t, sdk.CodeOK, res.Code)
+	require.Equal(t, sdk.ZeroInt(), keeper.GetMaxSupply(ctx, "btc"))
+	require.False(t, keeper.IsMintable(ctx, "btc"))
+	require.True(t, keeper.IsBurnable(ctx, "btc"))
+}
+
 func TestDisableTokenProposalPassed(t *testing.T) {
 	input := setupTestEnv(t)
 	ctx := input.ctx
@@ -184,3 +239,223 @@ func TestDisableTokenProposalFailed(t *testing.T) {
 	require.Equal(t, 0, len(events))
 	require.Contains(t, res.Log, "Not allowed to disable native token")
 }
+
+func feeTokenProposal(symbol string, rate sdk.Dec) types.FeeTokenProposal {
+	return types.FeeTokenProposal{
+		Title:       "Test",
+		Description: "description",
+		Symbol:      symbol,
+		Rate:        rate,
+	}
+}
+
+func TestFeeTokenProposalPassed(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	for _, ti := range TestTokenData {
+		keeper.SetTokenInfo(ctx, &ti)
+	}
+
+	hdlr := NewTokenProposalHandler(keeper)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res := hdlr(ctx, feeTokenProposal(BtcToken, sdk.NewDec(2)))
+	require.Equal(t, sdk.CodeOK, res.Code)
+	events := ctx.EventManager().Events()
+	require.Equal(t, 1, len(events))
+	require.Equal(t, types.EventTypeExecuteFeeTokenProposal, events[0].Type)
+	require.True(t, keeper.IsFeeToken(ctx, BtcToken))
+
+	//remove it with a zero rate
+	res = hdlr(ctx, feeTokenProposal(BtcToken, sdk.ZeroDec()))
+	require.Equal(t, sdk.CodeOK, res.Code)
+	require.False(t, keeper.IsFeeToken(ctx, BtcToken))
+}
+
+func TestFeeTokenProposalFailed(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+	hdlr := NewTokenProposalHandler(keeper)
+
+	//non-exist token
+	res := hdlr(ctx, feeTokenProposal("ebt", sdk.NewDec(2)))
+	require.Equal(t, sdk.CodeUnsupportToken, res.Code)
+	require.Contains(t, res.Log, "does not exist")
+
+	//native token
+	res = hdlr(ctx, feeTokenProposal(sdk.NativeToken, sdk.NewDec(2)))
+	require.Equal(t, sdk.CodeInvalidTx, res.Code)
+
+	//remove a token which is not a fee token
+	res = hdlr(ctx, feeTokenProposal(BtcToken, sdk.ZeroDec()))
+	require.Equal(t, types.CodeInvalidFeeToken, res.Code)
+	require.Contains(t, res.Log, "is not a fee token")
+}
+
+func TestReserveSymbolProposals(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx.WithBlockTime(time.Unix(1000, 0))
+	keeper := input.tokenKeeper
+
+	for _, ti := range TestTokenData {
+		keeper.SetTokenInfo(ctx, &ti)
+	}
+
+	hdlr := NewTokenProposalHandler(keeper)
+	claimant := sdk.AccAddress([]byte("claimant"))
+
+	//an issued symbol can not be reserved
+	res := hdlr(ctx, types.NewReserveSymbolProposal("Test", "description", BtcToken, claimant, time.Time{}))
+	require.Equal(t, sdk.CodeSymbolAlreadyExist, res.Code)
+
+	//an expired reservation is meaningless
+	res = hdlr(ctx, types.NewReserveSymbolProposal("Test", "description", "abc", claimant, time.Unix(1000, 0)))
+	require.Equal(t, sdk.CodeInvalidTx, res.Code)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res = hdlr(ctx, types.NewReserveSymbolProposal("Test", "description", "abc", claimant, time.Unix(2000, 0)))
+	require.Equal(t, sdk.CodeOK, res.Code)
+	events := ctx.EventManager().Events()
+	require.Equal(t, 1, len(events))
+	require.Equal(t, types.EventTypeExecuteReserveSymbolProposal, events[0].Type)
+	reservation, found := keeper.GetReservation(ctx, "abc")
+	require.True(t, found)
+	require.Equal(t, types.NewReservation("abc", claimant, time.Unix(2000, 0).UTC()), reservation)
+
+	//reserving again replaces the reservation
+	res = hdlr(ctx, types.NewReserveSymbolProposal("Test", "description", "abc", nil, time.Time{}))
+	require.Equal(t, sdk.CodeOK, res.Code)
+	reservation, found = keeper.GetReservation(ctx, "abc")
+	require.True(t, found)
+	require.True(t, reservation.Claimant.Empty())
+	require.True(t, reservation.ExpireTime.IsZero())
+	require.Equal(t, 1, len(keeper.GetReservations(ctx)))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res = hdlr(ctx, types.NewUnreserveSymbolProposal("Test", "description", "abc"))
+	require.Equal(t, sdk.CodeOK, res.Code)
+	events = ctx.EventManager().Events()
+	require.Equal(t, 1, len(events))
+	require.Equal(t, types.EventTypeExecuteUnreserveSymbolProposal, events[0].Type)
+	_, found = keeper.GetReservation(ctx, "abc")
+	require.False(t, found)
+
+	res = hdlr(ctx, types.NewUnreserveSymbolProposal("Test", "description", "abc"))
+	require.Equal(t, types.CodeNotReserved, res.Code)
+}
+
+func TestTokenLifecycleProposals(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx.WithBlockHeight(10)
+	keeper := input.tokenKeeper
+	btc := sdk.Symbol(BtcToken)
+
+	for _, ti := range TestTokenData {
+		keeper.SetTokenInfo(ctx, &ti)
+	}
+	keeper.SetFeeToken(ctx, types.NewFeeToken(BtcToken, sdk.NewDec(2)))
+	require.True(t, keeper.IsFeeToken(ctx, BtcToken))
+
+	hdlr := NewTokenProposalHandler(keeper)
+
+	//an active token can not be enabled
+	res := hdlr(ctx, types.NewEnableTokenProposal("Test", "description", BtcToken))
+	require.Equal(t, types.CodeInvalidStatus, res.Code)
+
+	//pause
+	ctx = govtypes.WithProposalID(ctx, 3).WithEventManager(sdk.NewEventManager())
+	res = hdlr(ctx, disableProposal(BtcToken))
+	require.Equal(t, sdk.CodeOK, res.Code)
+	require.Equal(t, sdk.TokenStatusPaused, keeper.GetStatus(ctx, btc))
+	require.False(t, keeper.IsSendEnabled(ctx, btc))
+	require.False(t, keeper.IsFeeToken(ctx, BtcToken))
+
+	res = hdlr(ctx, disableProposal(BtcToken))
+	require.Equal(t, types.CodeInvalidStatus, res.Code)
+
+	//enable again
+	ctx = govtypes.WithProposalID(ctx.WithBlockHeight(20), 4).WithEventManager(sdk.NewEventManager())
+	res = hdlr(ctx, types.NewEnableTokenProposal("Test", "description", BtcToken))
+	require.Equal(t, sdk.CodeOK, res.Code)
+	events := ctx.EventManager().Events()
+	require.Equal(t, 1, len(events))
+	require.Equal(t, types.EventTypeExecuteEnableTokenProposal, events[0].Type)
+	require.Equal(t, sdk.TokenStatusActive, keeper.GetStatus(ctx, btc))
+	require.True(t, keeper.IsSendEnabled(ctx, btc))
+	require.True(t, keeper.IsFeeToken(ctx, BtcToken))
+
+	//migrate to a non-exist token
+	ctx = govtypes.WithProposalID(ctx.WithBlockHeight(30), 5).WithEventManager(sdk.NewEventManager())
+	res = hdlr(ctx, types.NewDelistTokenProposal("Test", "description", BtcToken, "wbtc"))
+	require.Equal(t, sdk.CodeUnsupportToken, res.Code)
+
+	//migrate to eth
+	res = hdlr(ctx, types.NewDelistTokenProposal("Test", "description", BtcToken, EthToken))
+	require.Equal(t, sdk.CodeOK, res.Code)
+	require.Equal(t, sdk.TokenStatusMigrating, keeper.GetStatus(ctx, btc))
+	require.True(t, keeper.IsSendEnabled(ctx, btc))
+	require.False(t, keeper.IsFeeToken(ctx, BtcToken))
+
+	//a migrating token can not be a successor
+	res = hdlr(ctx, types.NewDelistTokenProposal("Test", "description", UsdtToken, BtcToken))
+	require.Equal(t, types.CodeInvalidStatus, res.Code)
+
+	//delist
+	ctx = govtypes.WithProposalID(ctx.WithBlockHeight(40), 6).WithEventManager(sdk.NewEventManager())
+	res = hdlr(ctx, types.NewDelistTokenProposal("Test", "description", BtcToken, ""))
+	require.Equal(t, sdk.CodeOK, res.Code)
+	require.Equal(t, sdk.TokenStatusDelisted, keeper.GetStatus(ctx, btc))
+	require.False(t, keeper.IsSendEnabled(ctx, btc))
+
+	res = hdlr(ctx, types.NewDelistTokenProposal("Test", "description", sdk.NativeToken, ""))
+	require.Equal(t, sdk.CodeInvalidTx, res.Code)
+
+	//every transition is recorded with the proposal triggering it
+	transitions := keeper.GetStatusTransitions(ctx, btc)
+	require.Equal(t, []types.TokenStatusTransition{
+		types.NewTokenStatusTransition(btc, sdk.TokenStatusActive, sdk.TokenStatusPaused, 10, ctx.BlockHeader().Time, 3, ""),
+		types.NewTokenStatusTransition(btc, sdk.TokenStatusPaused, sdk.TokenStatusActive, 20, ctx.BlockHeader().Time, 4, ""),
+		types.NewTokenStatusTransition(btc, sdk.TokenStatusActive, sdk.TokenStatusMigrating, 30, ctx.BlockHeader().Time, 5, EthToken),
+		types.NewTokenStatusTransition(btc, sdk.TokenStatusMigrating, sdk.TokenStatusDelisted, 40, ctx.BlockHeader().Time, 6, ""),
+	}, transitions)
+	require.Nil(t, keeper.GetStatusTransitions(ctx, sdk.Symbol(EthToken)))
+}
+
+func TestDisableTokenProposalOverridesIssuerPause(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	for _, ti := range TestTokenData {
+		keeper.SetTokenInfo(ctx, &ti)
+	}
+	btc := sdk.Symbol(BtcToken)
+	issuer := sdk.AccAddress([]byte("issuer______________"))
+	hdlr := NewTokenProposalHandler(keeper)
+
+	keeper.SetStatusByIssuer(ctx, btc, sdk.TokenStatusPaused, issuer)
+	require.True(t, keeper.IsPausedByIssuer(ctx, btc))
+
+	//governance takes over the pause
+	ctx = govtypes.WithProposalID(ctx, 8).WithEventManager(sdk.NewEventManager())
+	res := hdlr(ctx, disableProposal(BtcToken))
+	require.Equal(t, sdk.CodeOK, res.Code)
+	require.Equal(t, sdk.TokenStatusPaused, keeper.GetStatus(ctx, btc))
+	require.False(t, keeper.IsPausedByIssuer(ctx, btc))
+
+	transitions := keeper.GetStatusTransitions(ctx, btc)
+	require.Equal(t, 2, len(transitions))
+	require.Equal(t, uint64(8), transitions[1].ProposalID)
+	require.True(t, transitions[1].Issuer.Empty())
+
+	//paused by governance already
+	res = hdlr(ctx, disableProposal(BtcToken))
+	require.Equal(t, types.CodeInvalidStatus, res.Code)
+
+	//only governance can enable it again
+	res = hdlr(ctx, types.NewEnableTokenProposal("Test", "description", BtcToken))
+	require.Equal(t, sdk.CodeOK, res.Code)
+	require.Equal(t, sdk.TokenStatusActive, keeper.GetStatus(ctx, btc))
+}
@@ -2,6 +2,7 @@ package token
 
 import (
 	"fmt"
+
 	"github.com/pocblockchain/pocc/codec"
 
 	sdk "github.com/pocblockchain/pocc/types"
@@ -16,11 +17,29 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		case types.QueryToken:
 			return queryToken(ctx, req, keeper)
 		case types.QuerySymbols:
-			return querySymbols(ctx, keeper)
+			return querySymbols(ctx, req, keeper)
 		case types.QueryTokens:
-			return queryTokens(ctx, keeper)
+			return queryTokens(ctx, req, keeper)
 		case types.QueryParameters:
 			return queryParams(ctx, keeper)
+		case types.QueryMetadata:
+			return queryMetadata(ctx, req, keeper)
+		case types.QueryFrozenAccounts:
+			return queryFrozenAccounts(ctx, req, keeper)
+		case types.QueryFeeTokens:
+			return queryFeeTokens(ctx, keeper)
+		case types.QueryTokensByStatus:
+			return queryTokensByStatus(ctx, req, keeper)
+		case types.QueryTransitions:
+			return queryStatusTransitions(ctx, req, keeper)
+		case types.QueryReservations:
+			return queryReservations(ctx, keeper)
+		case types.QueryAirdrop:
+			return queryAirdrop(ctx, req, keeper)
+		case types.QueryAirdrops:
+			return queryAirdrops(ctx, req, keeper)
+		case types.QueryAirdropClaim:
+			return queryAirdropClaim(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest(path[0])
 		}
@@ -49,10 +68,43 @@ func queryToken(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 }
 
 //=====
-func querySymbols(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
-	var symbols []string
-	symbols = keeper.GetSymbols(ctx)
-	bz, err := keeper.cdc.MarshalJSON(symbols)
+func queryMetadata(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var ti QueryTokenInfo
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &ti); err != nil {
+		return nil, sdk.ErrJSONUnmarshal(fmt.Sprintf("%v", ti))
+	}
+
+	symbol := sdk.Symbol(ti.Symbol)
+	if !keeper.IsTokenSupported(ctx, symbol) {
+		return nil, types.ErrNonExistSymbol(ti.Symbol)
+	}
+
+	bz, err := keeper.cdc.MarshalJSON(types.QueryResMetadata(keeper.GetMetadata(ctx, symbol)))
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+//=====
+func queryFrozenAccounts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var ti QueryTokenInfo
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &ti); err != nil {
+		return nil, sdk.ErrJSONUnmarshal(fmt.Sprintf("%v", ti))
+	}
+
+	symbol := sdk.Symbol(ti.Symbol)
+	if !keeper.IsTokenSupported(ctx, symbol) {
+		return nil, types.ErrNonExistSymbol(ti.Symbol)
+	}
+
+	addrs := keeper.GetFrozenAccounts(ctx, symbol)
+	if addrs == nil {
+		addrs = []sdk.AccAddress{}
+	}
+
+	bz, err := keeper.cdc.MarshalJSON(types.QueryResFrozenAccounts(addrs))
 	if err != nil {
 		panic("could not marshal result to JSON")
 	}
@@ -60,8 +112,75 @@ func querySymbols(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 	return bz, nil
 }
 
-func queryTokens(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
-	tokens := keeper.GetAllTokenInfo(ctx)
+//=====
+//pageSymbols returns the page of symbols matching match out of the ones iterated by IterateSymbols,
+//the iteration stops at the last symbol of the page so that no full scan is needed for the first pages
+func pageSymbols(ctx sdk.Context, keeper Keeper, issuer, prefix, after string, page, limit int, match func(symbol string) bool) []string {
+	symbols := []string{}
+	//all the matching symbols are returned unless a limit is given
+	if limit != 0 && (page < 1 || limit < 0) {
+		return symbols
+	}
+
+	skip := 0
+	if limit != 0 {
+		skip = (page - 1) * limit
+	}
+	keeper.IterateSymbols(ctx, issuer, prefix, after, func(symbol string) bool {
+		if !match(symbol) {
+			return false
+		}
+		if skip > 0 {
+			skip--
+			return false
+		}
+		symbols = append(symbols, symbol)
+		return limit != 0 && len(symbols) == limit
+	})
+	return symbols
+}
+
+//filterSymbols returns the page of symbols matching the filters of the tokens or symbols query,
+//the issuer index and the store prefix narrow the candidates so that no full scan is needed for them
+func filterSymbols(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]string, sdk.Error) {
+	params := types.NewQueryTokensParams(1, 0, "", nil, "", "")
+	if len(req.Data) != 0 {
+		if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdk.ErrJSONUnmarshal(fmt.Sprintf("%v", params))
+		}
+	}
+
+	symbols := pageSymbols(ctx, keeper, params.Issuer, params.Prefix, params.After, params.Page, params.Limit, func(symbol string) bool {
+		return params.SendEnabled == nil || keeper.IsSendEnabled(ctx, sdk.Symbol(symbol)) == *params.SendEnabled
+	})
+	return symbols, nil
+}
+
+func querySymbols(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	symbols, sdkErr := filterSymbols(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := keeper.cdc.MarshalJSON(types.QueryResSymbols(symbols))
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryTokens(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	symbols, sdkErr := filterSymbols(ctx, req, keeper)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	tokens := make([]sdk.TokenInfo, 0, len(symbols))
+	for _, symbol := range symbols {
+		tokens = append(tokens, *keeper.GetTokenInfo(ctx, sdk.Symbol(symbol)))
+	}
+
 	bz, err := keeper.cdc.MarshalJSON(types.QueryResTokens(tokens))
 	if err != nil {
 		panic("could not marshal result to JSON")
@@ -70,6 +189,67 @@ func queryTokens(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
 	return bz, nil
 }
 
+func queryTokensByStatus(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryTokensByStatusParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrJSONUnmarshal(fmt.Sprintf("%v", params))
+	}
+
+	symbols := pageSymbols(ctx, keeper, "", "", params.After, params.Page, params.Limit, func(symbol string) bool {
+		return keeper.GetStatus(ctx, sdk.Symbol(symbol)) == params.Status
+	})
+
+	tokens := make([]sdk.TokenInfo, 0, len(symbols))
+	for _, symbol := range symbols {
+		tokens = append(tokens, *keeper.GetTokenInfo(ctx, sdk.Symbol(symbol)))
+	}
+
+	bz, err := keeper.cdc.MarshalJSON(types.QueryResTokens(tokens))
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryStatusTransitions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var ti QueryTokenInfo
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &ti); err != nil {
+		return nil, sdk.ErrJSONUnmarshal(fmt.Sprintf("%v", ti))
+	}
+
+	symbol := sdk.Symbol(ti.Symbol)
+	if !keeper.IsTokenSupported(ctx, symbol) {
+		return nil, types.ErrNonExistSymbol(ti.Symbol)
+	}
+
+	transitions := keeper.GetStatusTransitions(ctx, symbol)
+	if transitions == nil {
+		transitions = []types.TokenStatusTransition{}
+	}
+
+	bz, err := keeper.cdc.MarshalJSON(types.QueryResTransitions(transitions))
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryFeeTokens(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	feeTokens := keeper.GetFeeTokens(ctx)
+	if feeTokens == nil {
+		feeTokens = []types.FeeToken{}
+	}
+
+	bz, err := keeper.cdc.MarshalJSON(types.QueryResFeeTokens(feeTokens))
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
 func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	params := k.GetParams(ctx)
 	res, err := codec.MarshalJSONIndent(k.cdc, params)
@@ -78,3 +258,87 @@ func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
 	}
 	return res, nil
 }
+
+func queryReservations(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	reservations := keeper.GetReservations(ctx)
+	if reservations == nil {
+		reservations = []types.Reservation{}
+	}
+
+	bz, err := keeper.cdc.MarshalJSON(types.Reservations(reservations))
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryAirdrop(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAirdropParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrJSONUnmarshal(fmt.Sprintf("%v", err))
+	}
+
+	airdrop, found := keeper.GetAirdrop(ctx, params.AirdropID)
+	if !found {
+		return nil, types.ErrUnknownAirdrop(params.AirdropID)
+	}
+
+	bz, err := keeper.cdc.MarshalJSON(airdrop)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryAirdrops(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAirdropsParams
+	if len(req.Data) > 0 {
+		if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdk.ErrJSONUnmarshal(fmt.Sprintf("%v", err))
+		}
+	}
+
+	airdrops := types.Airdrops{}
+	keeper.IterateAirdrops(ctx, func(airdrop types.Airdrop) bool {
+		if params.Issuer.Empty() || params.Issuer.Equals(airdrop.Issuer) {
+			airdrops = append(airdrops, airdrop)
+		}
+		return false
+	})
+
+	bz, err := keeper.cdc.MarshalJSON(airdrops)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryAirdropClaim(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAirdropClaimParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrJSONUnmarshal(fmt.Sprintf("%v", err))
+	}
+
+	airdrop, found := keeper.GetAirdrop(ctx, params.AirdropID)
+	if !found {
+		return nil, types.ErrUnknownAirdrop(params.AirdropID)
+	}
+	if params.Index >= airdrop.Recipients {
+		return nil, types.ErrInvalidAirdrop(fmt.Sprintf("leaf %d out of the %d recipients", params.Index, airdrop.Recipients))
+	}
+
+	res := types.QueryResAirdropClaim{
+		AirdropID: params.AirdropID,
+		Index:     params.Index,
+		Claimed:   keeper.IsAirdropClaimed(ctx, params.AirdropID, params.Index),
+	}
+	bz, err := keeper.cdc.MarshalJSON(res)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
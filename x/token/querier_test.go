@@ -14,6 +14,7 @@ package token
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/pocblockchain/pocc/types"
 	"github.com/pocblockchain/pocc/x/token/types"
@@ -58,7 +59,7 @@ func TestQuery(t *testing.T) {
 	assert.NotNil(t, err)
 
 	//err
-	bz, err = querySymbols(ctx, keeper)
+	bz, err = querySymbols(ctx, abci.RequestQuery{}, keeper)
 	assert.Nil(t, err)
 	var symbols []string
 	keeper.cdc.MustUnmarshalJSON(bz, &symbols)
@@ -78,7 +79,7 @@ func TestQueryTokens(t *testing.T) {
 	}
 
 	//queryTokens
-	bz, err := queryTokens(ctx, keeper)
+	bz, err := queryTokens(ctx, abci.RequestQuery{}, keeper)
 	assert.Nil(t, err)
 
 	// Unmarshal tokeninfos
@@ -98,7 +99,7 @@ func TestMarshalAndString(t *testing.T) {
 	input := setupTestEnv(t)
 	keeper := input.tokenKeeper
 
-	prtintStr := "\n\tSymbol:\n\tIssuer:\n\tIsSendEnabled:false\n\tDecimals:8\n\tTotalSupply:2100\n\t"
+	prtintStr := "\n\tSymbol:\n\tIssuer:\n\tIsSendEnabled:false\n\tDecimals:8\n\tTotalSupply:2100\n\tPendingIssuer:\n\tMintRenounced:false\n\tMaxSupply:0\n\tMintable:false\n\tBurnable:false\n\tFreezable:false\n\tPausable:false\n\tStatus:Active\n\tMetadata:{Name: Description: LogoURI: Website:}\n\t"
 
 	btcTokenInfo := sdk.TokenInfo{
 		Issuer:        "",
@@ -106,6 +107,7 @@ func TestMarshalAndString(t *testing.T) {
 
 		Decimals:    8,
 		TotalSupply: sdk.NewInt(2100),
+		MaxSupply:   sdk.ZeroInt(),
 	}
 
 	bz, err := keeper.cdc.MarshalJSON(btcTokenInfo)
@@ -116,3 +118,321 @@ func TestMarshalAndString(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, prtintStr, res.String())
 }
+
+func TestQueryMetadata(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+	cdc := input.cdc
+
+	for _, ti := range TestTokenData {
+		keeper.SetTokenInfo(ctx, &ti)
+	}
+
+	metadata := sdk.NewTokenMetadata("Bitcoin", "digital gold", "", "https://bitcoin.org")
+	keeper.SetMetadata(ctx, sdk.Symbol(BtcToken), metadata)
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("token/%s/%s", types.RouterKey, types.QueryMetadata),
+	}
+
+	bz, err := cdc.MarshalJSON(QueryTokenInfo{BtcToken})
+	assert.NoError(t, err)
+	req.Data = bz
+	bz, err = queryMetadata(ctx, req, keeper)
+	assert.Nil(t, err)
+
+	var res types.QueryResMetadata
+	keeper.cdc.MustUnmarshalJSON(bz, &res)
+	assert.Equal(t, metadata, sdk.TokenMetadata(res))
+
+	bz, err = cdc.MarshalJSON(QueryTokenInfo{"nonexist"})
+	assert.NoError(t, err)
+	req.Data = bz
+	_, err = queryMetadata(ctx, req, keeper)
+	assert.NotNil(t, err)
+}
+
+func TestQueryFrozenAccounts(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+	cdc := input.cdc
+
+	for _, ti := range TestTokenData {
+		keeper.SetTokenInfo(ctx, &ti)
+	}
+
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	keeper.FreezeAccount(ctx, sdk.Symbol(BtcToken), addr)
+
+	req := abci.RequestQuery{
+		Path: fmt.Sprintf("token/%s/%s", types.RouterKey, types.QueryFrozenAccounts),
+	}
+
+	bz, err := cdc.MarshalJSON(QueryTokenInfo{BtcToken})
+	assert.NoError(t, err)
+	req.Data = bz
+	bz, err = queryFrozenAccounts(ctx, req, keeper)
+	assert.Nil(t, err)
+
+	var res types.QueryResFrozenAccounts
+	keeper.cdc.MustUnmarshalJSON(bz, &res)
+	assert.Equal(t, types.QueryResFrozenAccounts{addr}, res)
+
+	bz, err = cdc.MarshalJSON(QueryTokenInfo{EthToken})
+	assert.NoError(t, err)
+	req.Data = bz
+	bz, err = queryFrozenAccounts(ctx, req, keeper)
+	assert.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &res)
+	assert.Equal(t, 0, len(res))
+
+	bz, err = cdc.MarshalJSON(QueryTokenInfo{"nonexist"})
+	assert.NoError(t, err)
+	req.Data = bz
+	_, err = queryFrozenAccounts(ctx, req, keeper)
+	assert.NotNil(t, err)
+}
+
+func TestQueryFeeTokens(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	bz, err := queryFeeTokens(ctx, keeper)
+	assert.Nil(t, err)
+
+	var res types.QueryResFeeTokens
+	keeper.cdc.MustUnmarshalJSON(bz, &res)
+	assert.Equal(t, 0, len(res))
+
+	keeper.SetFeeToken(ctx, types.NewFeeToken(BtcToken, sdk.NewDec(2)))
+	bz, err = queryFeeTokens(ctx, keeper)
+	assert.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &res)
+	assert.Equal(t, types.QueryResFeeTokens{types.NewFeeToken(BtcToken, sdk.NewDec(2))}, res)
+	assert.Equal(t, "btc:2.000000000000000000", res.String())
+}
+
+func TestQueryReservations(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+	querier := NewQuerier(keeper)
+
+	bz, err := querier(ctx, []string{types.QueryReservations}, abci.RequestQuery{})
+	assert.Nil(t, err)
+
+	var res types.Reservations
+	keeper.cdc.MustUnmarshalJSON(bz, &res)
+	assert.Equal(t, 0, len(res))
+
+	claimant, _ := sdk.AccAddressFromBech32("poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr")
+	keeper.SetReservation(ctx, types.NewReservation("eth", nil, time.Time{}))
+	keeper.SetReservation(ctx, types.NewReservation("abc", claimant, time.Unix(1000, 0).UTC()))
+	bz, err = querier(ctx, []string{types.QueryReservations}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &res)
+	assert.Equal(t, 2, len(res))
+	assert.Equal(t, "abc", res[0].Symbol)
+	assert.True(t, res[0].CanClaim(claimant))
+	assert.Equal(t, time.Unix(1000, 0).UTC(), res[0].ExpireTime)
+	assert.Equal(t, "eth", res[1].Symbol)
+	assert.True(t, res[1].Claimant.Empty())
+	assert.True(t, res[1].ExpireTime.IsZero())
+}
+
+func TestQueryTokensByStatus(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	for _, ti := range TestTokenData {
+		keeper.SetTokenInfo(ctx, &ti)
+	}
+	keeper.SetStatus(ctx, sdk.Symbol(BtcToken), sdk.TokenStatusPaused, 7, "")
+
+	queryPage := func(status sdk.TokenStatus, page, limit int, after string) types.QueryResTokens {
+		params := types.NewQueryTokensByStatusParams(status, page, limit, after)
+		bz, err := queryTokensByStatus(ctx, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(params)}, keeper)
+		assert.Nil(t, err)
+		var res types.QueryResTokens
+		keeper.cdc.MustUnmarshalJSON(bz, &res)
+		return res
+	}
+	query := func(status sdk.TokenStatus) types.QueryResTokens {
+		return queryPage(status, 1, 0, "")
+	}
+
+	paused := query(sdk.TokenStatusPaused)
+	assert.Equal(t, 1, len(paused))
+	assert.Equal(t, sdk.Symbol(BtcToken), paused[0].Symbol)
+	assert.Equal(t, len(TestTokenData)-1, len(query(sdk.TokenStatusActive)))
+	assert.Equal(t, 0, len(query(sdk.TokenStatusDelisted)))
+
+	//pagination by page or by cursor
+	active := query(sdk.TokenStatusActive)
+	assert.Equal(t, 3, len(active))
+	assert.Equal(t, active[:2], queryPage(sdk.TokenStatusActive, 1, 2, ""))
+	assert.Equal(t, active[2:], queryPage(sdk.TokenStatusActive, 2, 2, ""))
+	assert.Equal(t, active[1:2], queryPage(sdk.TokenStatusActive, 1, 1, active[0].Symbol.String()))
+	assert.Equal(t, active[1:], queryPage(sdk.TokenStatusActive, 1, 0, active[0].Symbol.String()))
+	assert.Equal(t, 0, len(queryPage(sdk.TokenStatusPaused, 1, 1, BtcToken)))
+
+	//status transitions
+	bz, err := queryStatusTransitions(ctx, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(types.NewQueryTokenInfo(BtcToken))}, keeper)
+	assert.Nil(t, err)
+	var transitions types.QueryResTransitions
+	keeper.cdc.MustUnmarshalJSON(bz, &transitions)
+	assert.Equal(t, 1, len(transitions))
+	assert.Equal(t, sdk.TokenStatusActive, transitions[0].From)
+	assert.Equal(t, sdk.TokenStatusPaused, transitions[0].To)
+	assert.Equal(t, uint64(7), transitions[0].ProposalID)
+
+	_, err = queryStatusTransitions(ctx, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(types.NewQueryTokenInfo("xyz"))}, keeper)
+	assert.NotNil(t, err)
+}
+
+func TestQueryTokensWithParams(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	for _, ti := range TestTokenData {
+		keeper.SetTokenInfo(ctx, &ti)
+	}
+	issuer := sdk.AccAddress([]byte("issuer______________"))
+	for _, symbol := range []string{"bha", "bhb", "bhc", "bhd", "cat"} {
+		keeper.SetTokenInfo(ctx, &sdk.TokenInfo{Symbol: sdk.Symbol(symbol), Issuer: issuer.String(), IsSendEnabled: true, Decimals: 8, TotalSupply: sdk.NewInt(100), MaxSupply: sdk.ZeroInt()})
+	}
+	keeper.DisableSend(ctx, "bhb")
+
+	query := func(params types.QueryTokensParams) types.QueryResSymbols {
+		bz, err := querySymbols(ctx, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(params)}, keeper)
+		assert.Nil(t, err)
+		var res types.QueryResSymbols
+		keeper.cdc.MustUnmarshalJSON(bz, &res)
+		return res
+	}
+	enabled, disabled := true, false
+
+	assert.Equal(t, 9, len(query(types.NewQueryTokensParams(1, 0, "", nil, "", ""))))
+	assert.Equal(t, types.QueryResSymbols{"bha", "bhb", "bhc", "bhd", "cat"}, query(types.NewQueryTokensParams(1, 0, issuer.String(), nil, "", "")))
+	assert.Equal(t, types.QueryResSymbols{"bha", "bhb", "bhc", "bhd"}, query(types.NewQueryTokensParams(1, 0, "", nil, "bh", "")))
+	assert.Equal(t, types.QueryResSymbols{"bha", "bhc", "bhd"}, query(types.NewQueryTokensParams(1, 0, issuer.String(), &enabled, "bh", "")))
+	assert.Equal(t, types.QueryResSymbols{"bhb"}, query(types.NewQueryTokensParams(1, 0, "", &disabled, "", "")))
+
+	//pagination
+	assert.Equal(t, types.QueryResSymbols{"bha", "bhb"}, query(types.NewQueryTokensParams(1, 2, "", nil, "bh", "")))
+	assert.Equal(t, types.QueryResSymbols{"bhc", "bhd"}, query(types.NewQueryTokensParams(2, 2, "", nil, "bh", "")))
+	assert.Equal(t, 0, len(query(types.NewQueryTokensParams(3, 2, "", nil, "bh", ""))))
+
+	//pagination by cursor
+	assert.Equal(t, types.QueryResSymbols{"bhc", "bhd"}, query(types.NewQueryTokensParams(1, 2, "", nil, "bh", "bhb")))
+	assert.Equal(t, types.QueryResSymbols{"bhd"}, query(types.NewQueryTokensParams(2, 1, "", nil, "bh", "bhb")))
+	assert.Equal(t, types.QueryResSymbols{"bhc", "bhd", "cat"}, query(types.NewQueryTokensParams(1, 0, issuer.String(), nil, "", "bhb")))
+	assert.Equal(t, types.QueryResSymbols{"bha"}, query(types.NewQueryTokensParams(1, 1, issuer.String(), nil, "bh", "b")))
+	assert.Equal(t, types.QueryResSymbols{"bhc", "bhd"}, query(types.NewQueryTokensParams(1, 0, issuer.String(), &enabled, "bh", "bhb")))
+	assert.Equal(t, 0, len(query(types.NewQueryTokensParams(1, 0, "", nil, "bh", "bhd"))))
+	assert.Equal(t, 0, len(query(types.NewQueryTokensParams(1, 0, "", nil, "bh", "c"))))
+
+	//tokens query applies the same params
+	bz, err := queryTokens(ctx, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(types.NewQueryTokensParams(2, 2, issuer.String(), nil, "", ""))}, keeper)
+	assert.Nil(t, err)
+	var tokens types.QueryResTokens
+	keeper.cdc.MustUnmarshalJSON(bz, &tokens)
+	assert.Equal(t, 2, len(tokens))
+	assert.Equal(t, sdk.Symbol("bhc"), tokens[0].Symbol)
+	assert.Equal(t, sdk.NewInt(100), tokens[0].TotalSupply)
+
+	_, err = queryTokens(ctx, abci.RequestQuery{Data: []byte("invalid")}, keeper)
+	assert.NotNil(t, err)
+}
+
+func TestQueryTokensWithoutPagination(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+
+	issuer := sdk.AccAddress([]byte("issuer______________"))
+	for i := 0; i < 150; i++ {
+		symbol := sdk.Symbol(fmt.Sprintf("tk%03d", i))
+		keeper.SetTokenInfo(ctx, &sdk.TokenInfo{Symbol: symbol, Issuer: issuer.String(), IsSendEnabled: true, Decimals: 8, TotalSupply: sdk.NewInt(100), MaxSupply: sdk.ZeroInt()})
+	}
+	total := len(keeper.GetAllTokenInfo(ctx))
+	assert.True(t, total > 150)
+
+	//all tokens are returned without params or limit
+	var symbols types.QueryResSymbols
+	bz, err := querySymbols(ctx, abci.RequestQuery{}, keeper)
+	assert.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &symbols)
+	assert.Equal(t, total, len(symbols))
+
+	var tokens types.QueryResTokens
+	bz, err = queryTokens(ctx, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(types.NewQueryTokensParams(1, 0, issuer.String(), nil, "", ""))}, keeper)
+	assert.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &tokens)
+	assert.Equal(t, 150, len(tokens))
+
+	//a limit paginates them
+	bz, err = querySymbols(ctx, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(types.NewQueryTokensParams(2, 100, "", nil, "tk", ""))}, keeper)
+	assert.Nil(t, err)
+	symbols = nil
+	keeper.cdc.MustUnmarshalJSON(bz, &symbols)
+	assert.Equal(t, 50, len(symbols))
+	assert.Equal(t, "tk100", symbols[0])
+}
+
+func TestQueryAirdrops(t *testing.T) {
+	input := setupTestEnv(t)
+	ctx := input.ctx
+	keeper := input.tokenKeeper
+	querier := NewQuerier(keeper)
+
+	issuer, _ := sdk.AccAddressFromBech32("poc12jwptqcnzkk4d7yupmwlnkzjkm9hvp0rr0chxr")
+	other := sdk.AccAddress([]byte("other-airdrop-issuer"))
+	root := make([]byte, 32)
+	keeper.SetAirdrop(ctx, types.NewAirdrop(1, issuer, sdk.NewCoin("bhd", sdk.NewInt(100)), root, 2, time.Unix(1000, 0).UTC()))
+	keeper.SetAirdrop(ctx, types.NewAirdrop(2, other, sdk.NewCoin("bhe", sdk.NewInt(200)), root, 3, time.Unix(2000, 0).UTC()))
+	keeper.SetAirdropClaimed(ctx, 1, 1)
+
+	bz, err := querier(ctx, []string{types.QueryAirdrop}, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(types.NewQueryAirdropParams(2))})
+	assert.Nil(t, err)
+	var airdrop types.Airdrop
+	keeper.cdc.MustUnmarshalJSON(bz, &airdrop)
+	assert.Equal(t, uint64(2), airdrop.AirdropID)
+	assert.Equal(t, other, airdrop.Issuer)
+	assert.Equal(t, sdk.NewInt(200), airdrop.Remaining)
+
+	_, err = querier(ctx, []string{types.QueryAirdrop}, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(types.NewQueryAirdropParams(3))})
+	assert.NotNil(t, err)
+
+	var airdrops types.Airdrops
+	bz, err = querier(ctx, []string{types.QueryAirdrops}, abci.RequestQuery{})
+	assert.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &airdrops)
+	assert.Equal(t, 2, len(airdrops))
+
+	bz, err = querier(ctx, []string{types.QueryAirdrops}, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(types.NewQueryAirdropsParams(issuer))})
+	assert.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &airdrops)
+	assert.Equal(t, 1, len(airdrops))
+	assert.Equal(t, uint64(1), airdrops[0].AirdropID)
+
+	var claim types.QueryResAirdropClaim
+	bz, err = querier(ctx, []string{types.QueryAirdropClaim}, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(types.NewQueryAirdropClaimParams(1, 1))})
+	assert.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &claim)
+	assert.True(t, claim.Claimed)
+
+	bz, err = querier(ctx, []string{types.QueryAirdropClaim}, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(types.NewQueryAirdropClaimParams(1, 0))})
+	assert.Nil(t, err)
+	keeper.cdc.MustUnmarshalJSON(bz, &claim)
+	assert.False(t, claim.Claimed)
+
+	//the index is out of the recipients
+	_, err = querier(ctx, []string{types.QueryAirdropClaim}, abci.RequestQuery{Data: keeper.cdc.MustMarshalJSON(types.NewQueryAirdropClaimParams(1, 2))})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,42 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/pocblockchain/pocc/codec"
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/simulation"
+	"github.com/pocblockchain/pocc/x/token"
+	"github.com/pocblockchain/pocc/x/token/types"
+)
+
+// Simulation parameter constants
+const (
+	TokenCacheSize = "token_cache_size"
+	NewTokenFee    = "new_token_fee"
+	FeeDestination = "fee_destination"
+)
+
+// GenTokenGenesisState generates a random GenesisState for token
+func GenTokenGenesisState(cdc *codec.Codec, r *rand.Rand, ap simulation.AppParams, genesisState map[string]json.RawMessage) {
+	tokenGenesis := token.DefaultGenesisState()
+
+	ap.GetOrGenerate(cdc, TokenCacheSize, &tokenGenesis.Params.TokenCacheSize, r,
+		func(r *rand.Rand) {
+			tokenGenesis.Params.TokenCacheSize = uint64(simulation.RandIntBetween(r, 1, 64))
+		})
+	ap.GetOrGenerate(cdc, NewTokenFee, &tokenGenesis.Params.NewTokenFee, r,
+		func(r *rand.Rand) {
+			tokenGenesis.Params.NewTokenFee = sdk.NewInt(int64(simulation.RandIntBetween(r, 1, 1e9)))
+		})
+	ap.GetOrGenerate(cdc, FeeDestination, &tokenGenesis.Params.FeeDestination, r,
+		func(r *rand.Rand) {
+			destinations := []string{types.FeeDestinationCommunityPool, types.FeeDestinationBurn, types.FeeDestinationFeeCollector}
+			tokenGenesis.Params.FeeDestination = destinations[r.Intn(len(destinations))]
+		})
+
+	fmt.Printf("Selected randomly generated token parameters:\n%s\n", codec.MustMarshalJSONIndent(cdc, tokenGenesis.Params))
+	genesisState[token.ModuleName] = cdc.MustMarshalJSON(tokenGenesis)
+}
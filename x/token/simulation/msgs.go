@@ -0,0 +1,282 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/pocblockchain/pocc/baseapp"
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/auth"
+	"github.com/pocblockchain/pocc/x/gov"
+	govsim "github.com/pocblockchain/pocc/x/gov/simulation"
+	"github.com/pocblockchain/pocc/x/simulation"
+	"github.com/pocblockchain/pocc/x/token"
+	"github.com/pocblockchain/pocc/x/token/types"
+)
+
+// SimulateMsgNewToken generates a MsgNewToken with random values.
+func SimulateMsgNewToken(ak auth.AccountKeeper, k token.Keeper) simulation.Operation {
+	handler := token.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		issuer := simulation.RandomAcc(r, accs)
+		recipient := simulation.RandomAcc(r, accs)
+
+		symbol := randomSymbol(r)
+		fee := k.GetNewTokenFee(ctx, sdk.Symbol(symbol))
+		spendable := ak.GetAccount(ctx, issuer.Address).SpendableCoins(ctx.BlockHeader().Time)
+		if spendable.AmountOf(sdk.NativeToken).LT(fee) {
+			return simulation.NoOpMsg(token.ModuleName), nil, nil
+		}
+
+		totalSupply := sdk.NewInt(int64(simulation.RandIntBetween(r, 1, 1e12)))
+		maxSupply := sdk.ZeroInt()
+		if r.Intn(2) == 0 {
+			maxSupply = totalSupply.MulRaw(int64(simulation.RandIntBetween(r, 1, 10)))
+		}
+
+		msg := types.NewMsgNewToken(issuer.Address, recipient.Address, symbol, uint64(r.Intn(sdk.Precision+1)),
+			totalSupply, maxSupply, r.Intn(2) == 0, r.Intn(2) == 0, r.Intn(2) == 0, r.Intn(2) == 0, sdk.TokenMetadata{})
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(token.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgInflateToken generates a MsgInflateToken of a random issued token.
+func SimulateMsgInflateToken(k token.Keeper) simulation.Operation {
+	handler := token.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		ti, found := randomIssuedToken(r, ctx, k)
+		if !found {
+			return simulation.NoOpMsg(token.ModuleName), nil, nil
+		}
+
+		issuer, err := sdk.AccAddressFromBech32(ti.Issuer)
+		if err != nil {
+			return simulation.NoOpMsg(token.ModuleName), nil, nil
+		}
+
+		// stay within the max supply of capped tokens
+		max := k.GetTotalSupply(ctx, ti.Symbol)
+		if ti.IsCapped() {
+			max = ti.MaxSupply.Sub(max)
+		}
+		amt, goErr := simulation.RandPositiveInt(r, max)
+		if goErr != nil {
+			return simulation.NoOpMsg(token.ModuleName), nil, nil
+		}
+
+		recipient := simulation.RandomAcc(r, accs)
+		msg := types.NewMsgInflateToken(issuer, recipient.Address, sdk.NewCoins(sdk.NewCoin(ti.Symbol.String(), amt)))
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(token.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateMsgBurnToken generates a MsgBurnToken of a random coin held by a random account.
+func SimulateMsgBurnToken(ak auth.AccountKeeper, k token.Keeper) simulation.Operation {
+	handler := token.NewHandler(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simulation.Account) (opMsg simulation.OperationMsg, fOps []simulation.FutureOperation, err error) {
+
+		burner := simulation.RandomAcc(r, accs)
+		spendable := ak.GetAccount(ctx, burner.Address).SpendableCoins(ctx.BlockHeader().Time)
+		if len(spendable) == 0 {
+			return simulation.NoOpMsg(token.ModuleName), nil, nil
+		}
+
+		coin := spendable[r.Intn(len(spendable))]
+		amt, goErr := simulation.RandPositiveInt(r, coin.Amount)
+		if goErr != nil {
+			return simulation.NoOpMsg(token.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgBurnToken(burner.Address, sdk.NewCoins(sdk.NewCoin(coin.Denom, amt)))
+
+		if msg.ValidateBasic() != nil {
+			return simulation.NoOpMsg(token.ModuleName), nil, fmt.Errorf("expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		}
+
+		ctx, write := ctx.CacheContext()
+		ok := handler(ctx, msg).IsOK()
+		if ok {
+			write()
+		}
+
+		opMsg = simulation.NewOperationMsg(msg, ok, "")
+		return opMsg, nil, nil
+	}
+}
+
+// SimulateTokenParamsChangeProposalContent generates random token-params-change proposal content
+func SimulateTokenParamsChangeProposalContent(k token.Keeper) govsim.ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []simulation.Account) gov.Content {
+		symbol := randomSymbol(r)
+		totalSupply := sdk.ZeroInt()
+		if ti, found := randomIssuedToken(r, ctx, k); found {
+			symbol = ti.Symbol.String()
+			totalSupply = k.GetTotalSupply(ctx, ti.Symbol)
+		}
+
+		var changes []types.ParamChange
+		for _, key := range []string{sdk.KeyIsSendEnabled, sdk.KeyMintable, sdk.KeyBurnable, sdk.KeyFreezable, sdk.KeyPausable} {
+			if r.Intn(2) == 0 {
+				changes = append(changes, types.NewParamChange(key, fmt.Sprintf("%t", r.Intn(2) == 0)))
+			}
+		}
+		if r.Intn(2) == 0 {
+			maxSupply := totalSupply.MulRaw(int64(simulation.RandIntBetween(r, 1, 10)))
+			changes = append(changes, types.NewParamChange(sdk.KeyMaxSupply, fmt.Sprintf("\"%s\"", maxSupply)))
+		}
+
+		return token.NewTokenParamsChangeProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			symbol,
+			changes,
+		)
+	}
+}
+
+// SimulateDisableTokenProposalContent generates random disable-token proposal content
+func SimulateDisableTokenProposalContent(k token.Keeper) govsim.ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []simulation.Account) gov.Content {
+		symbol := randomSymbol(r)
+		if ti, found := randomIssuedToken(r, ctx, k); found {
+			symbol = ti.Symbol.String()
+		}
+
+		return token.NewDisableTokenProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			symbol,
+		)
+	}
+}
+
+// SimulateEnableTokenProposalContent generates random enable-token proposal content
+func SimulateEnableTokenProposalContent(k token.Keeper) govsim.ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []simulation.Account) gov.Content {
+		symbol := randomSymbol(r)
+		if ti, found := randomIssuedToken(r, ctx, k); found {
+			symbol = ti.Symbol.String()
+		}
+
+		return token.NewEnableTokenProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			symbol,
+		)
+	}
+}
+
+// SimulateDelistTokenProposalContent generates random delist-token proposal content,
+// migrating the token to another issued token half of the time
+func SimulateDelistTokenProposalContent(k token.Keeper) govsim.ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []simulation.Account) gov.Content {
+		symbol := randomSymbol(r)
+		if ti, found := randomIssuedToken(r, ctx, k); found {
+			symbol = ti.Symbol.String()
+		}
+
+		successor := ""
+		if ti, found := randomIssuedToken(r, ctx, k); found && r.Intn(2) == 0 && ti.Symbol.String() != symbol {
+			successor = ti.Symbol.String()
+		}
+
+		return token.NewDelistTokenProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			symbol,
+			successor,
+		)
+	}
+}
+
+// SimulateReserveSymbolProposalContent generates a ReserveSymbolProposal of a random symbol,
+// claimed by a random account or nobody and expiring within a day or never
+func SimulateReserveSymbolProposalContent(k token.Keeper) govsim.ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account) gov.Content {
+		var claimant sdk.AccAddress
+		if r.Intn(2) == 0 {
+			claimant = simulation.RandomAcc(r, accs).Address
+		}
+
+		var expireTime time.Time
+		if r.Intn(2) == 0 {
+			expireTime = ctx.BlockHeader().Time.Add(time.Duration(simulation.RandIntBetween(r, 1, 86400)) * time.Second)
+		}
+
+		return token.NewReserveSymbolProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			randomSymbol(r),
+			claimant,
+			expireTime,
+		)
+	}
+}
+
+// SimulateUnreserveSymbolProposalContent generates an UnreserveSymbolProposal of a random reserved symbol
+func SimulateUnreserveSymbolProposalContent(k token.Keeper) govsim.ContentSimulator {
+	return func(r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []simulation.Account) gov.Content {
+		symbol := randomSymbol(r)
+		if reservations := k.GetReservations(ctx); len(reservations) > 0 {
+			symbol = reservations[r.Intn(len(reservations))].Symbol
+		}
+
+		return token.NewUnreserveSymbolProposal(
+			simulation.RandStringOfLength(r, 10),
+			simulation.RandStringOfLength(r, 100),
+			symbol,
+		)
+	}
+}
+
+// randomSymbol returns a random lower case symbol of 3 to 8 letters
+func randomSymbol(r *rand.Rand) string {
+	return strings.ToLower(simulation.RandStringOfLength(r, simulation.RandIntBetween(r, 3, 9)))
+}
+
+// randomIssuedToken returns a random token other than the native token
+func randomIssuedToken(r *rand.Rand, ctx sdk.Context, k token.Keeper) (*sdk.TokenInfoWithoutSupply, bool) {
+	var symbols []string
+	for _, symbol := range k.GetSymbols(ctx) {
+		if symbol != sdk.NativeToken {
+			symbols = append(symbols, symbol)
+		}
+	}
+	if len(symbols) == 0 {
+		return nil, false
+	}
+
+	ti := k.GetTokenInfoWithoutSupply(ctx, sdk.Symbol(symbols[r.Intn(len(symbols))]))
+	return ti, ti != nil
+}
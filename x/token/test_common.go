@@ -35,6 +35,7 @@ var TestTokenData = []sdk.TokenInfo{
 		IsSendEnabled: true,
 		Decimals:      8,
 		TotalSupply:   sdk.NewIntWithDecimal(21, 15),
+		MaxSupply:     sdk.ZeroInt(),
 	},
 	{
 		Symbol:        sdk.Symbol(BtcToken),
@@ -42,6 +43,7 @@ var TestTokenData = []sdk.TokenInfo{
 		IsSendEnabled: true,
 		Decimals:      8,
 		TotalSupply:   sdk.NewIntWithDecimal(21, 15),
+		MaxSupply:     sdk.ZeroInt(),
 	},
 	{
 		Symbol:        sdk.Symbol(EthToken),
@@ -49,6 +51,7 @@ var TestTokenData = []sdk.TokenInfo{
 		IsSendEnabled: true,
 		Decimals:      18,
 		TotalSupply:   sdk.NewInt(0),
+		MaxSupply:     sdk.ZeroInt(),
 	},
 	{
 		Symbol:        sdk.Symbol(UsdtToken),
@@ -56,6 +59,7 @@ var TestTokenData = []sdk.TokenInfo{
 		IsSendEnabled: true,
 		Decimals:      18,
 		TotalSupply:   sdk.NewIntWithDecimal(1, 28),
+		MaxSupply:     sdk.ZeroInt(),
 	},
 }
 
@@ -102,6 +106,7 @@ func setupTestEnv(t *testing.T) testEnv {
 
 	//register cdc
 	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	staking.RegisterCodec(cdc)
 	auth.RegisterCodec(cdc)
@@ -125,11 +130,13 @@ func setupTestEnv(t *testing.T) testEnv {
 	blacklistedAddrs[notBondedPool.String()] = true
 	blacklistedAddrs[bondPool.String()] = true
 	blacklistedAddrs[distrAcc.String()] = true
+	blacklistedAddrs[supply.NewModuleAddress(types.ModuleName).String()] = true
+	blacklistedAddrs[supply.NewModuleAddress(types.AirdropAccountName).String()] = true
 
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
 	pk := params.NewKeeper(cdc, keyParams, tkeyParams, params.DefaultCodespace)
 	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
-	bankKeeper := bank.NewBaseKeeper(accountKeeper, nil, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, nil, nil, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace, blacklistedAddrs)
 	bankKeeper.SetSendEnabled(ctx, true)
 
 	maccPerms := map[string][]string{
@@ -139,6 +146,7 @@ func setupTestEnv(t *testing.T) testEnv {
 		staking.BondedPoolName:    []string{supply.Burner, supply.Staking},
 		mint.ModuleName:           []string{supply.Minter},
 		types.ModuleName:          {supply.Minter, supply.Burner},
+		types.AirdropAccountName:  nil,
 	}
 	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
 
@@ -160,7 +168,7 @@ func setupTestEnv(t *testing.T) testEnv {
 	distrKeeper := distr.NewKeeper(cdc, keyDistr, pk.Subspace(distr.DefaultParamspace), stakingKeeper, supplyKeeper, distr.DefaultCodespace, auth.FeeCollectorName, nil)
 	mintKeeper := mint.NewKeeper(cdc, keyMint, pk.Subspace(mint.DefaultParamspace), stakingKeeper, supplyKeeper, auth.FeeCollectorName)
 
-	tokenKeeper := NewKeeper(cdc, keyToken, distrKeeper, supplyKeeper, pk.Subspace(DefaultParamspace))
+	tokenKeeper := NewKeeper(cdc, keyToken, accountKeeper, distrKeeper, supplyKeeper, pk.Subspace(DefaultParamspace), blacklistedAddrs)
 	tokenKeeper.SetParams(ctx, DefaultParams())
 
 	//set native token
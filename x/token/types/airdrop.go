@@ -0,0 +1,170 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+
+	sdk "github.com/pocblockchain/pocc/types"
+)
+
+// DefaultStartingAirdropID is the ID of the first airdrop
+const DefaultStartingAirdropID uint64 = 1
+
+// Airdrop defines an amount of a token locked in the airdrop module account by its issuer,
+// the recipients committed to by the merkle root can claim their share until the deadline,
+// after which the unclaimed remaining amount returns to the issuer
+type Airdrop struct {
+	AirdropID  uint64         `json:"airdrop_id" yaml:"airdrop_id"`
+	Issuer     sdk.AccAddress `json:"issuer" yaml:"issuer"`
+	Amount     sdk.Coin       `json:"amount" yaml:"amount"`
+	Remaining  sdk.Int        `json:"remaining" yaml:"remaining"`
+	MerkleRoot cmn.HexBytes   `json:"merkle_root" yaml:"merkle_root"`
+	Recipients uint64         `json:"recipients" yaml:"recipients"` //number of leaves of the merkle tree
+	Deadline   time.Time      `json:"deadline" yaml:"deadline"`
+}
+
+// NewAirdrop creates a new Airdrop instance, nothing of which is claimed yet
+func NewAirdrop(airdropID uint64, issuer sdk.AccAddress, amount sdk.Coin, merkleRoot []byte, recipients uint64, deadline time.Time) Airdrop {
+	return Airdrop{
+		AirdropID:  airdropID,
+		Issuer:     issuer,
+		Amount:     amount,
+		Remaining:  amount.Amount,
+		MerkleRoot: merkleRoot,
+		Recipients: recipients,
+		Deadline:   deadline,
+	}
+}
+
+// IsExpired returns whether the airdrop deadline has passed at blockTime
+func (a Airdrop) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(a.Deadline)
+}
+
+// RemainingCoins returns the unclaimed coins of the airdrop
+func (a Airdrop) RemainingCoins() sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(a.Amount.Denom, a.Remaining))
+}
+
+// Validate performs a stateless validation of the airdrop
+func (a Airdrop) Validate() error {
+	if a.Issuer.Empty() {
+		return fmt.Errorf("airdrop %d: missing issuer", a.AirdropID)
+	}
+	if !a.Amount.IsValid() || !a.Amount.IsPositive() {
+		return fmt.Errorf("airdrop %d: invalid amount %s", a.AirdropID, a.Amount)
+	}
+	if a.Remaining.IsNegative() || a.Remaining.GT(a.Amount.Amount) {
+		return fmt.Errorf("airdrop %d: invalid remaining %s of %s", a.AirdropID, a.Remaining, a.Amount)
+	}
+	if len(a.MerkleRoot) != tmhash.Size {
+		return fmt.Errorf("airdrop %d: merkle root length %d != %d", a.AirdropID, len(a.MerkleRoot), tmhash.Size)
+	}
+	if a.Recipients == 0 {
+		return fmt.Errorf("airdrop %d: no recipients", a.AirdropID)
+	}
+	if a.Deadline.IsZero() {
+		return fmt.Errorf("airdrop %d: missing deadline", a.AirdropID)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (a Airdrop) String() string {
+	return fmt.Sprintf(`Airdrop %d:
+  Issuer:      %s
+  Amount:      %s
+  Remaining:   %s
+  Merkle Root: %s
+  Recipients:  %d
+  Deadline:    %s`, a.AirdropID, a.Issuer, a.Amount, a.Remaining, a.MerkleRoot, a.Recipients, a.Deadline)
+}
+
+// Airdrops is an array of airdrops
+type Airdrops []Airdrop
+
+// String implements the Stringer interface
+func (as Airdrops) String() string {
+	if len(as) == 0 {
+		return "[]"
+	}
+
+	out := make([]string, len(as))
+	for i, a := range as {
+		out[i] = a.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// Total returns the sum of the remaining amounts
+func (as Airdrops) Total() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, a := range as {
+		total = total.Add(a.RemainingCoins())
+	}
+	return total
+}
+
+// AirdropClaim records that the leaf at Index of an airdrop has been claimed
+type AirdropClaim struct {
+	AirdropID uint64 `json:"airdrop_id" yaml:"airdrop_id"`
+	Index     uint64 `json:"index" yaml:"index"`
+}
+
+// NewAirdropClaim creates a new AirdropClaim instance
+func NewAirdropClaim(airdropID, index uint64) AirdropClaim {
+	return AirdropClaim{AirdropID: airdropID, Index: index}
+}
+
+// AirdropEntry is the recipient and amount committed to by a leaf of an airdrop merkle tree
+type AirdropEntry struct {
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Amount    sdk.Int        `json:"amount" yaml:"amount"`
+}
+
+// NewAirdropEntry creates a new AirdropEntry instance
+func NewAirdropEntry(recipient sdk.AccAddress, amount sdk.Int) AirdropEntry {
+	return AirdropEntry{Recipient: recipient, Amount: amount}
+}
+
+// Leaf returns the leaf of the entry in the airdrop merkle tree
+func (e AirdropEntry) Leaf() []byte {
+	return []byte(fmt.Sprintf("%s:%s", e.Recipient, e.Amount))
+}
+
+// BuildAirdropTree returns the merkle root of entries, which an issuer locks the airdrop with,
+// and the proof of every entry, the aunts of which recipients claim their amounts with
+func BuildAirdropTree(entries []AirdropEntry) ([]byte, []*merkle.SimpleProof) {
+	leaves := make([][]byte, len(entries))
+	for i, e := range entries {
+		leaves[i] = e.Leaf()
+	}
+	return merkle.SimpleProofsFromByteSlices(leaves)
+}
+
+// VerifyAirdropProof returns whether aunts prove entry to be the leaf at index of the
+// merkle tree of recipients leaves whose root is merkleRoot
+func VerifyAirdropProof(merkleRoot []byte, recipients, index uint64, entry AirdropEntry, aunts [][]byte) bool {
+	if index >= recipients {
+		return false
+	}
+
+	proof := merkle.SimpleProof{
+		Total:    int(recipients),
+		Index:    int(index),
+		LeafHash: airdropLeafHash(entry.Leaf()),
+		Aunts:    aunts,
+	}
+	return bytes.Equal(merkleRoot, proof.ComputeRootHash())
+}
+
+// airdropLeafHash hashes a leaf the way the tendermint simple merkle tree does
+func airdropLeafHash(leaf []byte) []byte {
+	return tmhash.Sum(append([]byte{0}, leaf...))
+}
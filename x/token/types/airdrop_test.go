@@ -0,0 +1,90 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAirdropProof(t *testing.T) {
+	entries := []AirdropEntry{
+		NewAirdropEntry(sdk.AccAddress([]byte("alice")), sdk.NewInt(100)),
+		NewAirdropEntry(sdk.AccAddress([]byte("bob")), sdk.NewInt(200)),
+		NewAirdropEntry(sdk.AccAddress([]byte("carol")), sdk.NewInt(300)),
+	}
+	root, proofs := BuildAirdropTree(entries)
+	require.Equal(t, 3, len(proofs))
+
+	for i, e := range entries {
+		require.True(t, VerifyAirdropProof(root, 3, uint64(i), e, proofs[i].Aunts))
+	}
+
+	//wrong amount, index and aunts
+	require.False(t, VerifyAirdropProof(root, 3, 0, NewAirdropEntry(entries[0].Recipient, sdk.NewInt(101)), proofs[0].Aunts))
+	require.False(t, VerifyAirdropProof(root, 3, 1, entries[0], proofs[0].Aunts))
+	require.False(t, VerifyAirdropProof(root, 3, 3, entries[0], proofs[0].Aunts))
+	require.False(t, VerifyAirdropProof(root, 3, 0, entries[0], proofs[1].Aunts))
+
+	//a single recipient has no aunts
+	root, proofs = BuildAirdropTree(entries[:1])
+	require.Equal(t, 0, len(proofs[0].Aunts))
+	require.True(t, VerifyAirdropProof(root, 1, 0, entries[0], nil))
+}
+
+func TestMsgCreateAirdropValidation(t *testing.T) {
+	addr := sdk.AccAddress([]byte("from"))
+	root := make([]byte, 32)
+	amount := sdk.NewCoin("bhd", sdk.NewInt(100))
+	deadline := time.Unix(1000, 0).UTC()
+
+	cases := []struct {
+		valid bool
+		msg   MsgCreateAirdrop
+	}{
+		{true, NewMsgCreateAirdrop(addr, amount, root, 2, deadline)},
+		{false, NewMsgCreateAirdrop(nil, amount, root, 2, deadline)},
+		{false, NewMsgCreateAirdrop(addr, sdk.NewCoin("bhd", sdk.ZeroInt()), root, 2, deadline)},
+		{false, NewMsgCreateAirdrop(addr, sdk.NewCoin(sdk.NativeToken, sdk.NewInt(100)), root, 2, deadline)},
+		{false, NewMsgCreateAirdrop(addr, amount, root[:31], 2, deadline)},
+		{false, NewMsgCreateAirdrop(addr, amount, root, 0, deadline)},
+		{false, NewMsgCreateAirdrop(addr, amount, root, 2, time.Time{})},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+	require.Equal(t, TypeMsgCreateAirdrop, cases[0].msg.Type())
+}
+
+func TestMsgClaimAirdropValidation(t *testing.T) {
+	addr := sdk.AccAddress([]byte("from"))
+	aunt := make([]byte, 32)
+
+	cases := []struct {
+		valid bool
+		msg   MsgClaimAirdrop
+	}{
+		{true, NewMsgClaimAirdrop(addr, 1, 0, sdk.NewInt(100), [][]byte{aunt})},
+		{true, NewMsgClaimAirdrop(addr, 1, 0, sdk.NewInt(100), nil)},
+		{false, NewMsgClaimAirdrop(nil, 1, 0, sdk.NewInt(100), nil)},
+		{false, NewMsgClaimAirdrop(addr, 1, 0, sdk.ZeroInt(), nil)},
+		{false, NewMsgClaimAirdrop(addr, 1, 0, sdk.NewInt(100), [][]byte{aunt[:31]})},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "case %d", i)
+		} else {
+			require.NotNil(t, err, "case %d", i)
+		}
+	}
+	require.Equal(t, TypeMsgClaimAirdrop, cases[0].msg.Type())
+}
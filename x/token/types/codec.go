@@ -13,9 +13,24 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(sdk.TokenInfoWithoutSupply{}, "poc/token/TokenInfoWithoutSupply", nil)
 	cdc.RegisterConcrete(TokenParamsChangeProposal{}, "poc/token/TokenParamsChangeProposal", nil)
 	cdc.RegisterConcrete(DisableTokenProposal{}, "poc/token/DisableTokenProposal", nil)
+	cdc.RegisterConcrete(FeeTokenProposal{}, "poc/token/FeeTokenProposal", nil)
+	cdc.RegisterConcrete(EnableTokenProposal{}, "poc/token/EnableTokenProposal", nil)
+	cdc.RegisterConcrete(DelistTokenProposal{}, "poc/token/DelistTokenProposal", nil)
+	cdc.RegisterConcrete(ReserveSymbolProposal{}, "poc/token/ReserveSymbolProposal", nil)
+	cdc.RegisterConcrete(UnreserveSymbolProposal{}, "poc/token/UnreserveSymbolProposal", nil)
 	cdc.RegisterConcrete(MsgNewToken{}, "poc/token/MsgNewToken", nil)
 	cdc.RegisterConcrete(MsgBurnToken{}, "poc/token/MsgBurnToken", nil)
 	cdc.RegisterConcrete(MsgInflateToken{}, "poc/token/MsgInflateToken", nil)
+	cdc.RegisterConcrete(MsgTransferTokenOwnership{}, "poc/token/MsgTransferTokenOwnership", nil)
+	cdc.RegisterConcrete(MsgAcceptTokenOwnership{}, "poc/token/MsgAcceptTokenOwnership", nil)
+	cdc.RegisterConcrete(MsgRenounceTokenMint{}, "poc/token/MsgRenounceTokenMint", nil)
+	cdc.RegisterConcrete(MsgEditTokenMetadata{}, "poc/token/MsgEditTokenMetadata", nil)
+	cdc.RegisterConcrete(MsgFreezeAccount{}, "poc/token/MsgFreezeAccount", nil)
+	cdc.RegisterConcrete(MsgUnfreezeAccount{}, "poc/token/MsgUnfreezeAccount", nil)
+	cdc.RegisterConcrete(MsgPauseToken{}, "poc/token/MsgPauseToken", nil)
+	cdc.RegisterConcrete(MsgUnpauseToken{}, "poc/token/MsgUnpauseToken", nil)
+	cdc.RegisterConcrete(MsgCreateAirdrop{}, "poc/token/MsgCreateAirdrop", nil)
+	cdc.RegisterConcrete(MsgClaimAirdrop{}, "poc/token/MsgClaimAirdrop", nil)
 
 }
 
@@ -8,13 +8,29 @@ import (
 type CodeType = sdk.CodeType
 
 const (
-	DefaultCodespace   sdk.CodespaceType = "token"
-	CodeInvalidInput   CodeType          = 103
-	CodeEmptyData      CodeType          = 104
-	CodeDuplicatedKey  CodeType          = 105
-	CodeInvalidSymbol  CodeType          = 106
-	CodeNonExistSymbol CodeType          = 107
-	CodeSymbolReserved CodeType          = 108
+	DefaultCodespace     sdk.CodespaceType = "token"
+	CodeInvalidInput     CodeType          = 103
+	CodeEmptyData        CodeType          = 104
+	CodeDuplicatedKey    CodeType          = 105
+	CodeInvalidSymbol    CodeType          = 106
+	CodeNonExistSymbol   CodeType          = 107
+	CodeSymbolReserved   CodeType          = 108
+	CodeMintRenounced    CodeType          = 109
+	CodeNoPendingOwner   CodeType          = 110
+	CodeNotMintable      CodeType          = 111
+	CodeNotBurnable      CodeType          = 112
+	CodeExceedMaxSupply  CodeType          = 113
+	CodeInvalidMetadata  CodeType          = 114
+	CodeNotFreezable     CodeType          = 115
+	CodeAccountFrozen    CodeType          = 116
+	CodeAccountNotFrozen CodeType          = 117
+	CodeInvalidFeeToken  CodeType          = 118
+	CodeInvalidStatus    CodeType          = 119
+	CodeNotPausable      CodeType          = 120
+	CodeNotReserved      CodeType          = 121
+	CodeInvalidAirdrop   CodeType          = 122
+	CodeUnknownAirdrop   CodeType          = 123
+	CodeAirdropClaimed   CodeType          = 124
 )
 
 // ErrEmptyKey returns an error for when an empty key is given.
@@ -51,3 +67,103 @@ func ErrNonExistSymbol(symbol string) sdk.Error {
 func ErrSymbolReserved(msg string) sdk.Error {
 	return sdk.NewError(DefaultCodespace, CodeSymbolReserved, msg)
 }
+
+// ErrMintRenounced returns an error for when inflating a token whose mint right was renounced
+func ErrMintRenounced(symbol string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeMintRenounced, "mint of %v has been renounced", symbol)
+}
+
+// ErrNoPendingOwner returns an error for when accepting a token not being transferred to the sender
+func ErrNoPendingOwner(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNoPendingOwner, msg)
+}
+
+// ErrNotMintable returns an error for when inflating a token which is not mintable
+func ErrNotMintable(symbol string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNotMintable, "%v is not mintable", symbol)
+}
+
+// ErrNotBurnable returns an error for when burning a token which is not burnable
+func ErrNotBurnable(symbol string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNotBurnable, "%v is not burnable", symbol)
+}
+
+// ErrExceedMaxSupply returns an error for when total supply would exceed the token's max supply
+func ErrExceedMaxSupply(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeExceedMaxSupply, msg)
+}
+
+// ErrInvalidMetadata returns an error for when a token's metadata is invalid
+func ErrInvalidMetadata(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidMetadata, msg)
+}
+
+// ErrNotFreezable returns an error for when freezing an account of a token which is not freezable
+func ErrNotFreezable(symbol string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNotFreezable, "%v is not freezable", symbol)
+}
+
+// ErrAccountFrozen returns an error for when an account's balance of a token is already frozen
+func ErrAccountFrozen(symbol string, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeAccountFrozen, "%v of %v is already frozen", symbol, addr)
+}
+
+// ErrAccountNotFrozen returns an error for when unfreezing an account which is not frozen
+func ErrAccountNotFrozen(symbol string, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeAccountNotFrozen, "%v of %v is not frozen", symbol, addr)
+}
+
+// ErrInvalidFeeTokenRate returns an error for when a fee token's rate is invalid
+func ErrInvalidFeeTokenRate(symbol string, rate sdk.Dec) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidFeeToken, "fee rate %v of %v is invalid", rate, symbol)
+}
+
+// ErrNotFeeToken returns an error for when a token is not accepted as transaction fee
+func ErrNotFeeToken(symbol string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidFeeToken, "%v is not a fee token", symbol)
+}
+
+// ErrInvalidStatusTransition returns an error for when a token can not move from its current status to the given one
+func ErrInvalidStatusTransition(symbol string, from, to sdk.TokenStatus) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidStatus, "%v can not change status from %v to %v", symbol, from, to)
+}
+
+// ErrStatusNotAllowed returns an error for when an operation is not allowed in the token's current status
+func ErrStatusNotAllowed(symbol string, status sdk.TokenStatus, operation string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidStatus, "%v is not allowed to %v in status %v", symbol, operation, status)
+}
+
+// ErrNotPausable returns an error for when pausing a token which is not pausable
+func ErrNotPausable(symbol string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNotPausable, "%v is not pausable", symbol)
+}
+
+// ErrNotPausedByIssuer returns an error for when the issuer unpauses a token it did not pause
+func ErrNotPausedByIssuer(symbol string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidStatus, "%v is not paused by its issuer", symbol)
+}
+
+// ErrSymbolNotReserved returns an error for when removing the reservation of a symbol which is not reserved
+func ErrSymbolNotReserved(symbol string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeNotReserved, "%v is not reserved", symbol)
+}
+
+// ErrInvalidAirdrop returns an error for when an airdrop is invalid
+func ErrInvalidAirdrop(msg string) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidAirdrop, "invalid airdrop: %v", msg)
+}
+
+// ErrInvalidAirdropProof returns an error for when a claim does not match the merkle root of an airdrop
+func ErrInvalidAirdropProof(airdropID, index uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeInvalidAirdrop, "invalid proof of leaf %d of airdrop %d", index, airdropID)
+}
+
+// ErrUnknownAirdrop returns an error for when an airdrop does not exist
+func ErrUnknownAirdrop(airdropID uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeUnknownAirdrop, "airdrop %d does not exist", airdropID)
+}
+
+// ErrAirdropClaimed returns an error for when a leaf of an airdrop is claimed again
+func ErrAirdropClaimed(airdropID, index uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeAirdropClaimed, "leaf %d of airdrop %d is already claimed", index, airdropID)
+}
@@ -3,9 +3,27 @@ package types
 const (
 	EventTypeExecuteTokenParamsChangeProposal = "execute_token_params_change_proposal"
 	EventTypeExecuteDisableTokenProposal      = "execute_disable_token_proposal"
+	EventTypeExecuteFeeTokenProposal          = "execute_fee_token_proposal"
+	EventTypeExecuteEnableTokenProposal       = "execute_enable_token_proposal"
+	EventTypeExecuteDelistTokenProposal       = "execute_delist_token_proposal"
+	EventTypeExecuteReserveSymbolProposal     = "execute_reserve_symbol_proposal"
+	EventTypeExecuteUnreserveSymbolProposal   = "execute_unreserve_symbol_proposal"
 	EventTypeNewToken                         = "new_token"
 	EventTypeBurnToken                        = "burn_token"
 	EventTypeInflateToken                     = "inflate_token"
+	EventTypeTransferTokenOwnership           = "transfer_token_ownership"
+	EventTypeAcceptTokenOwnership             = "accept_token_ownership"
+	EventTypeRenounceTokenMint                = "renounce_token_mint"
+	EventTypeEditTokenMetadata                = "edit_token_metadata"
+	EventTypeFreezeAccount                    = "freeze_account"
+	EventTypeUnfreezeAccount                  = "unfreeze_account"
+	EventTypePauseToken                       = "pause_token"
+	EventTypeUnpauseToken                     = "unpause_token"
+	EventTypeClaimSymbol                      = "claim_symbol"
+	EventTypeCreateAirdrop                    = "create_airdrop"
+	EventTypeClaimAirdrop                     = "claim_airdrop"
+	EventTypeRefundAirdrop                    = "refund_airdrop"
+	EventTypeRefundAirdropFailed              = "refund_airdrop_failed"
 
 	AttributeKeyTokenParam      = "param"
 	AttributeKeyTokenParamValue = "value"
@@ -16,6 +34,19 @@ const (
 	AttributeKeyAmount          = "amount"
 	AttributeKeyIssueFee        = "issue_fee"
 	AttributeKeyBurner          = "burner"
+	AttributeKeyNewIssuer       = "new_issuer"
+	AttributeKeyPendingIssuer   = "pending_issuer"
+	AttributeKeyMaxSupply       = "max_supply"
+	AttributeKeyTarget          = "target"
+	AttributeKeyFeeRate         = "fee_rate"
+	AttributeKeyStatus          = "status"
+	AttributeKeySuccessor       = "successor"
+	AttributeKeyClaimant        = "claimant"
+	AttributeKeyExpireTime      = "expire_time"
+	AttributeKeyAirdropID       = "airdrop_id"
+	AttributeKeyAirdropIndex    = "index"
+	AttributeKeyDeadline        = "deadline"
+	AttributeKeyReason          = "reason"
 
 	AttributeValueCategory = ModuleName
 )
@@ -13,14 +13,37 @@ const (
 	// Parameter store default paramter store
 	DefaultParamspace = ModuleName
 
+	// AirdropAccountName is the name of the module account holding the unclaimed airdrops
+	AirdropAccountName = "airdrop"
+
 	// query endpoints supported by the nameservice Querier
-	QueryToken      = "token"
-	QuerySymbols    = "symbols"
-	QueryTokens     = "tokens"
-	QueryParameters = "parameters"
+	QueryToken          = "token"
+	QuerySymbols        = "symbols"
+	QueryTokens         = "tokens"
+	QueryParameters     = "parameters"
+	QueryMetadata       = "metadata"
+	QueryFrozenAccounts = "frozen_accounts"
+	QueryFeeTokens      = "fee_tokens"
+	QueryTokensByStatus = "tokens_by_status"
+	QueryTransitions    = "status_transitions"
+	QueryReservations   = "reservations"
+	QueryAirdrop        = "airdrop"
+	QueryAirdrops       = "airdrops"
+	QueryAirdropClaim   = "airdrop_claim"
 
 	// MsgNewToken
 	TypeMsgNewToken     = "new"
 	TypeMsgInflateToken = "inflate"
 	TypeMsgBurnToken    = "burn"
+
+	TypeMsgTransferTokenOwnership = "transfer_ownership"
+	TypeMsgAcceptTokenOwnership   = "accept_ownership"
+	TypeMsgRenounceTokenMint      = "renounce_mint"
+	TypeMsgEditTokenMetadata      = "edit_metadata"
+	TypeMsgFreezeAccount          = "freeze_account"
+	TypeMsgUnfreezeAccount        = "unfreeze_account"
+	TypeMsgPauseToken             = "pause_token"
+	TypeMsgUnpauseToken           = "unpause_token"
+	TypeMsgCreateAirdrop          = "create_airdrop"
+	TypeMsgClaimAirdrop           = "claim_airdrop"
 )
@@ -3,13 +3,14 @@ package types
 import (
 	sdk "github.com/pocblockchain/pocc/types"
 	"github.com/stretchr/testify/require"
+	"strings"
 	"testing"
 )
 
 func TestMsgNewTokenRouteAndType(t *testing.T) {
 	addr1 := sdk.AccAddress([]byte("from"))
 	addr2 := sdk.AccAddress([]byte("to"))
-	var msg = NewMsgNewToken(addr1, addr2, "btc", 8, sdk.NewInt(10000))
+	var msg = NewMsgNewToken(addr1, addr2, "btc", 8, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 
 	require.Equal(t, msg.Route(), RouterKey)
 	require.Equal(t, msg.Type(), TypeMsgNewToken)
@@ -20,49 +21,60 @@ func TestMsgNewTokenValidation(t *testing.T) {
 	addr2 := sdk.AccAddress([]byte("to"))
 
 	//from address is empty
-	msg := NewMsgNewToken(nil, addr2, "btc", 8, sdk.NewInt(10000))
+	msg := NewMsgNewToken(nil, addr2, "btc", 8, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
 
-	msg = NewMsgNewToken(sdk.AccAddress(nil), addr2, "btc", 8, sdk.NewInt(10000))
+	msg = NewMsgNewToken(sdk.AccAddress(nil), addr2, "btc", 8, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
 
-	msg = NewMsgNewToken(sdk.AccAddress{}, addr2, "btc", 8, sdk.NewInt(10000))
+	msg = NewMsgNewToken(sdk.AccAddress{}, addr2, "btc", 8, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
 
 	//to address is empty
-	msg = NewMsgNewToken(addr1, nil, "btc", 8, sdk.NewInt(10000))
+	msg = NewMsgNewToken(addr1, nil, "btc", 8, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
 
-	msg = NewMsgNewToken(addr1, sdk.AccAddress(nil), "btc", 8, sdk.NewInt(10000))
+	msg = NewMsgNewToken(addr1, sdk.AccAddress(nil), "btc", 8, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
 
-	msg = NewMsgNewToken(addr1, sdk.AccAddress{}, "btc", 8, sdk.NewInt(10000))
+	msg = NewMsgNewToken(addr1, sdk.AccAddress{}, "btc", 8, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
 
 	//too much precision
-	msg = NewMsgNewToken(addr1, addr2, "btc", 19, sdk.NewInt(10000))
+	msg = NewMsgNewToken(addr1, addr2, "btc", 19, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	require.Equal(t, sdk.CodeTooMuchPrecision, msg.ValidateBasic().Code())
 
 	//total supply is negative
-	msg = NewMsgNewToken(addr1, addr2, "btc", 8, sdk.NewInt(-10000))
+	msg = NewMsgNewToken(addr1, addr2, "btc", 8, sdk.NewInt(-10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	require.Equal(t, sdk.CodeInvalidAmount, msg.ValidateBasic().Code())
 
+	//max supply is negative
+	msg = NewMsgNewToken(addr1, addr2, "btc", 8, sdk.NewInt(10000), sdk.NewInt(-1), true, true, false, false, sdk.TokenMetadata{})
+	require.Equal(t, sdk.CodeInvalidAmount, msg.ValidateBasic().Code())
+
+	//total supply exceeds max supply
+	msg = NewMsgNewToken(addr1, addr2, "btc", 8, sdk.NewInt(10000), sdk.NewInt(9999), true, true, false, false, sdk.TokenMetadata{})
+	require.Equal(t, sdk.CodeInvalidAmount, msg.ValidateBasic().Code())
+
+	msg = NewMsgNewToken(addr1, addr2, "btc", 8, sdk.NewInt(10000), sdk.NewInt(10000), true, true, false, false, sdk.TokenMetadata{})
+	require.Nil(t, msg.ValidateBasic())
+
 }
 
 func TestMsgNewTokenGetSignBytes(t *testing.T) {
 	addr1 := sdk.AccAddress([]byte("from"))
 	addr2 := sdk.AccAddress([]byte("to"))
-	msg := NewMsgNewToken(addr1, addr2, "btc", 8, sdk.NewInt(10000))
+	msg := NewMsgNewToken(addr1, addr2, "btc", 8, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	res := msg.GetSignBytes()
 
-	expected := `{"type":"poc/token/MsgNewToken","value":{"decimals":"8","from":"poc1veex7mg3y9476","symbol":"btc","to":"poc1w3hssmamea","total_supply":"10000"}}`
+	expected := `{"type":"poc/token/MsgNewToken","value":{"burnable":true,"decimals":"8","freezable":false,"from":"poc1veex7mg3y9476","max_supply":"0","metadata":{"description":"","logo_uri":"","name":"","website":""},"mintable":true,"pausable":false,"symbol":"btc","to":"poc1w3hssmamea","total_supply":"10000"}}`
 	require.Equal(t, expected, string(res))
 }
 
 func TestMsgNewTokenGetSigners(t *testing.T) {
 	addr1 := sdk.AccAddress([]byte("from"))
 	addr2 := sdk.AccAddress([]byte("to"))
-	msg := NewMsgNewToken(addr1, addr2, "btc", 8, sdk.NewInt(10000))
+	msg := NewMsgNewToken(addr1, addr2, "btc", 8, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false, sdk.TokenMetadata{})
 	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
 
 }
@@ -177,3 +189,142 @@ func TestMsgBrunTokenGetSigners(t *testing.T) {
 
 	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
 }
+
+func TestMsgTransferTokenOwnership(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+
+	msg := NewMsgTransferTokenOwnership(addr1, addr2, "btc", true)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgTransferTokenOwnership, msg.Type())
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	expected := `{"type":"poc/token/MsgTransferTokenOwnership","value":{"from":"poc1veex7mg3y9476","require_acceptance":true,"symbol":"btc","to":"poc1w3hssmamea"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+
+	msg = NewMsgTransferTokenOwnership(nil, addr2, "btc", true)
+	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
+
+	msg = NewMsgTransferTokenOwnership(addr1, nil, "btc", true)
+	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
+
+	msg = NewMsgTransferTokenOwnership(addr1, addr1, "btc", true)
+	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
+
+	msg = NewMsgTransferTokenOwnership(addr1, addr2, "BTC", true)
+	require.Equal(t, sdk.CodeInvalidSymbol, msg.ValidateBasic().Code())
+}
+
+func TestMsgAcceptTokenOwnership(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+
+	msg := NewMsgAcceptTokenOwnership(addr1, "btc")
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgAcceptTokenOwnership, msg.Type())
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	msg = NewMsgAcceptTokenOwnership(nil, "btc")
+	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
+
+	msg = NewMsgAcceptTokenOwnership(addr1, "BTC")
+	require.Equal(t, sdk.CodeInvalidSymbol, msg.ValidateBasic().Code())
+}
+
+func TestMsgRenounceTokenMint(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+
+	msg := NewMsgRenounceTokenMint(addr1, "btc")
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgRenounceTokenMint, msg.Type())
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	msg = NewMsgRenounceTokenMint(nil, "btc")
+	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
+
+	msg = NewMsgRenounceTokenMint(addr1, "BTC")
+	require.Equal(t, sdk.CodeInvalidSymbol, msg.ValidateBasic().Code())
+}
+
+func TestMsgEditTokenMetadata(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	keep := sdk.DoNotModifyTokenMetadata
+
+	msg := NewMsgEditTokenMetadata(addr1, "btc", sdk.NewTokenMetadata("Bitcoin", keep, keep, "https://bitcoin.org"))
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgEditTokenMetadata, msg.Type())
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	msg = NewMsgEditTokenMetadata(nil, "btc", sdk.NewTokenMetadata("Bitcoin", keep, keep, keep))
+	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
+
+	msg = NewMsgEditTokenMetadata(addr1, "BTC", sdk.NewTokenMetadata("Bitcoin", keep, keep, keep))
+	require.Equal(t, sdk.CodeInvalidSymbol, msg.ValidateBasic().Code())
+
+	//nothing to edit
+	msg = NewMsgEditTokenMetadata(addr1, "btc", sdk.NewTokenMetadata(keep, keep, keep, keep))
+	require.Equal(t, CodeInvalidMetadata, msg.ValidateBasic().Code())
+
+	//name too long
+	msg = NewMsgEditTokenMetadata(addr1, "btc", sdk.NewTokenMetadata(strings.Repeat("a", sdk.MaxTokenNameLength+1), keep, keep, keep))
+	require.Equal(t, CodeInvalidMetadata, msg.ValidateBasic().Code())
+
+	//MsgNewToken checks metadata length as well
+	newMsg := NewMsgNewToken(addr1, addr1, "btc", 8, sdk.NewInt(10000), sdk.ZeroInt(), true, true, false, false,
+		sdk.NewTokenMetadata("", strings.Repeat("a", sdk.MaxTokenDescriptionLength+1), "", ""))
+	require.Equal(t, CodeInvalidMetadata, newMsg.ValidateBasic().Code())
+}
+
+func TestMsgFreezeAccount(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+	addr2 := sdk.AccAddress([]byte("to"))
+
+	msg := NewMsgFreezeAccount(addr1, addr2, "btc")
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgFreezeAccount, msg.Type())
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	msg = NewMsgFreezeAccount(nil, addr2, "btc")
+	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
+
+	msg = NewMsgFreezeAccount(addr1, nil, "btc")
+	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
+
+	msg = NewMsgFreezeAccount(addr1, addr2, "BTC")
+	require.Equal(t, sdk.CodeInvalidSymbol, msg.ValidateBasic().Code())
+
+	unfreeze := NewMsgUnfreezeAccount(addr1, addr2, "btc")
+	require.Equal(t, TypeMsgUnfreezeAccount, unfreeze.Type())
+	require.Nil(t, unfreeze.ValidateBasic())
+
+	unfreeze = NewMsgUnfreezeAccount(addr1, nil, "btc")
+	require.Equal(t, sdk.CodeInvalidAddress, unfreeze.ValidateBasic().Code())
+}
+
+func TestMsgPauseToken(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("from"))
+
+	msg := NewMsgPauseToken(addr1, "btc")
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, TypeMsgPauseToken, msg.Type())
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, msg.GetSigners())
+
+	msg = NewMsgPauseToken(nil, "btc")
+	require.Equal(t, sdk.CodeInvalidAddress, msg.ValidateBasic().Code())
+
+	msg = NewMsgPauseToken(addr1, "BTC")
+	require.Equal(t, sdk.CodeInvalidSymbol, msg.ValidateBasic().Code())
+
+	unpause := NewMsgUnpauseToken(addr1, "btc")
+	require.Equal(t, TypeMsgUnpauseToken, unpause.Type())
+	require.Nil(t, unpause.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, unpause.GetSigners())
+
+	unpause = NewMsgUnpauseToken(nil, "btc")
+	require.Equal(t, sdk.CodeInvalidAddress, unpause.ValidateBasic().Code())
+}
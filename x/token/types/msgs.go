@@ -2,7 +2,11 @@ package types
 
 import (
 	"fmt"
+	"time"
+
 	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
 )
 
 var _ sdk.Msg = MsgNewToken{}
@@ -14,16 +18,30 @@ type MsgNewToken struct {
 	Symbol      sdk.Symbol     `json:"symbol" yaml:"symbol"`
 	Decimals    uint64         `json:"decimals" yaml:"decimals"`
 	TotalSupply sdk.Int        `json:"total_supply" yaml:"total_supply"`
+	MaxSupply   sdk.Int        `json:"max_supply" yaml:"max_supply"` //zero means no cap
+	Mintable    bool           `json:"mintable" yaml:"mintable"`
+	Burnable    bool           `json:"burnable" yaml:"burnable"`
+	Freezable   bool           `json:"freezable" yaml:"freezable"`
+	Pausable    bool           `json:"pausable" yaml:"pausable"`
+
+	Metadata sdk.TokenMetadata `json:"metadata" yaml:"metadata"`
 }
 
 //NewMsgNewToken is a constructor function for MsgTokenNew
-func NewMsgNewToken(from, to sdk.AccAddress, symbol string, decimals uint64, totalSupply sdk.Int) MsgNewToken {
+func NewMsgNewToken(from, to sdk.AccAddress, symbol string, decimals uint64, totalSupply, maxSupply sdk.Int, mintable, burnable,
+	freezable, pausable bool, metadata sdk.TokenMetadata) MsgNewToken {
 	return MsgNewToken{
 		From:        from,
 		To:          to,
 		Symbol:      sdk.Symbol(symbol),
 		Decimals:    decimals,
 		TotalSupply: totalSupply,
+		MaxSupply:   maxSupply,
+		Mintable:    mintable,
+		Burnable:    burnable,
+		Freezable:   freezable,
+		Pausable:    pausable,
+		Metadata:    metadata,
 	}
 }
 
@@ -52,6 +70,18 @@ func (msg MsgNewToken) ValidateBasic() sdk.Error {
 		return sdk.ErrInvalidAmount(fmt.Sprintf("totalSupply %v is not positive", msg.TotalSupply))
 	}
 
+	if msg.MaxSupply.IsNil() || msg.MaxSupply.IsNegative() {
+		return sdk.ErrInvalidAmount(fmt.Sprintf("maxSupply %v is negative", msg.MaxSupply))
+	}
+
+	if msg.MaxSupply.IsPositive() && msg.TotalSupply.GT(msg.MaxSupply) {
+		return sdk.ErrInvalidAmount(fmt.Sprintf("totalSupply %v exceeds maxSupply %v", msg.TotalSupply, msg.MaxSupply))
+	}
+
+	if err := msg.Metadata.EnsureLength(); err != nil {
+		return ErrInvalidMetadata(err.Error())
+	}
+
 	return nil
 }
 
@@ -163,3 +193,559 @@ func (msg MsgBurnToken) GetSignBytes() []byte {
 func (msg MsgBurnToken) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.From}
 }
+
+//__________________________________________________________
+var _ sdk.Msg = MsgTransferTokenOwnership{}
+
+// MsgTransferTokenOwnership hands a token's issuance rights to another address.
+// If RequireAcceptance is set, the new owner becomes effective only after it
+// sends a MsgAcceptTokenOwnership.
+type MsgTransferTokenOwnership struct {
+	From              sdk.AccAddress `json:"from" yaml:"from"`
+	To                sdk.AccAddress `json:"to" yaml:"to"`
+	Symbol            sdk.Symbol     `json:"symbol" yaml:"symbol"`
+	RequireAcceptance bool           `json:"require_acceptance" yaml:"require_acceptance"`
+}
+
+// NewMsgTransferTokenOwnership is a constructor function for MsgTransferTokenOwnership
+func NewMsgTransferTokenOwnership(from, to sdk.AccAddress, symbol string, requireAcceptance bool) MsgTransferTokenOwnership {
+	return MsgTransferTokenOwnership{
+		From:              from,
+		To:                to,
+		Symbol:            sdk.Symbol(symbol),
+		RequireAcceptance: requireAcceptance,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgTransferTokenOwnership) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgTransferTokenOwnership) Type() string { return TypeMsgTransferTokenOwnership }
+
+// ValidateBasic Implements Msg.
+func (msg MsgTransferTokenOwnership) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("from address can not be empty:%v", msg.From))
+	}
+
+	if msg.To.Empty() {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("to address can not be empty%v", msg.To))
+	}
+
+	if msg.From.Equals(msg.To) {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("%v already owns %v", msg.From, msg.Symbol))
+	}
+
+	if !msg.Symbol.IsValidTokenName() {
+		return sdk.ErrInvalidSymbol(fmt.Sprintf("symbol %v is invalid", msg.Symbol))
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgTransferTokenOwnership) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgTransferTokenOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+//__________________________________________________________
+var _ sdk.Msg = MsgAcceptTokenOwnership{}
+
+// MsgAcceptTokenOwnership is sent by the pending issuer to take over a token
+type MsgAcceptTokenOwnership struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	Symbol sdk.Symbol     `json:"symbol" yaml:"symbol"`
+}
+
+// NewMsgAcceptTokenOwnership is a constructor function for MsgAcceptTokenOwnership
+func NewMsgAcceptTokenOwnership(from sdk.AccAddress, symbol string) MsgAcceptTokenOwnership {
+	return MsgAcceptTokenOwnership{
+		From:   from,
+		Symbol: sdk.Symbol(symbol),
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgAcceptTokenOwnership) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgAcceptTokenOwnership) Type() string { return TypeMsgAcceptTokenOwnership }
+
+// ValidateBasic Implements Msg.
+func (msg MsgAcceptTokenOwnership) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("from address can not be empty:%v", msg.From))
+	}
+
+	if !msg.Symbol.IsValidTokenName() {
+		return sdk.ErrInvalidSymbol(fmt.Sprintf("symbol %v is invalid", msg.Symbol))
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgAcceptTokenOwnership) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgAcceptTokenOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+//__________________________________________________________
+var _ sdk.Msg = MsgRenounceTokenMint{}
+
+// MsgRenounceTokenMint gives up the right to inflate a token, it can not be undone
+type MsgRenounceTokenMint struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	Symbol sdk.Symbol     `json:"symbol" yaml:"symbol"`
+}
+
+// NewMsgRenounceTokenMint is a constructor function for MsgRenounceTokenMint
+func NewMsgRenounceTokenMint(from sdk.AccAddress, symbol string) MsgRenounceTokenMint {
+	return MsgRenounceTokenMint{
+		From:   from,
+		Symbol: sdk.Symbol(symbol),
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgRenounceTokenMint) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgRenounceTokenMint) Type() string { return TypeMsgRenounceTokenMint }
+
+// ValidateBasic Implements Msg.
+func (msg MsgRenounceTokenMint) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("from address can not be empty:%v", msg.From))
+	}
+
+	if !msg.Symbol.IsValidTokenName() {
+		return sdk.ErrInvalidSymbol(fmt.Sprintf("symbol %v is invalid", msg.Symbol))
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgRenounceTokenMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgRenounceTokenMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+//__________________________________________________________
+var _ sdk.Msg = MsgEditTokenMetadata{}
+
+// MsgEditTokenMetadata updates a token's metadata, fields set to
+// sdk.DoNotModifyTokenMetadata are left unchanged
+type MsgEditTokenMetadata struct {
+	From     sdk.AccAddress    `json:"from" yaml:"from"`
+	Symbol   sdk.Symbol        `json:"symbol" yaml:"symbol"`
+	Metadata sdk.TokenMetadata `json:"metadata" yaml:"metadata"`
+}
+
+// NewMsgEditTokenMetadata is a constructor function for MsgEditTokenMetadata
+func NewMsgEditTokenMetadata(from sdk.AccAddress, symbol string, metadata sdk.TokenMetadata) MsgEditTokenMetadata {
+	return MsgEditTokenMetadata{
+		From:     from,
+		Symbol:   sdk.Symbol(symbol),
+		Metadata: metadata,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgEditTokenMetadata) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgEditTokenMetadata) Type() string { return TypeMsgEditTokenMetadata }
+
+// ValidateBasic Implements Msg.
+func (msg MsgEditTokenMetadata) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("from address can not be empty:%v", msg.From))
+	}
+
+	if !msg.Symbol.IsValidTokenName() {
+		return sdk.ErrInvalidSymbol(fmt.Sprintf("symbol %v is invalid", msg.Symbol))
+	}
+
+	if msg.Metadata == (sdk.TokenMetadata{
+		Name:        sdk.DoNotModifyTokenMetadata,
+		Description: sdk.DoNotModifyTokenMetadata,
+		LogoURI:     sdk.DoNotModifyTokenMetadata,
+		Website:     sdk.DoNotModifyTokenMetadata,
+	}) {
+		return ErrInvalidMetadata("nothing to edit")
+	}
+
+	if err := msg.Metadata.EnsureLength(); err != nil {
+		return ErrInvalidMetadata(err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgEditTokenMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgEditTokenMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+//__________________________________________________________
+var _ sdk.Msg = MsgFreezeAccount{}
+
+// MsgFreezeAccount freezes Target's balance of the token, only the issuer can send it
+type MsgFreezeAccount struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	Target sdk.AccAddress `json:"target" yaml:"target"`
+	Symbol sdk.Symbol     `json:"symbol" yaml:"symbol"`
+}
+
+// NewMsgFreezeAccount is a constructor function for MsgFreezeAccount
+func NewMsgFreezeAccount(from, target sdk.AccAddress, symbol string) MsgFreezeAccount {
+	return MsgFreezeAccount{
+		From:   from,
+		Target: target,
+		Symbol: sdk.Symbol(symbol),
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgFreezeAccount) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgFreezeAccount) Type() string { return TypeMsgFreezeAccount }
+
+// ValidateBasic Implements Msg.
+func (msg MsgFreezeAccount) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("from address can not be empty:%v", msg.From))
+	}
+
+	if msg.Target.Empty() {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("target address can not be empty:%v", msg.Target))
+	}
+
+	if !msg.Symbol.IsValidTokenName() {
+		return sdk.ErrInvalidSymbol(fmt.Sprintf("symbol %v is invalid", msg.Symbol))
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgFreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+//__________________________________________________________
+var _ sdk.Msg = MsgUnfreezeAccount{}
+
+// MsgUnfreezeAccount unfreezes Target's balance of the token, only the issuer can send it
+type MsgUnfreezeAccount struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	Target sdk.AccAddress `json:"target" yaml:"target"`
+	Symbol sdk.Symbol     `json:"symbol" yaml:"symbol"`
+}
+
+// NewMsgUnfreezeAccount is a constructor function for MsgUnfreezeAccount
+func NewMsgUnfreezeAccount(from, target sdk.AccAddress, symbol string) MsgUnfreezeAccount {
+	return MsgUnfreezeAccount{
+		From:   from,
+		Target: target,
+		Symbol: sdk.Symbol(symbol),
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgUnfreezeAccount) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUnfreezeAccount) Type() string { return TypeMsgUnfreezeAccount }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUnfreezeAccount) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("from address can not be empty:%v", msg.From))
+	}
+
+	if msg.Target.Empty() {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("target address can not be empty:%v", msg.Target))
+	}
+
+	if !msg.Symbol.IsValidTokenName() {
+		return sdk.ErrInvalidSymbol(fmt.Sprintf("symbol %v is invalid", msg.Symbol))
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUnfreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+//__________________________________________________________
+var _ sdk.Msg = MsgPauseToken{}
+
+// MsgPauseToken stops all transfers of the token, only the issuer of a pausable token can send it
+type MsgPauseToken struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	Symbol sdk.Symbol     `json:"symbol" yaml:"symbol"`
+}
+
+// NewMsgPauseToken is a constructor function for MsgPauseToken
+func NewMsgPauseToken(from sdk.AccAddress, symbol string) MsgPauseToken {
+	return MsgPauseToken{
+		From:   from,
+		Symbol: sdk.Symbol(symbol),
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgPauseToken) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgPauseToken) Type() string { return TypeMsgPauseToken }
+
+// ValidateBasic Implements Msg.
+func (msg MsgPauseToken) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("from address can not be empty:%v", msg.From))
+	}
+
+	if !msg.Symbol.IsValidTokenName() {
+		return sdk.ErrInvalidSymbol(fmt.Sprintf("symbol %v is invalid", msg.Symbol))
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgPauseToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgPauseToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+//__________________________________________________________
+var _ sdk.Msg = MsgUnpauseToken{}
+
+// MsgUnpauseToken resumes transfers of a token paused by its issuer, only the issuer can send it
+type MsgUnpauseToken struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`
+	Symbol sdk.Symbol     `json:"symbol" yaml:"symbol"`
+}
+
+// NewMsgUnpauseToken is a constructor function for MsgUnpauseToken
+func NewMsgUnpauseToken(from sdk.AccAddress, symbol string) MsgUnpauseToken {
+	return MsgUnpauseToken{
+		From:   from,
+		Symbol: sdk.Symbol(symbol),
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgUnpauseToken) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUnpauseToken) Type() string { return TypeMsgUnpauseToken }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUnpauseToken) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("from address can not be empty:%v", msg.From))
+	}
+
+	if !msg.Symbol.IsValidTokenName() {
+		return sdk.ErrInvalidSymbol(fmt.Sprintf("symbol %v is invalid", msg.Symbol))
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUnpauseToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUnpauseToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+//__________________________________________________________
+var _ sdk.Msg = MsgCreateAirdrop{}
+
+// MsgCreateAirdrop locks an amount of a token in the airdrop module account, the recipients
+// committed to by the merkle root can claim it until the deadline, only the issuer can send it
+type MsgCreateAirdrop struct {
+	From       sdk.AccAddress `json:"from" yaml:"from"`
+	Amount     sdk.Coin       `json:"amount" yaml:"amount"`
+	MerkleRoot cmn.HexBytes   `json:"merkle_root" yaml:"merkle_root"`
+	Recipients uint64         `json:"recipients" yaml:"recipients"`
+	Deadline   time.Time      `json:"deadline" yaml:"deadline"`
+}
+
+// NewMsgCreateAirdrop is a constructor function for MsgCreateAirdrop
+func NewMsgCreateAirdrop(from sdk.AccAddress, amount sdk.Coin, merkleRoot []byte, recipients uint64, deadline time.Time) MsgCreateAirdrop {
+	return MsgCreateAirdrop{
+		From:       from,
+		Amount:     amount,
+		MerkleRoot: merkleRoot,
+		Recipients: recipients,
+		Deadline:   deadline,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgCreateAirdrop) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgCreateAirdrop) Type() string { return TypeMsgCreateAirdrop }
+
+// ValidateBasic Implements Msg.
+func (msg MsgCreateAirdrop) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("from address can not be empty:%v", msg.From))
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid airdrop amount %v", msg.Amount))
+	}
+
+	if msg.Amount.Denom == sdk.NativeToken {
+		return sdk.ErrInvalidSymbol("not allowed to airdrop native token")
+	}
+
+	if len(msg.MerkleRoot) != tmhash.Size {
+		return ErrInvalidAirdrop(fmt.Sprintf("merkle root length %d != %d", len(msg.MerkleRoot), tmhash.Size))
+	}
+
+	if msg.Recipients == 0 {
+		return ErrInvalidAirdrop("no recipients")
+	}
+
+	if msg.Deadline.IsZero() {
+		return ErrInvalidAirdrop("missing deadline")
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgCreateAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgCreateAirdrop) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+//__________________________________________________________
+var _ sdk.Msg = MsgClaimAirdrop{}
+
+// MsgClaimAirdrop claims the amount committed to the sender by the leaf at Index of an airdrop
+type MsgClaimAirdrop struct {
+	From      sdk.AccAddress `json:"from" yaml:"from"`
+	AirdropID uint64         `json:"airdrop_id" yaml:"airdrop_id"`
+	Index     uint64         `json:"index" yaml:"index"`
+	Amount    sdk.Int        `json:"amount" yaml:"amount"`
+	Proof     []cmn.HexBytes `json:"proof" yaml:"proof"` //aunts of the leaf in the merkle tree
+}
+
+// NewMsgClaimAirdrop is a constructor function for MsgClaimAirdrop
+func NewMsgClaimAirdrop(from sdk.AccAddress, airdropID, index uint64, amount sdk.Int, proof [][]byte) MsgClaimAirdrop {
+	aunts := make([]cmn.HexBytes, len(proof))
+	for i, aunt := range proof {
+		aunts[i] = aunt
+	}
+
+	return MsgClaimAirdrop{
+		From:      from,
+		AirdropID: airdropID,
+		Index:     index,
+		Amount:    amount,
+		Proof:     aunts,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgClaimAirdrop) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgClaimAirdrop) Type() string { return TypeMsgClaimAirdrop }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClaimAirdrop) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return sdk.ErrInvalidAddress(fmt.Sprintf("from address can not be empty:%v", msg.From))
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid claim amount %v", msg.Amount))
+	}
+
+	for _, aunt := range msg.Proof {
+		if len(aunt) != tmhash.Size {
+			return ErrInvalidAirdropProof(msg.AirdropID, msg.Index)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClaimAirdrop) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClaimAirdrop) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// Entry returns the airdrop entry claimed by the message
+func (msg MsgClaimAirdrop) Entry() AirdropEntry {
+	return NewAirdropEntry(msg.From, msg.Amount)
+}
+
+// Aunts returns the proof of the message as the aunts of a merkle proof
+func (msg MsgClaimAirdrop) Aunts() [][]byte {
+	aunts := make([][]byte, len(msg.Proof))
+	for i, aunt := range msg.Proof {
+		aunts[i] = aunt
+	}
+	return aunts
+}
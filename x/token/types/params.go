@@ -12,27 +12,48 @@ import (
 // Default parameter values
 const (
 	DefaultTokenCacheSize uint64 = 32 //cache size for token
+	DefaultFeeDestination        = FeeDestinationCommunityPool
+)
+
+// Destinations of the new token and inflate token fees
+const (
+	FeeDestinationCommunityPool = "community_pool" //added to the community pool
+	FeeDestinationBurn          = "burn"           //burned, reducing the supply of the native token
+	FeeDestinationFeeCollector  = "fee_collector"  //distributed to validators with the transaction fees
+)
+
+// symbol lengths accepted by the fee schedule, same as the token name
+const (
+	minSymbolLength = 3
+	maxSymbolLength = 16
 )
 
 var (
 	DefaultNewTokenFee     = sdk.TokensFromConsensusPower(1000) //1000poc
-	DefaultReservedSymbols = []string{"btc", "eth", "eos", "usdt", "bch", "bsv", "ltc", "bnb", "xrp", "okb", "ht", "dash", "etc", "neo", "atom", "zec", "ont", "doge", "tusd", "bat", "qtum", "vsys", "iost", "dcr", "zrx", "beam", "grin"}
+	DefaultInflateTokenFee = sdk.ZeroInt()                      //the issuer has paid when the token was issued
 )
 
 // Parameter keys
 var (
-	KeyTokenCacheSize  = []byte("TokenCacheSize")
-	KeyNewTokenFee     = []byte("NewTokenFee")
-	KeyReservedSymbols = []byte("ReservedSymbols")
+	KeyTokenCacheSize      = []byte("TokenCacheSize")
+	KeyNewTokenFee         = []byte("NewTokenFee")
+	KeyFeeTokens           = []byte("FeeTokens")
+	KeyNewTokenFeeSchedule = []byte("NewTokenFeeSchedule")
+	KeyInflateTokenFee     = []byte("InflateTokenFee")
+	KeyFeeDestination      = []byte("FeeDestination")
 )
 
 var _ params.ParamSet = &Params{}
 
 // Params defines the parameters for the auth module.
 type Params struct {
-	TokenCacheSize  uint64   `json:"token_cache_size"`
-	NewTokenFee     sdk.Int  `json:"new_token_fee"`
-	ReservedSymbols []string `json:"reserved_symbols"`
+	TokenCacheSize uint64     `json:"token_cache_size"`
+	NewTokenFee    sdk.Int    `json:"new_token_fee"`
+	FeeTokens      []FeeToken `json:"fee_tokens"`
+
+	NewTokenFeeSchedule []SymbolLengthFee `json:"new_token_fee_schedule"` //fees of short symbols, longer symbols pay NewTokenFee
+	InflateTokenFee     sdk.Int           `json:"inflate_token_fee"`
+	FeeDestination      string            `json:"fee_destination"` //where the new token and inflate token fees go
 }
 
 // ParamKeyTable for auth module
@@ -47,7 +68,10 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		{KeyTokenCacheSize, &p.TokenCacheSize},
 		{KeyNewTokenFee, &p.NewTokenFee},
-		{KeyReservedSymbols, &p.ReservedSymbols},
+		{KeyFeeTokens, &p.FeeTokens},
+		{KeyNewTokenFeeSchedule, &p.NewTokenFeeSchedule},
+		{KeyInflateTokenFee, &p.InflateTokenFee},
+		{KeyFeeDestination, &p.FeeDestination},
 	}
 }
 
@@ -63,8 +87,20 @@ func DefaultParams() Params {
 	return Params{
 		TokenCacheSize:  DefaultTokenCacheSize,
 		NewTokenFee:     DefaultNewTokenFee,
-		ReservedSymbols: DefaultReservedSymbols,
+		InflateTokenFee: DefaultInflateTokenFee,
+		FeeDestination:  DefaultFeeDestination,
+	}
+}
+
+// GetNewTokenFee returns the fee of issuing symbol, which is the fee of the first
+// schedule entry long enough for the symbol, or NewTokenFee if there is none
+func (p Params) GetNewTokenFee(symbol string) sdk.Int {
+	for _, f := range p.NewTokenFeeSchedule {
+		if uint64(len(symbol)) <= f.MaxLength {
+			return f.Fee
+		}
 	}
+	return p.NewTokenFee
 }
 
 // String implements the stringer interface.
@@ -73,14 +109,130 @@ func (p Params) String() string {
 	sb.WriteString("Params:")
 	sb.WriteString(fmt.Sprintf("TokenCacheSize:%v\t", p.TokenCacheSize))
 	sb.WriteString(fmt.Sprintf("NewTokenFee:%v\t", p.NewTokenFee))
-	sb.WriteString(fmt.Sprintf("ReservedSymbols:%s\t", strings.Join(p.ReservedSymbols, ",")))
+	sb.WriteString(fmt.Sprintf("FeeTokens:%s\t", FeeTokens(p.FeeTokens)))
+	sb.WriteString(fmt.Sprintf("NewTokenFeeSchedule:%s\t", SymbolLengthFees(p.NewTokenFeeSchedule)))
+	sb.WriteString(fmt.Sprintf("InflateTokenFee:%v\t", p.InflateTokenFee))
+	sb.WriteString(fmt.Sprintf("FeeDestination:%s\t", p.FeeDestination))
 
 	return sb.String()
 }
 
 func (p Params) Validate() error {
-	if !p.NewTokenFee.IsNegative() {
-		return nil
+	if p.NewTokenFee.IsNegative() {
+		return fmt.Errorf("NewTokenFee %v is not valid", p.NewTokenFee)
+	}
+
+	seen := make(map[string]bool)
+	for _, ft := range p.FeeTokens {
+		if err := ft.Validate(); err != nil {
+			return err
+		}
+		if seen[ft.Symbol] {
+			return fmt.Errorf("duplicate fee token %s", ft.Symbol)
+		}
+		seen[ft.Symbol] = true
+	}
+
+	for i, f := range p.NewTokenFeeSchedule {
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		if i > 0 && f.MaxLength <= p.NewTokenFeeSchedule[i-1].MaxLength {
+			return fmt.Errorf("new token fee schedule is not sorted by increasing max length at %v", f)
+		}
+	}
+
+	if p.InflateTokenFee.IsNil() || p.InflateTokenFee.IsNegative() {
+		return fmt.Errorf("InflateTokenFee %v is not valid", p.InflateTokenFee)
+	}
+
+	switch p.FeeDestination {
+	case FeeDestinationCommunityPool, FeeDestinationBurn, FeeDestinationFeeCollector:
+	default:
+		return fmt.Errorf("FeeDestination %s is not one of %s, %s and %s", p.FeeDestination,
+			FeeDestinationCommunityPool, FeeDestinationBurn, FeeDestinationFeeCollector)
+	}
+	return nil
+}
+
+// FeeToken defines an issued token accepted as transaction fee and its
+// conversion rate, i.e. how many poc one unit of the token is worth
+type FeeToken struct {
+	Symbol string  `json:"symbol" yaml:"symbol"`
+	Rate   sdk.Dec `json:"rate" yaml:"rate"`
+}
+
+// NewFeeToken creates a new FeeToken instance
+func NewFeeToken(symbol string, rate sdk.Dec) FeeToken {
+	return FeeToken{Symbol: symbol, Rate: rate}
+}
+
+// Validate performs a stateless validation of the fee token
+func (ft FeeToken) Validate() error {
+	if !sdk.Symbol(ft.Symbol).IsValidTokenName() {
+		return fmt.Errorf("fee token %s is not a valid symbol", ft.Symbol)
+	}
+	if ft.Symbol == sdk.NativeToken {
+		return fmt.Errorf("native token %s is always accepted as fee", ft.Symbol)
+	}
+	if ft.Rate.IsNil() || !ft.Rate.IsPositive() {
+		return fmt.Errorf("fee token %s rate %v is not positive", ft.Symbol, ft.Rate)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (ft FeeToken) String() string {
+	return fmt.Sprintf("%s:%s", ft.Symbol, ft.Rate)
+}
+
+// FeeTokens is an array of fee tokens
+type FeeTokens []FeeToken
+
+// String implements the Stringer interface
+func (fts FeeTokens) String() string {
+	out := make([]string, len(fts))
+	for i, ft := range fts {
+		out[i] = ft.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// SymbolLengthFee defines the new token fee of the symbols up to MaxLength long
+type SymbolLengthFee struct {
+	MaxLength uint64  `json:"max_length" yaml:"max_length"`
+	Fee       sdk.Int `json:"fee" yaml:"fee"`
+}
+
+// NewSymbolLengthFee creates a new SymbolLengthFee instance
+func NewSymbolLengthFee(maxLength uint64, fee sdk.Int) SymbolLengthFee {
+	return SymbolLengthFee{MaxLength: maxLength, Fee: fee}
+}
+
+// Validate performs a stateless validation of the symbol length fee
+func (f SymbolLengthFee) Validate() error {
+	if f.MaxLength < minSymbolLength || f.MaxLength > maxSymbolLength {
+		return fmt.Errorf("symbol length %d is not between %d and %d", f.MaxLength, minSymbolLength, maxSymbolLength)
+	}
+	if f.Fee.IsNil() || f.Fee.IsNegative() {
+		return fmt.Errorf("fee %v of symbols up to %d long is not valid", f.Fee, f.MaxLength)
+	}
+	return nil
+}
+
+// String implements the Stringer interface
+func (f SymbolLengthFee) String() string {
+	return fmt.Sprintf("%d:%s", f.MaxLength, f.Fee)
+}
+
+// SymbolLengthFees is an array of symbol length fees
+type SymbolLengthFees []SymbolLengthFee
+
+// String implements the Stringer interface
+func (fs SymbolLengthFees) String() string {
+	out := make([]string, len(fs))
+	for i, f := range fs {
+		out[i] = f.String()
 	}
-	return fmt.Errorf("NewTokenFee %v is not valid", p.NewTokenFee)
+	return strings.Join(out, ",")
 }
@@ -17,12 +17,12 @@ func TestParamsEqual(t *testing.T) {
 	require.False(t, p1.Equal(p2))
 
 	p2.TokenCacheSize++
-	p2.ReservedSymbols = append(p2.ReservedSymbols, "mytoken")
+	p2.NewTokenFeeSchedule = append(p2.NewTokenFeeSchedule, NewSymbolLengthFee(3, sdk.NewInt(100)))
 	require.False(t, p1.Equal(p2))
 }
 
 func TestParamsString(t *testing.T) {
-	expectedStr := "Params:TokenCacheSize:32\tNewTokenFee:1000000000000000000000\tReservedSymbols:btc,eth,eos,usdt,bch,bsv,ltc,bnb,xrp,okb,ht,dash,etc,neo,atom,zec,ont,doge,tusd,bat,qtum,vsys,iost,dcr,zrx,beam,grin\t"
+	expectedStr := "Params:TokenCacheSize:32\tNewTokenFee:1000000000000000000000\tFeeTokens:\tNewTokenFeeSchedule:\tInflateTokenFee:0\tFeeDestination:community_pool\t"
 	p := DefaultParams()
 
 	require.Equal(t, expectedStr, p.String())
@@ -50,3 +50,52 @@ func TestParamValidate(t *testing.T) {
 	require.NotNil(t, p.Validate())
 
 }
+
+func TestParamValidateFeeTokens(t *testing.T) {
+	p := DefaultParams()
+	p.FeeTokens = []FeeToken{NewFeeToken("btc", sdk.NewDec(2)), NewFeeToken("eth", sdk.NewDecWithPrec(5, 1))}
+	require.Nil(t, p.Validate())
+
+	p.FeeTokens = []FeeToken{NewFeeToken("btc", sdk.NewDec(2)), NewFeeToken("btc", sdk.NewDec(3))}
+	require.NotNil(t, p.Validate())
+
+	p.FeeTokens = []FeeToken{NewFeeToken("btc", sdk.ZeroDec())}
+	require.NotNil(t, p.Validate())
+
+	p.FeeTokens = []FeeToken{NewFeeToken(sdk.NativeToken, sdk.OneDec())}
+	require.NotNil(t, p.Validate())
+}
+
+func TestParamValidateFeeSchedule(t *testing.T) {
+	p := DefaultParams()
+	p.NewTokenFeeSchedule = []SymbolLengthFee{NewSymbolLengthFee(3, sdk.NewInt(100)), NewSymbolLengthFee(5, sdk.NewInt(10))}
+	require.Nil(t, p.Validate())
+	require.Equal(t, sdk.NewInt(100), p.GetNewTokenFee("btc"))
+	require.Equal(t, sdk.NewInt(10), p.GetNewTokenFee("abcd"))
+	require.Equal(t, sdk.NewInt(10), p.GetNewTokenFee("abcde"))
+	require.Equal(t, p.NewTokenFee, p.GetNewTokenFee("abcdef"))
+
+	p.NewTokenFeeSchedule = []SymbolLengthFee{NewSymbolLengthFee(5, sdk.NewInt(10)), NewSymbolLengthFee(3, sdk.NewInt(100))}
+	require.NotNil(t, p.Validate())
+
+	p.NewTokenFeeSchedule = []SymbolLengthFee{NewSymbolLengthFee(2, sdk.NewInt(100))}
+	require.NotNil(t, p.Validate())
+
+	p.NewTokenFeeSchedule = []SymbolLengthFee{NewSymbolLengthFee(17, sdk.NewInt(100))}
+	require.NotNil(t, p.Validate())
+
+	p.NewTokenFeeSchedule = []SymbolLengthFee{NewSymbolLengthFee(3, sdk.NewInt(-1))}
+	require.NotNil(t, p.Validate())
+
+	p = DefaultParams()
+	p.InflateTokenFee = sdk.NewInt(-1)
+	require.NotNil(t, p.Validate())
+
+	p = DefaultParams()
+	for _, dest := range []string{FeeDestinationCommunityPool, FeeDestinationBurn, FeeDestinationFeeCollector} {
+		p.FeeDestination = dest
+		require.Nil(t, p.Validate())
+	}
+	p.FeeDestination = "validators"
+	require.NotNil(t, p.Validate())
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/pocblockchain/pocc/types"
 	govtypes "github.com/pocblockchain/pocc/x/gov/types"
@@ -12,17 +13,37 @@ const (
 	// ProposalTypeAddToken defines the type for a AddToken
 	ProposalTypeTokenParamsChange = "TokenParamsChange"
 	ProposalTypeDisableToken      = "DisableToken"
+	ProposalTypeFeeToken          = "FeeToken"
+	ProposalTypeEnableToken       = "EnableToken"
+	ProposalTypeDelistToken       = "DelistToken"
+	ProposalTypeReserveSymbol     = "ReserveSymbol"
+	ProposalTypeUnreserveSymbol   = "UnreserveSymbol"
 )
 
 // Assert proposl implements govtypes.Content at compile-time
 var _ govtypes.Content = TokenParamsChangeProposal{}
 var _ govtypes.Content = DisableTokenProposal{}
+var _ govtypes.Content = FeeTokenProposal{}
+var _ govtypes.Content = EnableTokenProposal{}
+var _ govtypes.Content = DelistTokenProposal{}
+var _ govtypes.Content = ReserveSymbolProposal{}
+var _ govtypes.Content = UnreserveSymbolProposal{}
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeTokenParamsChange)
 	govtypes.RegisterProposalTypeCodec(TokenParamsChangeProposal{}, "poc/token/TokenParamsChangeProposal")
 	govtypes.RegisterProposalType(ProposalTypeDisableToken)
 	govtypes.RegisterProposalTypeCodec(DisableTokenProposal{}, "poc/token/DisableTokenProposal")
+	govtypes.RegisterProposalType(ProposalTypeFeeToken)
+	govtypes.RegisterProposalTypeCodec(FeeTokenProposal{}, "poc/token/FeeTokenProposal")
+	govtypes.RegisterProposalType(ProposalTypeEnableToken)
+	govtypes.RegisterProposalTypeCodec(EnableTokenProposal{}, "poc/token/EnableTokenProposal")
+	govtypes.RegisterProposalType(ProposalTypeDelistToken)
+	govtypes.RegisterProposalTypeCodec(DelistTokenProposal{}, "poc/token/DelistTokenProposal")
+	govtypes.RegisterProposalType(ProposalTypeReserveSymbol)
+	govtypes.RegisterProposalTypeCodec(ReserveSymbolProposal{}, "poc/token/ReserveSymbolProposal")
+	govtypes.RegisterProposalType(ProposalTypeUnreserveSymbol)
+	govtypes.RegisterProposalTypeCodec(UnreserveSymbolProposal{}, "poc/token/UnreserveSymbolProposal")
 }
 
 type ParamChange struct {
@@ -173,3 +194,318 @@ func (dtp DisableTokenProposal) String() string {
 `, dtp.Title, dtp.Description, dtp.Symbol))
 	return b.String()
 }
+
+// FeeTokenProposal adds a token to the fee token whitelist or updates its rate, a zero rate removes it
+type FeeTokenProposal struct {
+	Title       string  `json:"title" yaml:"title"`
+	Description string  `json:"description" yaml:"description"`
+	Symbol      string  `json:"symbol" yaml:"symbol"`
+	Rate        sdk.Dec `json:"rate" yaml:"rate"`
+}
+
+// NewFeeTokenProposal creates a new fee token proposal.
+func NewFeeTokenProposal(title, description, symbol string, rate sdk.Dec) FeeTokenProposal {
+	return FeeTokenProposal{
+		Title:       title,
+		Description: description,
+		Symbol:      symbol,
+		Rate:        rate,
+	}
+}
+
+// GetTitle returns the title of a fee token proposal.
+func (ftp FeeTokenProposal) GetTitle() string { return ftp.Title }
+
+// GetDescription returns the description of a fee token proposal.
+func (ftp FeeTokenProposal) GetDescription() string { return ftp.Description }
+
+// ProposalRoute returns the routing key of a fee token proposal.
+func (ftp FeeTokenProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a fee token proposal.
+func (ftp FeeTokenProposal) ProposalType() string { return ProposalTypeFeeToken }
+
+// ValidateBasic runs basic stateless validity checks
+func (ftp FeeTokenProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, ftp)
+	if err != nil {
+		return err
+	}
+
+	if !sdk.Symbol(ftp.Symbol).IsValidTokenName() {
+		return ErrInvalidSymbol(ftp.Symbol)
+	}
+	if ftp.Symbol == sdk.NativeToken {
+		return sdk.ErrInvalidTx("Not allowed to change native token's fee rate")
+	}
+	if ftp.Rate.IsNil() || ftp.Rate.IsNegative() {
+		return ErrInvalidFeeTokenRate(ftp.Symbol, ftp.Rate)
+	}
+
+	return err
+}
+
+// String implements the Stringer interface.
+func (ftp FeeTokenProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Fee Token Proposal:
+ Title:       %s
+ Description: %s
+ Symbol:      %s
+ Rate:        %s
+`, ftp.Title, ftp.Description, ftp.Symbol, ftp.Rate))
+	return b.String()
+}
+
+// EnableTokenProposal makes a paused, delisted or migrating token active again
+type EnableTokenProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Symbol      string `json:"symbol" yaml:"symbol"`
+}
+
+// NewEnableTokenProposal creates a new enable token proposal.
+func NewEnableTokenProposal(title, description, symbol string) EnableTokenProposal {
+	return EnableTokenProposal{
+		Title:       title,
+		Description: description,
+		Symbol:      symbol,
+	}
+}
+
+// GetTitle returns the title of an enable token proposal.
+func (etp EnableTokenProposal) GetTitle() string { return etp.Title }
+
+// GetDescription returns the description of an enable token proposal.
+func (etp EnableTokenProposal) GetDescription() string { return etp.Description }
+
+// ProposalRoute returns the routing key of an enable token proposal.
+func (etp EnableTokenProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an enable token proposal.
+func (etp EnableTokenProposal) ProposalType() string { return ProposalTypeEnableToken }
+
+// ValidateBasic runs basic stateless validity checks
+func (etp EnableTokenProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, etp)
+	if err != nil {
+		return err
+	}
+
+	if !sdk.Symbol(etp.Symbol).IsValidTokenName() {
+		return ErrInvalidSymbol(etp.Symbol)
+	}
+	if etp.Symbol == sdk.NativeToken {
+		return sdk.ErrInvalidTx("Not allowed to enable native token")
+	}
+
+	return err
+}
+
+// String implements the Stringer interface.
+func (etp EnableTokenProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Enable Token Proposal:
+ Title:       %s
+ Description: %s
+ Symbol:      %s
+`, etp.Title, etp.Description, etp.Symbol))
+	return b.String()
+}
+
+// DelistTokenProposal delists a token, holders can only burn it afterwards. If a
+// successor is given the token is migrating instead and can still be sent.
+type DelistTokenProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Symbol      string `json:"symbol" yaml:"symbol"`
+	Successor   string `json:"successor" yaml:"successor"`
+}
+
+// NewDelistTokenProposal creates a new delist token proposal.
+func NewDelistTokenProposal(title, description, symbol, successor string) DelistTokenProposal {
+	return DelistTokenProposal{
+		Title:       title,
+		Description: description,
+		Symbol:      symbol,
+		Successor:   successor,
+	}
+}
+
+// GetTitle returns the title of a delist token proposal.
+func (dtp DelistTokenProposal) GetTitle() string { return dtp.Title }
+
+// GetDescription returns the description of a delist token proposal.
+func (dtp DelistTokenProposal) GetDescription() string { return dtp.Description }
+
+// ProposalRoute returns the routing key of a delist token proposal.
+func (dtp DelistTokenProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a delist token proposal.
+func (dtp DelistTokenProposal) ProposalType() string { return ProposalTypeDelistToken }
+
+// TargetStatus returns the status the token moves to once the proposal is executed
+func (dtp DelistTokenProposal) TargetStatus() sdk.TokenStatus {
+	if dtp.Successor != "" {
+		return sdk.TokenStatusMigrating
+	}
+	return sdk.TokenStatusDelisted
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (dtp DelistTokenProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, dtp)
+	if err != nil {
+		return err
+	}
+
+	if !sdk.Symbol(dtp.Symbol).IsValidTokenName() {
+		return ErrInvalidSymbol(dtp.Symbol)
+	}
+	if dtp.Symbol == sdk.NativeToken {
+		return sdk.ErrInvalidTx("Not allowed to delist native token")
+	}
+	if dtp.Successor != "" {
+		if !sdk.Symbol(dtp.Successor).IsValidTokenName() {
+			return ErrInvalidSymbol(dtp.Successor)
+		}
+		if dtp.Successor == dtp.Symbol {
+			return sdk.ErrInvalidTx("A token can not migrate to itself")
+		}
+	}
+
+	return err
+}
+
+// String implements the Stringer interface.
+func (dtp DelistTokenProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Delist Token Proposal:
+ Title:       %s
+ Description: %s
+ Symbol:      %s
+ Successor:   %s
+`, dtp.Title, dtp.Description, dtp.Symbol, dtp.Successor))
+	return b.String()
+}
+
+// ReserveSymbolProposal reserves a symbol which is not issued yet or updates its reservation,
+// the claimant if any can issue the symbol until the reservation expires
+type ReserveSymbolProposal struct {
+	Title       string         `json:"title" yaml:"title"`
+	Description string         `json:"description" yaml:"description"`
+	Symbol      string         `json:"symbol" yaml:"symbol"`
+	Claimant    sdk.AccAddress `json:"claimant" yaml:"claimant"`
+	ExpireTime  time.Time      `json:"expire_time" yaml:"expire_time"`
+}
+
+// NewReserveSymbolProposal creates a new reserve symbol proposal.
+func NewReserveSymbolProposal(title, description, symbol string, claimant sdk.AccAddress, expireTime time.Time) ReserveSymbolProposal {
+	return ReserveSymbolProposal{
+		Title:       title,
+		Description: description,
+		Symbol:      symbol,
+		Claimant:    claimant,
+		ExpireTime:  expireTime,
+	}
+}
+
+// GetTitle returns the title of a reserve symbol proposal.
+func (rsp ReserveSymbolProposal) GetTitle() string { return rsp.Title }
+
+// GetDescription returns the description of a reserve symbol proposal.
+func (rsp ReserveSymbolProposal) GetDescription() string { return rsp.Description }
+
+// ProposalRoute returns the routing key of a reserve symbol proposal.
+func (rsp ReserveSymbolProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a reserve symbol proposal.
+func (rsp ReserveSymbolProposal) ProposalType() string { return ProposalTypeReserveSymbol }
+
+// Reservation returns the reservation made once the proposal is executed
+func (rsp ReserveSymbolProposal) Reservation() Reservation {
+	return NewReservation(rsp.Symbol, rsp.Claimant, rsp.ExpireTime)
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (rsp ReserveSymbolProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, rsp)
+	if err != nil {
+		return err
+	}
+
+	if !sdk.Symbol(rsp.Symbol).IsValidTokenName() {
+		return ErrInvalidSymbol(rsp.Symbol)
+	}
+	if rsp.Symbol == sdk.NativeToken {
+		return sdk.ErrInvalidTx("Not allowed to reserve native token")
+	}
+
+	return err
+}
+
+// String implements the Stringer interface.
+func (rsp ReserveSymbolProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Reserve Symbol Proposal:
+ Title:       %s
+ Description: %s
+ Symbol:      %s
+ Claimant:    %s
+ ExpireTime:  %s
+`, rsp.Title, rsp.Description, rsp.Symbol, rsp.Claimant, rsp.ExpireTime))
+	return b.String()
+}
+
+// UnreserveSymbolProposal removes the reservation of a symbol so that anyone can issue it
+type UnreserveSymbolProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	Symbol      string `json:"symbol" yaml:"symbol"`
+}
+
+// NewUnreserveSymbolProposal creates a new unreserve symbol proposal.
+func NewUnreserveSymbolProposal(title, description, symbol string) UnreserveSymbolProposal {
+	return UnreserveSymbolProposal{
+		Title:       title,
+		Description: description,
+		Symbol:      symbol,
+	}
+}
+
+// GetTitle returns the title of an unreserve symbol proposal.
+func (usp UnreserveSymbolProposal) GetTitle() string { return usp.Title }
+
+// GetDescription returns the description of an unreserve symbol proposal.
+func (usp UnreserveSymbolProposal) GetDescription() string { return usp.Description }
+
+// ProposalRoute returns the routing key of an unreserve symbol proposal.
+func (usp UnreserveSymbolProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an unreserve symbol proposal.
+func (usp UnreserveSymbolProposal) ProposalType() string { return ProposalTypeUnreserveSymbol }
+
+// ValidateBasic runs basic stateless validity checks
+func (usp UnreserveSymbolProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, usp)
+	if err != nil {
+		return err
+	}
+
+	if !sdk.Symbol(usp.Symbol).IsValidTokenName() {
+		return ErrInvalidSymbol(usp.Symbol)
+	}
+
+	return err
+}
+
+// String implements the Stringer interface.
+func (usp UnreserveSymbolProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Unreserve Symbol Proposal:
+ Title:       %s
+ Description: %s
+ Symbol:      %s
+`, usp.Title, usp.Description, usp.Symbol))
+	return b.String()
+}
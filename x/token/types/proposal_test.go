@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/pocblockchain/pocc/types"
 	"github.com/stretchr/testify/require"
@@ -47,3 +48,111 @@ func TestDisableTokenProposal(t *testing.T) {
 	dtp = NewDisableTokenProposal("Test", "Description", "Btc")
 	require.NotNil(t, dtp.ValidateBasic())
 }
+
+func TestFeeTokenProposal(t *testing.T) {
+	ftp := NewFeeTokenProposal("Test", "Description", "btc", sdk.NewDec(2))
+
+	require.Equal(t, "Test", ftp.GetTitle())
+	require.Equal(t, "Description", ftp.GetDescription())
+	require.Equal(t, RouterKey, ftp.ProposalRoute())
+	require.Equal(t, ProposalTypeFeeToken, ftp.ProposalType())
+	require.Nil(t, ftp.ValidateBasic())
+
+	//a zero rate removes the fee token
+	ftp = NewFeeTokenProposal("Test", "Description", "btc", sdk.ZeroDec())
+	require.Nil(t, ftp.ValidateBasic())
+
+	ftp = NewFeeTokenProposal("Test", "Description", "btc", sdk.NewDec(-1))
+	require.NotNil(t, ftp.ValidateBasic())
+
+	ftp = NewFeeTokenProposal("Test", "Description", sdk.NativeToken, sdk.NewDec(2))
+	require.NotNil(t, ftp.ValidateBasic())
+
+	ftp = NewFeeTokenProposal("Test", "Description", "Btc", sdk.NewDec(2))
+	require.NotNil(t, ftp.ValidateBasic())
+}
+
+func TestEnableTokenProposal(t *testing.T) {
+	expectedStr := "Enable Token Proposal:\n Title:       Test\n Description: Description\n Symbol:      btc\n"
+	etp := NewEnableTokenProposal("Test", "Description", "btc")
+
+	require.Equal(t, "Test", etp.GetTitle())
+	require.Equal(t, "Description", etp.GetDescription())
+	require.Equal(t, RouterKey, etp.ProposalRoute())
+	require.Equal(t, ProposalTypeEnableToken, etp.ProposalType())
+	require.Nil(t, etp.ValidateBasic())
+	require.Equal(t, expectedStr, etp.String())
+
+	etp = NewEnableTokenProposal("Test", "Description", sdk.NativeToken)
+	require.NotNil(t, etp.ValidateBasic())
+
+	etp = NewEnableTokenProposal("Test", "Description", "Btc")
+	require.NotNil(t, etp.ValidateBasic())
+}
+
+func TestDelistTokenProposal(t *testing.T) {
+	dtp := NewDelistTokenProposal("Test", "Description", "btc", "")
+
+	require.Equal(t, "Test", dtp.GetTitle())
+	require.Equal(t, "Description", dtp.GetDescription())
+	require.Equal(t, RouterKey, dtp.ProposalRoute())
+	require.Equal(t, ProposalTypeDelistToken, dtp.ProposalType())
+	require.Equal(t, sdk.TokenStatusDelisted, dtp.TargetStatus())
+	require.Nil(t, dtp.ValidateBasic())
+
+	dtp = NewDelistTokenProposal("Test", "Description", "btc", "wbtc")
+	require.Equal(t, sdk.TokenStatusMigrating, dtp.TargetStatus())
+	require.Nil(t, dtp.ValidateBasic())
+
+	//a token can not migrate to itself or to an invalid symbol
+	dtp = NewDelistTokenProposal("Test", "Description", "btc", "btc")
+	require.NotNil(t, dtp.ValidateBasic())
+	dtp = NewDelistTokenProposal("Test", "Description", "btc", "Wbtc")
+	require.NotNil(t, dtp.ValidateBasic())
+
+	dtp = NewDelistTokenProposal("Test", "Description", sdk.NativeToken, "")
+	require.NotNil(t, dtp.ValidateBasic())
+}
+
+func TestReserveSymbolProposal(t *testing.T) {
+	claimant := sdk.AccAddress([]byte("claimant"))
+	expireTime := time.Unix(1000, 0).UTC()
+	rsp := NewReserveSymbolProposal("Test", "Description", "btc", claimant, expireTime)
+
+	require.Equal(t, "Test", rsp.GetTitle())
+	require.Equal(t, "Description", rsp.GetDescription())
+	require.Equal(t, RouterKey, rsp.ProposalRoute())
+	require.Equal(t, ProposalTypeReserveSymbol, rsp.ProposalType())
+	require.Equal(t, NewReservation("btc", claimant, expireTime), rsp.Reservation())
+	require.Nil(t, rsp.ValidateBasic())
+
+	//nobody can claim a reservation without claimant, which never expires without expire time
+	r := NewReserveSymbolProposal("Test", "Description", "btc", nil, time.Time{}).Reservation()
+	require.False(t, r.CanClaim(claimant))
+	require.False(t, r.IsExpired(expireTime))
+
+	r = rsp.Reservation()
+	require.True(t, r.CanClaim(claimant))
+	require.False(t, r.CanClaim(sdk.AccAddress([]byte("other"))))
+	require.False(t, r.IsExpired(expireTime.Add(-time.Second)))
+	require.True(t, r.IsExpired(expireTime))
+
+	rsp = NewReserveSymbolProposal("Test", "Description", "Btc", claimant, expireTime)
+	require.NotNil(t, rsp.ValidateBasic())
+
+	rsp = NewReserveSymbolProposal("Test", "Description", sdk.NativeToken, claimant, expireTime)
+	require.NotNil(t, rsp.ValidateBasic())
+}
+
+func TestUnreserveSymbolProposal(t *testing.T) {
+	usp := NewUnreserveSymbolProposal("Test", "Description", "btc")
+
+	require.Equal(t, "Test", usp.GetTitle())
+	require.Equal(t, "Description", usp.GetDescription())
+	require.Equal(t, RouterKey, usp.ProposalRoute())
+	require.Equal(t, ProposalTypeUnreserveSymbol, usp.ProposalType())
+	require.Nil(t, usp.ValidateBasic())
+
+	usp = NewUnreserveSymbolProposal("Test", "Description", "Btc")
+	require.NotNil(t, usp.ValidateBasic())
+}
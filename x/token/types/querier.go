@@ -11,6 +11,7 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 
 	sdk "github.com/pocblockchain/pocc/types"
@@ -25,6 +26,46 @@ func NewQueryTokenInfo(symbol string) QueryTokenInfo {
 	return QueryTokenInfo{Symbol: symbol}
 }
 
+//QueryTokensParams filters and paginates the tokens and symbols queries, empty filters match all tokens
+//and a zero limit returns all of them. The symbols are paged in order, after the cursor if it is given,
+//so that the next page can be queried from the last symbol of the previous one
+type QueryTokensParams struct {
+	Page        int    `json:"page"`
+	Limit       int    `json:"limit"`
+	Issuer      string `json:"issuer"`       //only tokens issued by this address
+	SendEnabled *bool  `json:"send_enabled"` //only tokens whose send is enabled or disabled
+	Prefix      string `json:"prefix"`       //only tokens whose symbol starts with prefix
+	After       string `json:"after"`        //only tokens whose symbol sorts after this one
+}
+
+func NewQueryTokensParams(page, limit int, issuer string, sendEnabled *bool, prefix, after string) QueryTokensParams {
+	return QueryTokensParams{
+		Page:        page,
+		Limit:       limit,
+		Issuer:      issuer,
+		SendEnabled: sendEnabled,
+		Prefix:      prefix,
+		After:       after,
+	}
+}
+
+//QueryTokensByStatusParams filters the tokens by status and paginates them like QueryTokensParams
+type QueryTokensByStatusParams struct {
+	Status sdk.TokenStatus `json:"status"`
+	Page   int             `json:"page"`
+	Limit  int             `json:"limit"`
+	After  string          `json:"after"` //only tokens whose symbol sorts after this one
+}
+
+func NewQueryTokensByStatusParams(status sdk.TokenStatus, page, limit int, after string) QueryTokensByStatusParams {
+	return QueryTokensByStatusParams{
+		Status: status,
+		Page:   page,
+		Limit:  limit,
+		After:  after,
+	}
+}
+
 //QueryResToken
 type QueryResToken sdk.TokenInfo
 
@@ -55,3 +96,105 @@ type QueryResSymbols []string
 func (qs QueryResSymbols) String() string {
 	return strings.Join(qs, ",")
 }
+
+//QueryResMetadata
+type QueryResMetadata sdk.TokenMetadata
+
+func (qm QueryResMetadata) String() string {
+	return fmt.Sprintf(`
+	Name:%s
+	Description:%s
+	LogoURI:%s
+	Website:%s
+	`, qm.Name, qm.Description, qm.LogoURI, qm.Website)
+}
+
+//QueryResFrozenAccounts
+type QueryResFrozenAccounts []sdk.AccAddress
+
+func (qf QueryResFrozenAccounts) String() string {
+	if len(qf) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, addr := range qf {
+		b.WriteString(addr.String())
+		b.WriteString("\n")
+	}
+	return strings.TrimSpace(b.String())
+}
+
+//QueryResFeeTokens
+type QueryResFeeTokens []FeeToken
+
+func (qf QueryResFeeTokens) String() string {
+	if len(qf) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, ft := range qf {
+		b.WriteString(ft.String())
+		b.WriteString("\n")
+	}
+	return strings.TrimSpace(b.String())
+}
+
+//QueryResTransitions
+type QueryResTransitions []TokenStatusTransition
+
+func (qt QueryResTransitions) String() string {
+	if len(qt) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	for _, t := range qt {
+		b.WriteString(t.String())
+		b.WriteString("\n")
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// QueryAirdropParams defines the params of the airdrop query
+type QueryAirdropParams struct {
+	AirdropID uint64 `json:"airdrop_id"`
+}
+
+// NewQueryAirdropParams creates a new QueryAirdropParams instance
+func NewQueryAirdropParams(airdropID uint64) QueryAirdropParams {
+	return QueryAirdropParams{AirdropID: airdropID}
+}
+
+// QueryAirdropsParams defines the params of the airdrops query, an empty issuer queries all airdrops
+type QueryAirdropsParams struct {
+	Issuer sdk.AccAddress `json:"issuer"`
+}
+
+// NewQueryAirdropsParams creates a new QueryAirdropsParams instance
+func NewQueryAirdropsParams(issuer sdk.AccAddress) QueryAirdropsParams {
+	return QueryAirdropsParams{Issuer: issuer}
+}
+
+// QueryAirdropClaimParams defines the params of the airdrop claim status query
+type QueryAirdropClaimParams struct {
+	AirdropID uint64 `json:"airdrop_id"`
+	Index     uint64 `json:"index"`
+}
+
+// NewQueryAirdropClaimParams creates a new QueryAirdropClaimParams instance
+func NewQueryAirdropClaimParams(airdropID, index uint64) QueryAirdropClaimParams {
+	return QueryAirdropClaimParams{AirdropID: airdropID, Index: index}
+}
+
+//QueryResAirdropClaim is the claim status of a leaf of an airdrop
+type QueryResAirdropClaim struct {
+	AirdropID uint64 `json:"airdrop_id"`
+	Index     uint64 `json:"index"`
+	Claimed   bool   `json:"claimed"`
+}
+
+func (qc QueryResAirdropClaim) String() string {
+	return fmt.Sprintf("Airdrop %d Index %d Claimed: %t", qc.AirdropID, qc.Index, qc.Claimed)
+}
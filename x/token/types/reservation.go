@@ -0,0 +1,85 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/pocblockchain/pocc/types"
+)
+
+// DefaultReservedSymbols are reserved in the default genesis
+var DefaultReservedSymbols = []string{"btc", "eth", "eos", "usdt", "bch", "bsv", "ltc", "bnb", "xrp", "okb", "dash", "etc", "neo", "atom", "zec", "ont", "doge", "tusd", "bat", "qtum", "vsys", "iost", "dcr", "zrx", "beam", "grin"}
+
+// Reservation keeps a symbol from being issued, only its claimant can issue it
+// and the symbol is free for anyone once the reservation expires
+type Reservation struct {
+	Symbol     string         `json:"symbol" yaml:"symbol"`
+	Claimant   sdk.AccAddress `json:"claimant" yaml:"claimant"`       //empty if nobody can claim the symbol
+	ExpireTime time.Time      `json:"expire_time" yaml:"expire_time"` //zero if the reservation never expires
+}
+
+// NewReservation creates a new Reservation instance
+func NewReservation(symbol string, claimant sdk.AccAddress, expireTime time.Time) Reservation {
+	return Reservation{
+		Symbol:     symbol,
+		Claimant:   claimant,
+		ExpireTime: expireTime,
+	}
+}
+
+// Validate performs a stateless validation of the reservation
+func (r Reservation) Validate() error {
+	if !sdk.Symbol(r.Symbol).IsValidTokenName() {
+		return fmt.Errorf("reserved symbol %s is not valid", r.Symbol)
+	}
+	if r.Symbol == sdk.NativeToken {
+		return fmt.Errorf("native token %s can not be reserved", r.Symbol)
+	}
+	return nil
+}
+
+// IsExpired returns whether the reservation has expired at blockTime
+func (r Reservation) IsExpired(blockTime time.Time) bool {
+	return !r.ExpireTime.IsZero() && !blockTime.Before(r.ExpireTime)
+}
+
+// CanClaim returns whether addr is the claimant of the reservation
+func (r Reservation) CanClaim(addr sdk.AccAddress) bool {
+	return !r.Claimant.Empty() && r.Claimant.Equals(addr)
+}
+
+// String implements the Stringer interface
+func (r Reservation) String() string {
+	return fmt.Sprintf(`Reservation %s:
+  Claimant:   %s
+  ExpireTime: %s`, r.Symbol, r.Claimant, r.ExpireTime)
+}
+
+// Reservations is an array of reservations
+type Reservations []Reservation
+
+// String implements the Stringer interface
+func (rs Reservations) String() string {
+	if len(rs) == 0 {
+		return ""
+	}
+
+	out := make([]string, len(rs))
+	for i, r := range rs {
+		out[i] = r.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// DefaultReservations returns the reservations of DefaultReservedSymbols sorted by symbol,
+// nobody can claim them and they never expire
+func DefaultReservations() []Reservation {
+	reservations := make([]Reservation, len(DefaultReservedSymbols))
+	for i, symbol := range DefaultReservedSymbols {
+		reservations[i] = NewReservation(symbol, nil, time.Time{})
+	}
+	sort.Slice(reservations, func(i, j int) bool { return reservations[i].Symbol < reservations[j].Symbol })
+	return reservations
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/pocblockchain/pocc/types"
+)
+
+// TokenStatusTransition records a change of a token's lifecycle state
+type TokenStatusTransition struct {
+	Symbol     sdk.Symbol      `json:"symbol" yaml:"symbol"`
+	From       sdk.TokenStatus `json:"from" yaml:"from"`
+	To         sdk.TokenStatus `json:"to" yaml:"to"`
+	Height     int64           `json:"height" yaml:"height"`           //block height of the transition
+	Time       time.Time       `json:"time" yaml:"time"`               //block time of the transition
+	ProposalID uint64          `json:"proposal_id" yaml:"proposal_id"` //proposal which triggered the transition, zero if none
+	Successor  string          `json:"successor" yaml:"successor"`     //token the holders migrate to, only set when migrating
+
+	Issuer sdk.AccAddress `json:"issuer" yaml:"issuer"` //issuer who paused or unpaused the token, empty if governance did
+}
+
+// NewTokenStatusTransition creates a new TokenStatusTransition instance
+func NewTokenStatusTransition(symbol sdk.Symbol, from, to sdk.TokenStatus, height int64, time time.Time, proposalID uint64, successor string) TokenStatusTransition {
+	return TokenStatusTransition{
+		Symbol:     symbol,
+		From:       from,
+		To:         to,
+		Height:     height,
+		Time:       time,
+		ProposalID: proposalID,
+		Successor:  successor,
+	}
+}
+
+// ByIssuer returns whether the issuer rather than governance triggered the transition
+func (t TokenStatusTransition) ByIssuer() bool {
+	return !t.Issuer.Empty()
+}
+
+// String implements the Stringer interface.
+func (t TokenStatusTransition) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Token Status Transition:
+ Symbol:      %s
+ From:        %s
+ To:          %s
+ Height:      %d
+ Time:        %s
+ Proposal ID: %d
+`, t.Symbol, t.From, t.To, t.Height, t.Time, t.ProposalID))
+	if t.Successor != "" {
+		b.WriteString(fmt.Sprintf(" Successor:   %s\n", t.Successor))
+	}
+	if !t.Issuer.Empty() {
+		b.WriteString(fmt.Sprintf(" Issuer:      %s\n", t.Issuer))
+	}
+	return b.String()
+}
@@ -0,0 +1,155 @@
+package upgrade
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pocblockchain/pocc/codec"
+	"github.com/pocblockchain/pocc/store"
+	sdk "github.com/pocblockchain/pocc/types"
+	govtypes "github.com/pocblockchain/pocc/x/gov/types"
+)
+
+func setupTest(t *testing.T) (sdk.Context, Keeper, govtypes.Handler) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	RegisterCodec(cdc)
+
+	header := abci.Header{Height: 10, Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	ctx := sdk.NewContext(ms, header, false, log.NewNopLogger())
+
+	k := NewKeeper(cdc, key, DefaultCodespace)
+	return ctx, k, NewSoftwareUpgradeProposalHandler(k)
+}
+
+func TestBeginBlockerHaltsWithoutHandler(t *testing.T) {
+	ctx, k, handler := setupTest(t)
+
+	res := handler(ctx, NewSoftwareUpgradeProposal("title", "desc", NewPlan("v2", time.Time{}, 15, "info")))
+	require.True(t, res.IsOK(), res.Log)
+
+	// nothing happens before the plan height
+	for h := int64(11); h < 15; h++ {
+		require.NotPanics(t, func() { BeginBlocker(ctx.WithBlockHeight(h), k) })
+	}
+
+	require.PanicsWithValue(t, `UPGRADE "v2" NEEDED at height: 15: info`, func() {
+		BeginBlocker(ctx.WithBlockHeight(15), k)
+	})
+
+	// the plan stays scheduled so a restarted old binary halts again
+	_, found := k.GetUpgradePlan(ctx)
+	require.True(t, found)
+}
+
+func TestBeginBlockerHaltsAtTime(t *testing.T) {
+	ctx, k, handler := setupTest(t)
+
+	due := ctx.BlockTime().Add(time.Hour)
+	res := handler(ctx, NewSoftwareUpgradeProposal("title", "desc", NewPlan("v2", due, 0, "")))
+	require.True(t, res.IsOK(), res.Log)
+
+	require.NotPanics(t, func() { BeginBlocker(ctx.WithBlockTime(due.Add(-time.Second)), k) })
+	require.Panics(t, func() { BeginBlocker(ctx.WithBlockTime(due), k) })
+}
+
+func TestBeginBlockerAppliesUpgrade(t *testing.T) {
+	ctx, k, handler := setupTest(t)
+
+	res := handler(ctx, NewSoftwareUpgradeProposal("title", "desc", NewPlan("v2", time.Time{}, 15, "")))
+	require.True(t, res.IsOK(), res.Log)
+
+	// the new binary registers the handler and runs from the upgrade height
+	var migrated int64
+	k.SetUpgradeHandler("v2", func(ctx sdk.Context, plan Plan) {
+		migrated = ctx.BlockHeight()
+	})
+
+	ctx = ctx.WithBlockHeight(15)
+	require.NotPanics(t, func() { BeginBlocker(ctx, k) })
+	require.Equal(t, int64(15), migrated)
+
+	_, found := k.GetUpgradePlan(ctx)
+	require.False(t, found)
+	ap, found := k.GetAppliedPlan(ctx, "v2")
+	require.True(t, found)
+	require.Equal(t, int64(15), ap.Height)
+
+	// the handler runs only once
+	migrated = 0
+	BeginBlocker(ctx.WithBlockHeight(16), k)
+	require.Equal(t, int64(0), migrated)
+}
+
+func TestBeginBlockerHaltsOnEarlyBinary(t *testing.T) {
+	ctx, k, handler := setupTest(t)
+
+	res := handler(ctx, NewSoftwareUpgradeProposal("title", "desc", NewPlan("v2", time.Time{}, 15, "")))
+	require.True(t, res.IsOK(), res.Log)
+
+	k.SetUpgradeHandler("v2", func(ctx sdk.Context, plan Plan) {})
+	require.Panics(t, func() { BeginBlocker(ctx.WithBlockHeight(14), k) })
+}
+
+func TestSoftwareUpgradeProposalHandler(t *testing.T) {
+	ctx, k, handler := setupTest(t)
+
+	// a plan in the past is rejected
+	res := handler(ctx, NewSoftwareUpgradeProposal("title", "desc", NewPlan("v2", time.Time{}, 5, "")))
+	require.False(t, res.IsOK())
+	require.Equal(t, CodeInvalidPlan, res.Code)
+
+	// nothing to cancel
+	res = handler(ctx, NewCancelSoftwareUpgradeProposal("title", "desc"))
+	require.False(t, res.IsOK())
+
+	res = handler(ctx, NewSoftwareUpgradeProposal("title", "desc", NewPlan("v2", time.Time{}, 15, "")))
+	require.True(t, res.IsOK(), res.Log)
+	res = handler(ctx, NewSoftwareUpgradeProposal("title", "desc", NewPlan("v3", time.Time{}, 20, "")))
+	require.True(t, res.IsOK(), res.Log)
+
+	plan, found := k.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, "v3", plan.Name)
+
+	res = handler(ctx, NewCancelSoftwareUpgradeProposal("title", "desc"))
+	require.True(t, res.IsOK(), res.Log)
+	_, found = k.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	// the plan is gone, the chain keeps running past its height
+	require.NotPanics(t, func() { BeginBlocker(ctx.WithBlockHeight(20), k) })
+
+	res = handler(ctx, govtypes.NewTextProposal("title", "desc"))
+	require.False(t, res.IsOK())
+}
+
+func TestSoftwareUpgradeProposalValidateBasic(t *testing.T) {
+	p := NewSoftwareUpgradeProposal("title", "desc", NewPlan("v2", time.Time{}, 15, ""))
+	require.NoError(t, p.ValidateBasic())
+	require.Equal(t, ProposalTypeSoftwareUpgrade, p.ProposalType())
+	require.Equal(t, RouterKey, p.ProposalRoute())
+
+	p = NewSoftwareUpgradeProposal("title", "desc", NewPlan("v2", time.Time{}, 0, ""))
+	require.Error(t, p.ValidateBasic())
+	p = NewSoftwareUpgradeProposal("", "desc", NewPlan("v2", time.Time{}, 15, ""))
+	require.Error(t, p.ValidateBasic())
+
+	// the proposals go through gov message validation
+	addr := sdk.AccAddress([]byte("proposer____________"))
+	msg := govtypes.NewMsgSubmitProposal(NewSoftwareUpgradeProposal("title", "desc", NewPlan("v2", time.Time{}, 15, "")), sdk.Coins{}, addr)
+	require.NoError(t, msg.ValidateBasic())
+	msg = govtypes.NewMsgSubmitProposal(NewCancelSoftwareUpgradeProposal("title", "desc"), sdk.Coins{}, addr)
+	require.NoError(t, msg.ValidateBasic())
+}
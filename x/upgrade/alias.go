@@ -0,0 +1,63 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/pocblockchain/pocc/x/upgrade/internal/keeper
+// ALIASGEN: github.com/pocblockchain/pocc/x/upgrade/internal/types
+package upgrade
+
+import (
+	"github.com/pocblockchain/pocc/x/upgrade/internal/keeper"
+	"github.com/pocblockchain/pocc/x/upgrade/internal/types"
+)
+
+const (
+	ModuleName                        = types.ModuleName
+	RouterKey                         = types.RouterKey
+	StoreKey                          = types.StoreKey
+	QuerierKey                        = types.QuerierKey
+	DefaultCodespace                  = types.DefaultCodespace
+	CodeInvalidPlan                   = types.CodeInvalidPlan
+	CodeUnknownPlan                   = types.CodeUnknownPlan
+	QueryCurrent                      = types.QueryCurrent
+	QueryApplied                      = types.QueryApplied
+	ProposalTypeSoftwareUpgrade       = types.ProposalTypeSoftwareUpgrade
+	ProposalTypeCancelSoftwareUpgrade = types.ProposalTypeCancelSoftwareUpgrade
+	EventTypeScheduleUpgrade          = types.EventTypeScheduleUpgrade
+	EventTypeCancelUpgrade            = types.EventTypeCancelUpgrade
+	EventTypeApplyUpgrade             = types.EventTypeApplyUpgrade
+	AttributeKeyName                  = types.AttributeKeyName
+	AttributeKeyHeight                = types.AttributeKeyHeight
+	AttributeKeyTime                  = types.AttributeKeyTime
+	AttributeValueCategory            = types.AttributeValueCategory
+)
+
+var (
+	// functions aliases
+	NewKeeper                        = keeper.NewKeeper
+	NewQuerier                       = keeper.NewQuerier
+	NewPlan                          = types.NewPlan
+	NewAppliedPlan                   = types.NewAppliedPlan
+	NewQueryAppliedParams            = types.NewQueryAppliedParams
+	NewSoftwareUpgradeProposal       = types.NewSoftwareUpgradeProposal
+	NewCancelSoftwareUpgradeProposal = types.NewCancelSoftwareUpgradeProposal
+	ErrInvalidPlan                   = types.ErrInvalidPlan
+	ErrUnknownPlan                   = types.ErrUnknownPlan
+	DoneKey                          = types.DoneKey
+	RegisterCodec                    = types.RegisterCodec
+
+	// variable aliases
+	ModuleCdc  = types.ModuleCdc
+	PlanKey    = types.PlanKey
+	DonePrefix = types.DonePrefix
+)
+
+type (
+	Keeper                        = keeper.Keeper
+	UpgradeHandler                = types.UpgradeHandler
+	Plan                          = types.Plan
+	AppliedPlan                   = types.AppliedPlan
+	AppliedPlans                  = types.AppliedPlans
+	QueryAppliedParams            = types.QueryAppliedParams
+	SoftwareUpgradeProposal       = types.SoftwareUpgradeProposal
+	CancelSoftwareUpgradeProposal = types.CancelSoftwareUpgradeProposal
+)
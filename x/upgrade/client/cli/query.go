@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pocblockchain/pocc/client"
+	"github.com/pocblockchain/pocc/client/context"
+	"github.com/pocblockchain/pocc/codec"
+	"github.com/pocblockchain/pocc/x/upgrade/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for the upgrade module.
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	upgradeQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the upgrade module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	upgradeQueryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryPlan(cdc),
+			GetCmdQueryApplied(cdc),
+		)...,
+	)
+
+	return upgradeQueryCmd
+}
+
+// GetCmdQueryPlan implements a command to return the currently scheduled
+// upgrade plan.
+func GetCmdQueryPlan(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "plan",
+		Short: "Query the upgrade plan, if one is scheduled",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierKey, types.QueryCurrent)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("no upgrade scheduled")
+			}
+
+			var plan types.Plan
+			if err := cdc.UnmarshalJSON(res, &plan); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(plan)
+		},
+	}
+}
+
+// GetCmdQueryApplied implements a command to return the applied upgrade
+// plans, or the applied plan with the given name.
+func GetCmdQueryApplied(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "applied [name]",
+		Short: "Query the applied upgrade plans, or the one with the given name",
+		Long: `Query the applied upgrade plans along with the height they were applied at.
+If a name is given, only the plan with that name is returned and the query fails
+if it has not been applied.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			name := ""
+			if len(args) > 0 {
+				name = args[0]
+			}
+
+			bz, err := cdc.MarshalJSON(types.NewQueryAppliedParams(name))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierKey, types.QueryApplied)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			if name != "" {
+				var ap types.AppliedPlan
+				if err := cdc.UnmarshalJSON(res, &ap); err != nil {
+					return err
+				}
+				return cliCtx.PrintOutput(ap)
+			}
+
+			var aps types.AppliedPlans
+			if err := cdc.UnmarshalJSON(res, &aps); err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(aps)
+		},
+	}
+}
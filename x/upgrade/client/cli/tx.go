@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/pocblockchain/pocc/client/context"
+	"github.com/pocblockchain/pocc/codec"
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/version"
+	"github.com/pocblockchain/pocc/x/auth"
+	"github.com/pocblockchain/pocc/x/auth/client/utils"
+	govtypes "github.com/pocblockchain/pocc/x/gov/types"
+	"github.com/pocblockchain/pocc/x/upgrade/internal/types"
+)
+
+// GetCmdSubmitUpgradeProposal implements the command to submit a SoftwareUpgrade proposal
+func GetCmdSubmitUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "software-upgrade [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a software upgrade proposal",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a software upgrade proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. The plan is scheduled
+at either a height or a time, a plan already scheduled is replaced.
+
+Example:
+$ %s tx gov submit-proposal software-upgrade <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Upgrade to v2",
+  "description": "software upgrade proposal",
+  "plan": {
+    "name": "v2",
+    "height": "1000000",
+    "info": "https://github.com/pocblockchain/pocc/releases/tag/v2.0.0"
+  },
+  "deposit": [
+    {
+      "denom": "poc",
+      "amount": "100000"
+    }
+  ]
+}
+`, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseSoftwareUpgradeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewSoftwareUpgradeProposal(proposal.Title, proposal.Description, proposal.Plan)
+
+			msg := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitCancelUpgradeProposal implements the command to submit a CancelSoftwareUpgrade proposal
+func GetCmdSubmitCancelUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-software-upgrade [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal cancelling the scheduled software upgrade",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal cancelling the scheduled software upgrade along with an
+initial deposit. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal cancel-software-upgrade <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Cancel v2",
+  "description": "cancel software upgrade proposal",
+  "deposit": [
+    {
+      "denom": "poc",
+      "amount": "100000"
+    }
+  ]
+}
+`, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseCancelSoftwareUpgradeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewCancelSoftwareUpgradeProposal(proposal.Title, proposal.Description)
+
+			msg := govtypes.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/pocblockchain/pocc/codec"
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/upgrade/internal/types"
+)
+
+type (
+	SoftwareUpgradeProposalJSON struct {
+		Title       string     `json:"title" yaml:"title"`
+		Description string     `json:"description" yaml:"description"`
+		Plan        types.Plan `json:"plan" yaml:"plan"`
+		Deposit     sdk.Coins  `json:"deposit" yaml:"deposit"`
+	}
+
+	CancelSoftwareUpgradeProposalJSON struct {
+		Title       string    `json:"title" yaml:"title"`
+		Description string    `json:"description" yaml:"description"`
+		Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseSoftwareUpgradeProposalJSON reads and parses a SoftwareUpgradeProposalJSON from a file.
+func ParseSoftwareUpgradeProposalJSON(cdc *codec.Codec, proposalFile string) (SoftwareUpgradeProposalJSON, error) {
+	proposal := SoftwareUpgradeProposalJSON{}
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseCancelSoftwareUpgradeProposalJSON reads and parses a CancelSoftwareUpgradeProposalJSON from a file.
+func ParseCancelSoftwareUpgradeProposalJSON(cdc *codec.Codec, proposalFile string) (CancelSoftwareUpgradeProposalJSON, error) {
+	proposal := CancelSoftwareUpgradeProposalJSON{}
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
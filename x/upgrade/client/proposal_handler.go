@@ -0,0 +1,13 @@
+package client
+
+import (
+	govclient "github.com/pocblockchain/pocc/x/gov/client"
+	"github.com/pocblockchain/pocc/x/upgrade/client/cli"
+	"github.com/pocblockchain/pocc/x/upgrade/client/rest"
+)
+
+// software upgrade proposal handlers
+var (
+	ProposalHandler       = govclient.NewProposalHandler(cli.GetCmdSubmitUpgradeProposal, rest.SoftwareUpgradeProposalRESTHandler)
+	CancelProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitCancelUpgradeProposal, rest.CancelSoftwareUpgradeProposalRESTHandler)
+)
@@ -0,0 +1,30 @@
+/*
+Package upgrade coordinates software upgrades of the chain through governance.
+
+A SoftwareUpgradeProposal schedules a named Plan at a block height or time,
+replacing any plan already scheduled, and a CancelSoftwareUpgradeProposal
+removes it. When the plan is due, BeginBlocker looks for an UpgradeHandler
+registered under the plan name:
+
+  - if there is none, the running binary is out of date: the node logs
+    UPGRADE "<name>" NEEDED at <height or time>: <info> and halts by panicking,
+    so the operator can switch to the new binary and restart;
+  - if there is one, the handler runs once, in the BeginBlock of the upgrade
+    block, with an infinite gas meter. It performs the in-place store
+    migrations of the new binary, then the plan is recorded as applied and its
+    name cannot be scheduled again.
+
+A binary shipping the handler of a plan that is scheduled but not yet due
+halts as well, so the switch happens exactly at the planned block.
+
+New binaries register their handlers on the app keeper before the app is
+loaded, for instance:
+
+	app.upgradeKeeper.SetUpgradeHandler("v2", func(ctx sdk.Context, plan upgrade.Plan) {
+		// migrate the stores of the modules changed by v2
+	})
+
+The upgrade module must be the first module of the BeginBlock order so that
+no other module runs on the upgrade block with the old code.
+*/
+package upgrade
@@ -0,0 +1,52 @@
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	govtypes "github.com/pocblockchain/pocc/x/gov/types"
+	"github.com/pocblockchain/pocc/x/upgrade/internal/types"
+)
+
+// NewSoftwareUpgradeProposalHandler creates a governance handler to manage new proposal types.
+// It enables SoftwareUpgradeProposal to propose an Upgrade, and CancelSoftwareUpgradeProposal
+// to abort a previously voted upgrade.
+func NewSoftwareUpgradeProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Result {
+		switch c := content.(type) {
+		case types.SoftwareUpgradeProposal:
+			return handleSoftwareUpgradeProposal(ctx, k, c)
+
+		case types.CancelSoftwareUpgradeProposal:
+			return handleCancelSoftwareUpgradeProposal(ctx, k, c)
+
+		default:
+			errMsg := fmt.Sprintf("unrecognized software upgrade proposal content type: %T", c)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p types.SoftwareUpgradeProposal) sdk.Result {
+	if err := k.ScheduleUpgrade(ctx, p.Plan); err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleCancelSoftwareUpgradeProposal(ctx sdk.Context, k Keeper, p types.CancelSoftwareUpgradeProposal) sdk.Result {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return types.ErrInvalidPlan(types.DefaultCodespace, "no upgrade plan to cancel").Result()
+	}
+	k.ClearUpgradePlan(ctx)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelUpgrade,
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+		),
+	)
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/pocblockchain/pocc/codec"
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/upgrade/internal/types"
+)
+
+// Keeper of the upgrade store
+type Keeper struct {
+	storeKey        sdk.StoreKey
+	cdc             *codec.Codec
+	codespace       sdk.CodespaceType
+	upgradeHandlers map[string]types.UpgradeHandler
+}
+
+// NewKeeper creates a new upgrade Keeper instance
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:        storeKey,
+		cdc:             cdc,
+		codespace:       codespace,
+		upgradeHandlers: map[string]types.UpgradeHandler{},
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// SetUpgradeHandler sets an UpgradeHandler for the upgrade specified by name. This handler will be called when the upgrade
+// with this name is applied. In order for an upgrade with the given name to proceed, a handler for this upgrade
+// must be set even if it is a no-op function.
+func (k Keeper) SetUpgradeHandler(name string, upgradeHandler types.UpgradeHandler) {
+	k.upgradeHandlers[name] = upgradeHandler
+}
+
+// HasHandler returns true if an UpgradeHandler is set for the upgrade name
+func (k Keeper) HasHandler(name string) bool {
+	_, ok := k.upgradeHandlers[name]
+	return ok
+}
+
+// ScheduleUpgrade schedules an upgrade based on the specified plan. If there is another Plan already scheduled,
+// it will overwrite it (the latest plan scheduled wins).
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, plan types.Plan) sdk.Error {
+	if err := plan.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !plan.Time.IsZero() {
+		if !plan.Time.After(ctx.BlockHeader().Time) {
+			return types.ErrInvalidPlan(k.codespace, "upgrade cannot be scheduled in the past")
+		}
+	} else if plan.Height <= ctx.BlockHeight() {
+		return types.ErrInvalidPlan(k.codespace, "upgrade cannot be scheduled in the past")
+	}
+
+	if _, found := k.GetAppliedPlan(ctx, plan.Name); found {
+		return types.ErrInvalidPlan(k.codespace, fmt.Sprintf("upgrade with name %s has already been completed", plan.Name))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PlanKey, k.cdc.MustMarshalBinaryBare(plan))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleUpgrade,
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", plan.Height)),
+			sdk.NewAttribute(types.AttributeKeyTime, plan.Time.String()),
+		),
+	)
+	return nil
+}
+
+// ClearUpgradePlan clears any schedule upgrade
+func (k Keeper) ClearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PlanKey)
+}
+
+// GetUpgradePlan returns the currently scheduled Plan if any, setting havePlan to true if there is a scheduled
+// upgrade or false if there is none
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan, havePlan bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PlanKey)
+	if bz == nil {
+		return plan, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &plan)
+	return plan, true
+}
+
+// GetAppliedPlan returns the applied plan with the name if any
+func (k Keeper) GetAppliedPlan(ctx sdk.Context, name string) (ap types.AppliedPlan, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DoneKey(name))
+	if bz == nil {
+		return ap, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &ap)
+	return ap, true
+}
+
+// GetAppliedPlans returns all the applied plans in the order they were applied
+func (k Keeper) GetAppliedPlans(ctx sdk.Context) types.AppliedPlans {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.DonePrefix)
+	defer iter.Close()
+
+	aps := types.AppliedPlans{}
+	for ; iter.Valid(); iter.Next() {
+		var ap types.AppliedPlan
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &ap)
+		aps = append(aps, ap)
+	}
+
+	// sort by height, plans are stored by name
+	for i := 1; i < len(aps); i++ {
+		for j := i; j > 0 && aps[j].Height < aps[j-1].Height; j-- {
+			aps[j], aps[j-1] = aps[j-1], aps[j]
+		}
+	}
+	return aps
+}
+
+// GetDoneHeight returns the height at which the given upgrade was applied, 0 if it was not
+func (k Keeper) GetDoneHeight(ctx sdk.Context, name string) int64 {
+	ap, found := k.GetAppliedPlan(ctx, name)
+	if !found {
+		return 0
+	}
+	return ap.Height
+}
+
+// setDone marks this upgrade name as being done so the name can't be reused accidentally
+func (k Keeper) setDone(ctx sdk.Context, plan types.Plan) {
+	store := ctx.KVStore(k.storeKey)
+	ap := types.NewAppliedPlan(plan, ctx.BlockHeight())
+	store.Set(types.DoneKey(plan.Name), k.cdc.MustMarshalBinaryBare(ap))
+}
+
+// ApplyUpgrade will execute the handler associated with the Plan and mark the plan as done.
+func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
+	handler := k.upgradeHandlers[plan.Name]
+	if handler == nil {
+		panic("ApplyUpgrade should never be called without first checking HasHandler")
+	}
+
+	handler(ctx, plan)
+
+	k.ClearUpgradePlan(ctx)
+	k.setDone(ctx, plan)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeApplyUpgrade,
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/upgrade/internal/types"
+)
+
+func TestScheduleUpgrade(t *testing.T) {
+	input := setupTestInput(t)
+	ctx, k := input.ctx, input.keeper
+
+	_, found := k.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	tests := []struct {
+		name    string
+		plan    types.Plan
+		wantErr bool
+	}{
+		{"empty name", types.NewPlan("", time.Time{}, 20, ""), true},
+		{"no height nor time", types.NewPlan("v2", time.Time{}, 0, ""), true},
+		{"height and time", types.NewPlan("v2", ctx.BlockTime().Add(time.Hour), 20, ""), true},
+		{"past height", types.NewPlan("v2", time.Time{}, ctx.BlockHeight(), ""), true},
+		{"past time", types.NewPlan("v2", ctx.BlockTime(), 0, ""), true},
+		{"future height", types.NewPlan("v2", time.Time{}, 20, "info"), false},
+		{"future time", types.NewPlan("v2", ctx.BlockTime().Add(time.Hour), 0, ""), false},
+	}
+	for _, tc := range tests {
+		err := k.ScheduleUpgrade(ctx, tc.plan)
+		if tc.wantErr {
+			require.Error(t, err, tc.name)
+			require.Equal(t, types.CodeInvalidPlan, err.Code(), tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+
+		// the latest scheduled plan wins
+		plan, found := k.GetUpgradePlan(ctx)
+		require.True(t, found, tc.name)
+		require.Equal(t, tc.plan, plan, tc.name)
+	}
+
+	k.ClearUpgradePlan(ctx)
+	_, found = k.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
+
+func TestApplyUpgrade(t *testing.T) {
+	input := setupTestInput(t)
+	ctx, k := input.ctx, input.keeper
+
+	require.Panics(t, func() { k.ApplyUpgrade(ctx, types.NewPlan("v2", time.Time{}, 10, "")) })
+
+	called := 0
+	k.SetUpgradeHandler("v2", func(ctx sdk.Context, plan types.Plan) { called++ })
+	require.True(t, k.HasHandler("v2"))
+	require.False(t, k.HasHandler("v3"))
+
+	plan := types.NewPlan("v2", time.Time{}, 20, "")
+	require.NoError(t, k.ScheduleUpgrade(ctx, plan))
+
+	ctx = ctx.WithBlockHeight(20)
+	k.ApplyUpgrade(ctx, plan)
+	require.Equal(t, 1, called)
+
+	_, found := k.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	ap, found := k.GetAppliedPlan(ctx, "v2")
+	require.True(t, found)
+	require.Equal(t, types.NewAppliedPlan(plan, 20), ap)
+	require.Equal(t, int64(20), k.GetDoneHeight(ctx, "v2"))
+	require.Equal(t, int64(0), k.GetDoneHeight(ctx, "v3"))
+
+	// a name cannot be reused once applied
+	err := k.ScheduleUpgrade(ctx, types.NewPlan("v2", time.Time{}, 30, ""))
+	require.Error(t, err)
+	require.Equal(t, types.CodeInvalidPlan, err.Code())
+}
+
+func TestGetAppliedPlans(t *testing.T) {
+	input := setupTestInput(t)
+	ctx, k := input.ctx, input.keeper
+
+	require.Empty(t, k.GetAppliedPlans(ctx))
+
+	noop := func(ctx sdk.Context, plan types.Plan) {}
+	for i, name := range []string{"b", "a", "c"} {
+		k.SetUpgradeHandler(name, noop)
+		height := int64(20 + i*10)
+		plan := types.NewPlan(name, time.Time{}, height, "")
+		require.NoError(t, k.ScheduleUpgrade(ctx, plan))
+		ctx = ctx.WithBlockHeight(height)
+		k.ApplyUpgrade(ctx, plan)
+	}
+
+	aps := k.GetAppliedPlans(ctx)
+	require.Len(t, aps, 3)
+	for i, name := range []string{"b", "a", "c"} {
+		require.Equal(t, name, aps[i].Plan.Name)
+		require.Equal(t, int64(20+i*10), aps[i].Height)
+	}
+}
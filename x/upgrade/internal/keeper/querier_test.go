@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/upgrade/internal/types"
+)
+
+func TestNewQuerier(t *testing.T) {
+	input := setupTestInput(t)
+	ctx, k, cdc := input.ctx, input.keeper, input.cdc
+	querier := NewQuerier(k)
+
+	query := func(path string, params interface{}) ([]byte, sdk.Error) {
+		req := abci.RequestQuery{Path: ""}
+		if params != nil {
+			req.Data = cdc.MustMarshalJSON(params)
+		}
+		return querier(ctx, []string{path}, req)
+	}
+
+	// no plan scheduled
+	res, err := query(types.QueryCurrent, nil)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	plan := types.NewPlan("v2", time.Time{}, 20, "info")
+	require.NoError(t, k.ScheduleUpgrade(ctx, plan))
+
+	res, err = query(types.QueryCurrent, nil)
+	require.NoError(t, err)
+	var current types.Plan
+	require.NoError(t, cdc.UnmarshalJSON(res, &current))
+	require.Equal(t, plan, current)
+
+	// no applied plan yet
+	_, err = query(types.QueryApplied, types.NewQueryAppliedParams("v2"))
+	require.Error(t, err)
+	require.Equal(t, types.CodeUnknownPlan, err.Code())
+
+	k.SetUpgradeHandler("v2", func(ctx sdk.Context, plan types.Plan) {})
+	ctx = ctx.WithBlockHeight(20)
+	k.ApplyUpgrade(ctx, plan)
+
+	res, err = query(types.QueryApplied, types.NewQueryAppliedParams("v2"))
+	require.NoError(t, err)
+	var ap types.AppliedPlan
+	require.NoError(t, cdc.UnmarshalJSON(res, &ap))
+	require.Equal(t, types.NewAppliedPlan(plan, 20), ap)
+
+	res, err = query(types.QueryApplied, types.NewQueryAppliedParams(""))
+	require.NoError(t, err)
+	var aps types.AppliedPlans
+	require.NoError(t, cdc.UnmarshalJSON(res, &aps))
+	require.Equal(t, types.AppliedPlans{ap}, aps)
+
+	_, err = query("foo", nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,40 @@
+// nolint:deadcode unused
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/pocblockchain/pocc/codec"
+	"github.com/pocblockchain/pocc/store"
+	sdk "github.com/pocblockchain/pocc/types"
+	"github.com/pocblockchain/pocc/x/upgrade/internal/types"
+)
+
+type testInput struct {
+	ctx    sdk.Context
+	cdc    *codec.Codec
+	keeper Keeper
+}
+
+func setupTestInput(t *testing.T) testInput {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+
+	header := abci.Header{Height: 10, Time: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+	ctx := sdk.NewContext(ms, header, false, log.NewNopLogger())
+
+	return testInput{ctx: ctx, cdc: cdc, keeper: NewKeeper(cdc, key, types.DefaultCodespace)}
+}
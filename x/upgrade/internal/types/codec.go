@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/pocblockchain/pocc/codec"
+)
+
+// module codec
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	ModuleCdc.Seal()
+}
+
+// RegisterCodec registers concrete types on the Amino codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(Plan{}, "poc/upgrade/Plan", nil)
+	cdc.RegisterConcrete(SoftwareUpgradeProposal{}, "poc/upgrade/SoftwareUpgradeProposal", nil)
+	cdc.RegisterConcrete(CancelSoftwareUpgradeProposal{}, "poc/upgrade/CancelSoftwareUpgradeProposal", nil)
+}
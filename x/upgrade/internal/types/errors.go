@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/pocblockchain/pocc/types"
+)
+
+// Upgrade module codespace constants
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidPlan sdk.CodeType = 1
+	CodeUnknownPlan sdk.CodeType = 2
+)
+
+// ErrInvalidPlan returns an error for a plan that cannot be scheduled.
+func ErrInvalidPlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPlan, msg)
+}
+
+// ErrUnknownPlan returns an error for a plan that has not been applied.
+func ErrUnknownPlan(codespace sdk.CodespaceType, name string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownPlan, "no applied upgrade plan named "+name)
+}
@@ -0,0 +1,14 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeScheduleUpgrade = "schedule_upgrade"
+	EventTypeCancelUpgrade   = "cancel_upgrade"
+	EventTypeApplyUpgrade    = "apply_upgrade"
+
+	AttributeKeyName   = "name"
+	AttributeKeyHeight = "height"
+	AttributeKeyTime   = "time"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,9 @@
+package types
+
+import (
+	sdk "github.com/pocblockchain/pocc/types"
+)
+
+// UpgradeHandler specifies the type of function that is called when an upgrade is applied.
+// It runs in the BeginBlock of the upgrade height and migrates the stores in place.
+type UpgradeHandler func(ctx sdk.Context, plan Plan)
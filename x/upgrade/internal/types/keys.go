@@ -0,0 +1,28 @@
+package types
+
+const (
+	// ModuleName is the name of this module
+	ModuleName = "upgrade"
+
+	// RouterKey is used to route governance proposals
+	RouterKey = ModuleName
+
+	// StoreKey is the prefix under which we store this module's data
+	StoreKey = ModuleName
+
+	// QuerierKey is used to handle abci_query requests
+	QuerierKey = ModuleName
+)
+
+var (
+	// PlanKey is the key under which the current plan is saved
+	PlanKey = []byte{0x00}
+
+	// DonePrefix is the prefix under which the applied plans are saved by name
+	DonePrefix = []byte{0x01}
+)
+
+// DoneKey returns the key of the applied plan with the name
+func DoneKey(name string) []byte {
+	return append(DonePrefix, []byte(name)...)
+}
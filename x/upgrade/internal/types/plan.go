@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/pocblockchain/pocc/types"
+)
+
+// Plan specifies information about a planned upgrade and when it should occur
+type Plan struct {
+	// Name sets the name for the upgrade. The binary performing the upgrade
+	// registers an upgrade handler under this name.
+	Name string `json:"name,omitempty" yaml:"name,omitempty"`
+
+	// Time is the block time after which the upgrade is executed, it must be
+	// unset if Height is set.
+	Time time.Time `json:"time,omitempty" yaml:"time,omitempty"`
+
+	// Height is the height at which the upgrade is executed, it must be unset
+	// if Time is set.
+	Height int64 `json:"height,omitempty" yaml:"height,omitempty"`
+
+	// Info is any application specific upgrade info to be included on-chain,
+	// such as a git commit that validators could automatically upgrade to.
+	Info string `json:"info,omitempty" yaml:"info,omitempty"`
+}
+
+// NewPlan creates a new Plan instance
+func NewPlan(name string, t time.Time, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Time:   t,
+		Height: height,
+		Info:   info,
+	}
+}
+
+func (p Plan) String() string {
+	due := p.DueAt()
+	dueUp := strings.ToUpper(due[0:1]) + due[1:]
+	return fmt.Sprintf(`Upgrade Plan
+  Name: %s
+  %s
+  Info: %s`, p.Name, dueUp, p.Info)
+}
+
+// ValidateBasic does basic validation of a Plan
+func (p Plan) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "name cannot be empty")
+	}
+	if p.Height < 0 {
+		return ErrInvalidPlan(DefaultCodespace, "height cannot be negative")
+	}
+	if p.Time.IsZero() && p.Height == 0 {
+		return ErrInvalidPlan(DefaultCodespace, "must set either time or height")
+	}
+	if !p.Time.IsZero() && p.Height != 0 {
+		return ErrInvalidPlan(DefaultCodespace, "cannot set both time and height")
+	}
+
+	return nil
+}
+
+// ShouldExecute returns true if the Plan is ready to execute given the current context
+func (p Plan) ShouldExecute(ctx sdk.Context) bool {
+	if !p.Time.IsZero() {
+		return !ctx.BlockTime().Before(p.Time)
+	}
+	if p.Height > 0 {
+		return p.Height <= ctx.BlockHeight()
+	}
+	return false
+}
+
+// DueAt is a string representation of when this plan is due to be executed
+func (p Plan) DueAt() string {
+	if !p.Time.IsZero() {
+		return fmt.Sprintf("time: %s", p.Time.UTC().Format(time.RFC3339))
+	}
+	return fmt.Sprintf("height: %d", p.Height)
+}
+
+// AppliedPlan is a plan along with the height at which it was applied
+type AppliedPlan struct {
+	Plan   Plan  `json:"plan" yaml:"plan"`
+	Height int64 `json:"height" yaml:"height"`
+}
+
+// NewAppliedPlan creates a new AppliedPlan instance
+func NewAppliedPlan(plan Plan, height int64) AppliedPlan {
+	return AppliedPlan{
+		Plan:   plan,
+		Height: height,
+	}
+}
+
+func (ap AppliedPlan) String() string {
+	return fmt.Sprintf(`%s
+  Applied at height: %d`, ap.Plan, ap.Height)
+}
+
+// AppliedPlans is a list of applied plans
+type AppliedPlans []AppliedPlan
+
+func (aps AppliedPlans) String() string {
+	var b strings.Builder
+	for _, ap := range aps {
+		b.WriteString(ap.String())
+		b.WriteString("\n")
+	}
+	return strings.TrimSpace(b.String())
+}
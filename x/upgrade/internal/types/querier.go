@@ -0,0 +1,18 @@
+package types
+
+// query endpoints supported by the upgrade querier
+const (
+	QueryCurrent = "current"
+	QueryApplied = "applied"
+)
+
+// QueryAppliedParams is passed as data with a QueryApplied request, an empty
+// name lists every applied plan
+type QueryAppliedParams struct {
+	Name string
+}
+
+// NewQueryAppliedParams creates a new instance to query the applied plans
+func NewQueryAppliedParams(name string) QueryAppliedParams {
+	return QueryAppliedParams{Name: name}
+}